@@ -52,6 +52,24 @@ func (_m *mockNode[CHAIN_ID, HEAD, RPC]) ConfiguredChainID() CHAIN_ID {
 	return r0
 }
 
+// Latency provides a mock function with given fields:
+func (_m *mockNode[CHAIN_ID, HEAD, RPC]) Latency() NodeLatency {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Latency")
+	}
+
+	var r0 NodeLatency
+	if rf, ok := ret.Get(0).(func() NodeLatency); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(NodeLatency)
+	}
+
+	return r0
+}
+
 // Name provides a mock function with given fields:
 func (_m *mockNode[CHAIN_ID, HEAD, RPC]) Name() string {
 	ret := _m.Called()
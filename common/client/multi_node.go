@@ -1,6 +1,7 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math"
@@ -69,6 +70,8 @@ type MultiNode[
 	]
 	Close() error
 	NodeStates() map[string]string
+	// NodeLatencies returns a map of node Name->latency averages observed while polling the node
+	NodeLatencies() map[string]NodeLatency
 	SelectNodeRPC() (RPC_CLIENT, error)
 
 	BatchCallContextAll(ctx context.Context, b []BATCH_ELEM) error
@@ -105,6 +108,7 @@ type multiNode[
 	chainFamily         string
 	reportInterval      time.Duration
 	sendTxSoftTimeout   time.Duration // defines max waiting time from first response til responses evaluation
+	reader              *multiNodeReader[CHAIN_ID, HEAD, RPC_CLIENT]
 
 	activeMu   sync.RWMutex
 	activeNode Node[CHAIN_ID, HEAD, RPC_CLIENT]
@@ -141,6 +145,7 @@ func NewMultiNode[
 	chainFamily string,
 	classifySendTxError func(tx TX, err error) SendTxReturnCode,
 	sendTxSoftTimeout time.Duration,
+	readCfg ReadConfig,
 ) MultiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM] {
 	nodeSelector := newNodeSelector(selectionMode, nodes)
 	// Prometheus' default interval is 15s, set this to under 7.5s to avoid
@@ -166,6 +171,8 @@ func NewMultiNode[
 		sendTxSoftTimeout:   sendTxSoftTimeout,
 	}
 
+	c.reader = newMultiNodeReader(c.lggr, readCfg, chainFamily, chainID, nodes, c.selectNode)
+
 	c.lggr.Debugf("The MultiNode is configured to use NodeSelectionMode: %s", selectionMode)
 
 	return c
@@ -375,11 +382,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 
 // ClientAPI methods
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) BalanceAt(ctx context.Context, account ADDR, blockNumber *big.Int) (*big.Int, error) {
-	n, err := c.selectNode()
-	if err != nil {
-		return nil, err
-	}
-	return n.RPC().BalanceAt(ctx, account, blockNumber)
+	return executeRead(ctx, c.reader, ReadMethodBalanceAt, func(ctx context.Context, rpc RPC_CLIENT) (*big.Int, error) {
+		return rpc.BalanceAt(ctx, account, blockNumber)
+	}, equalBigInt)
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) BatchCallContext(ctx context.Context, b []BATCH_ELEM) error {
@@ -433,19 +438,15 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) BlockByHash(ctx context.Context, hash BLOCK_HASH) (h HEAD, err error) {
-	n, err := c.selectNode()
-	if err != nil {
-		return h, err
-	}
-	return n.RPC().BlockByHash(ctx, hash)
+	return executeRead(ctx, c.reader, ReadMethodBlockByHash, func(ctx context.Context, rpc RPC_CLIENT) (HEAD, error) {
+		return rpc.BlockByHash(ctx, hash)
+	}, equalHead[BLOCK_HASH, HEAD])
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) BlockByNumber(ctx context.Context, number *big.Int) (h HEAD, err error) {
-	n, err := c.selectNode()
-	if err != nil {
-		return h, err
-	}
-	return n.RPC().BlockByNumber(ctx, number)
+	return executeRead(ctx, c.reader, ReadMethodBlockByNumber, func(ctx context.Context, rpc RPC_CLIENT) (HEAD, error) {
+		return rpc.BlockByNumber(ctx, number)
+	}, equalHead[BLOCK_HASH, HEAD])
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
@@ -461,11 +462,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	attempt interface{},
 	blockNumber *big.Int,
 ) (rpcErr []byte, extractErr error) {
-	n, err := c.selectNode()
-	if err != nil {
-		return rpcErr, err
-	}
-	return n.RPC().CallContract(ctx, attempt, blockNumber)
+	return executeRead(ctx, c.reader, ReadMethodCallContract, func(ctx context.Context, rpc RPC_CLIENT) ([]byte, error) {
+		return rpc.CallContract(ctx, attempt, blockNumber)
+	}, bytes.Equal)
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) PendingCallContract(
@@ -494,11 +493,9 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) CodeAt(ctx context.Context, account ADDR, blockNumber *big.Int) (code []byte, err error) {
-	n, err := c.selectNode()
-	if err != nil {
-		return code, err
-	}
-	return n.RPC().CodeAt(ctx, account, blockNumber)
+	return executeRead(ctx, c.reader, ReadMethodCodeAt, func(ctx context.Context, rpc RPC_CLIENT) ([]byte, error) {
+		return rpc.CodeAt(ctx, account, blockNumber)
+	}, bytes.Equal)
 }
 
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) ConfiguredChainID() CHAIN_ID {
@@ -552,6 +549,14 @@ func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OP
 	return
 }
 
+func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) NodeLatencies() (latencies map[string]NodeLatency) {
+	latencies = make(map[string]NodeLatency)
+	for _, n := range c.nodes {
+		latencies[n.Name()] = n.Latency()
+	}
+	return
+}
+
 func (c *multiNode[CHAIN_ID, SEQ, ADDR, BLOCK_HASH, TX, TX_HASH, EVENT, EVENT_OPS, TX_RECEIPT, FEE, HEAD, RPC_CLIENT, BATCH_ELEM]) PendingSequenceAt(ctx context.Context, addr ADDR) (s SEQ, err error) {
 	n, err := c.selectNode()
 	if err != nil {
@@ -0,0 +1,343 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+const (
+	// ReadModeSingle sends each read to the active node only. This is the default.
+	ReadModeSingle = "Single"
+	// ReadModeHedged sends a read to the active node and, if no response arrives within the hedge delay
+	// or the node fails, to the next alive node. The first successful result is returned.
+	ReadModeHedged = "Hedged"
+	// ReadModeQuorum sends a read to several alive nodes at once and returns the result
+	// shared by a strict majority of them. Nodes disagreeing with the majority are reported.
+	ReadModeQuorum = "Quorum"
+)
+
+// minQuorumReadNodes is the minimum number of alive nodes a ReadModeQuorum read must be sent to.
+// With a single node there is nothing to compare its result against.
+const minQuorumReadNodes = 2
+
+// Read methods that can be dispatched with ReadModeHedged or ReadModeQuorum.
+const (
+	ReadMethodBalanceAt     = "BalanceAt"
+	ReadMethodBlockByHash   = "BlockByHash"
+	ReadMethodBlockByNumber = "BlockByNumber"
+	ReadMethodCallContract  = "CallContract"
+	ReadMethodCodeAt        = "CodeAt"
+)
+
+var (
+	ReadModes   = []string{ReadModeSingle, ReadModeHedged, ReadModeQuorum}
+	ReadMethods = []string{ReadMethodBalanceAt, ReadMethodBlockByHash, ReadMethodBlockByNumber, ReadMethodCallContract, ReadMethodCodeAt}
+
+	ErrNoReadQuorum = errors.New("no quorum reached among RPC nodes")
+
+	promMultiNodeReadDisagreements = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "multi_node_read_disagreements",
+		Help: "The number of quorum reads where the given RPC node returned a result that differs from the majority",
+	}, []string{"network", "chainId", "method", "nodeName"})
+)
+
+// ReadConfig configures how MultiNode dispatches reads to the primary nodes.
+type ReadConfig interface {
+	// Mode is one of ReadModes.
+	Mode() string
+	// Methods lists the ReadMethods Mode applies to. Any other read uses ReadModeSingle.
+	Methods() []string
+	// FanOut is the maximum number of nodes a single read is sent to. Zero means all alive nodes.
+	FanOut() uint32
+	// HedgeDelay is how long a hedged read waits for a response before it is also sent to the next node.
+	HedgeDelay() time.Duration
+}
+
+type readResult[T any] struct {
+	node  string
+	value T
+	err   error
+}
+
+// multiNodeReader dispatches reads to one or more alive nodes according to the configured ReadConfig.
+type multiNodeReader[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+] struct {
+	lggr        logger.SugaredLogger
+	chainFamily string
+	chainID     CHAIN_ID
+	nodes       []Node[CHAIN_ID, HEAD, RPC]
+	selectNode  func() (Node[CHAIN_ID, HEAD, RPC], error)
+
+	mode       string
+	methods    map[string]struct{}
+	fanOut     int
+	hedgeDelay time.Duration
+}
+
+func newMultiNodeReader[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+](
+	lggr logger.SugaredLogger,
+	cfg ReadConfig,
+	chainFamily string,
+	chainID CHAIN_ID,
+	nodes []Node[CHAIN_ID, HEAD, RPC],
+	selectNode func() (Node[CHAIN_ID, HEAD, RPC], error),
+) *multiNodeReader[CHAIN_ID, HEAD, RPC] {
+	r := &multiNodeReader[CHAIN_ID, HEAD, RPC]{
+		lggr:        lggr,
+		chainFamily: chainFamily,
+		chainID:     chainID,
+		nodes:       nodes,
+		selectNode:  selectNode,
+		mode:        ReadModeSingle,
+		methods:     make(map[string]struct{}),
+	}
+	if cfg == nil || cfg.Mode() == "" {
+		return r
+	}
+	if !slices.Contains(ReadModes, cfg.Mode()) {
+		panic(fmt.Sprintf("unsupported ReadMode: %s", cfg.Mode()))
+	}
+	r.mode = cfg.Mode()
+	for _, method := range cfg.Methods() {
+		r.methods[method] = struct{}{}
+	}
+	r.fanOut = int(cfg.FanOut())
+	r.hedgeDelay = cfg.HedgeDelay()
+	return r
+}
+
+// modeFor returns the read mode used for the given method.
+func (r *multiNodeReader[CHAIN_ID, HEAD, RPC]) modeFor(method string) string {
+	if _, ok := r.methods[method]; ok {
+		return r.mode
+	}
+	return ReadModeSingle
+}
+
+// readNodes returns up to fanOut alive nodes, starting with the active node.
+func (r *multiNodeReader[CHAIN_ID, HEAD, RPC]) readNodes() ([]Node[CHAIN_ID, HEAD, RPC], error) {
+	active, err := r.selectNode()
+	if err != nil {
+		return nil, err
+	}
+	nodes := []Node[CHAIN_ID, HEAD, RPC]{active}
+	for _, n := range r.nodes {
+		if r.fanOut > 0 && len(nodes) >= r.fanOut {
+			break
+		}
+		if n != active && n.State() == nodeStateAlive {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes, nil
+}
+
+// executeRead performs the read with the mode configured for method.
+// equal reports whether two successful results are the same, and is only used by ReadModeQuorum.
+func executeRead[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+	T any,
+](
+	ctx context.Context,
+	r *multiNodeReader[CHAIN_ID, HEAD, RPC],
+	method string,
+	do func(ctx context.Context, rpc RPC) (T, error),
+	equal func(a, b T) bool,
+) (result T, err error) {
+	mode := r.modeFor(method)
+	if mode == ReadModeSingle {
+		n, err := r.selectNode()
+		if err != nil {
+			return result, err
+		}
+		return do(ctx, n.RPC())
+	}
+
+	nodes, err := r.readNodes()
+	if err != nil {
+		return result, err
+	}
+	switch mode {
+	case ReadModeHedged:
+		return hedgedRead(ctx, nodes, r.hedgeDelay, do)
+	case ReadModeQuorum:
+		return quorumRead(ctx, r, method, nodes, do, equal)
+	default:
+		panic(fmt.Sprintf("unsupported ReadMode: %s", mode))
+	}
+}
+
+// hedgedRead sends the read to nodes one at a time, moving on to the next node when the hedge delay elapses or
+// the latest request fails. It returns the first successful result, or the last error if every node failed.
+func hedgedRead[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+	T any,
+](
+	ctx context.Context,
+	nodes []Node[CHAIN_ID, HEAD, RPC],
+	hedgeDelay time.Duration,
+	do func(ctx context.Context, rpc RPC) (T, error),
+) (result T, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel() // abort the requests still in flight once we have a result
+
+	results := make(chan readResult[T], len(nodes))
+	send := func(n Node[CHAIN_ID, HEAD, RPC]) {
+		go func() {
+			value, err := do(ctx, n.RPC())
+			results <- readResult[T]{node: n.String(), value: value, err: err}
+		}()
+	}
+
+	sent, received := 1, 0
+	send(nodes[0])
+	hedge := time.NewTimer(hedgeDelay)
+	defer hedge.Stop()
+	for received < len(nodes) {
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		case <-hedge.C:
+			if sent < len(nodes) {
+				send(nodes[sent])
+				sent++
+				hedge.Reset(hedgeDelay)
+			}
+		case res := <-results:
+			received++
+			if res.err == nil {
+				return res.value, nil
+			}
+			err = res.err
+			if sent < len(nodes) {
+				send(nodes[sent])
+				sent++
+				hedge.Reset(hedgeDelay)
+			} else if received == sent {
+				return result, err
+			}
+		}
+	}
+	return result, err
+}
+
+// quorumRead sends the read to all nodes at once and returns the result shared by a strict majority of them.
+// It returns as soon as a result reaches the majority, and fails as soon as no result can reach it anymore.
+// Nodes that returned a different successful result before the majority was reached are logged and reported to Prometheus.
+func quorumRead[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+	T any,
+](
+	ctx context.Context,
+	r *multiNodeReader[CHAIN_ID, HEAD, RPC],
+	method string,
+	nodes []Node[CHAIN_ID, HEAD, RPC],
+	do func(ctx context.Context, rpc RPC) (T, error),
+	equal func(a, b T) bool,
+) (result T, err error) {
+	if len(nodes) < minQuorumReadNodes {
+		return result, fmt.Errorf("%w: %s requires at least %d alive nodes, got %d", ErrNoReadQuorum, method, minQuorumReadNodes, len(nodes))
+	}
+
+	quorum := len(nodes)/2 + 1
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel() // abort the requests still in flight once the outcome is known
+	results := make(chan readResult[T], len(nodes))
+	for _, n := range nodes {
+		go func(n Node[CHAIN_ID, HEAD, RPC]) {
+			value, err := do(ctx, n.RPC())
+			results <- readResult[T]{node: n.String(), value: value, err: err}
+		}(n)
+	}
+
+	// identical results are grouped, keeping the first result of each group as its representative
+	var groups [][]readResult[T]
+	var errs []error
+	largest := 0
+	for received := 1; received <= len(nodes); received++ {
+		var res readResult[T]
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		case res = <-results:
+		}
+		if res.err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", res.node, res.err))
+		} else {
+			i := slices.IndexFunc(groups, func(g []readResult[T]) bool { return equal(g[0].value, res.value) })
+			if i < 0 {
+				i = len(groups)
+				groups = append(groups, nil)
+			}
+			groups[i] = append(groups[i], res)
+			largest = max(largest, len(groups[i]))
+			if len(groups[i]) >= quorum {
+				reportDisagreements(r, method, groups, i)
+				return groups[i][0].value, nil
+			}
+		}
+		if largest+len(nodes)-received < quorum {
+			break
+		}
+	}
+
+	r.lggr.Warnw("No quorum reached for read", "method", method, "quorum", quorum, "nodes", len(nodes), "groups", len(groups), "errs", errs)
+	return result, fmt.Errorf("%w: %s requires %d matching results from %d nodes: %w", ErrNoReadQuorum, method, quorum, len(nodes), errors.Join(errs...))
+}
+
+// reportDisagreements logs and counts the nodes whose result is not in the majority group.
+func reportDisagreements[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+	T any,
+](r *multiNodeReader[CHAIN_ID, HEAD, RPC], method string, groups [][]readResult[T], majority int) {
+	for i, g := range groups {
+		if i == majority {
+			continue
+		}
+		for _, res := range g {
+			r.lggr.Warnw("RPC node returned a result that disagrees with the majority", "method", method, "node", res.node)
+			promMultiNodeReadDisagreements.WithLabelValues(r.chainFamily, r.chainID.String(), method, res.node).Inc()
+		}
+	}
+}
+
+func equalBigInt(a, b *big.Int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Cmp(b) == 0
+}
+
+func equalHead[BLOCK_HASH types.Hashable, HEAD types.Head[BLOCK_HASH]](a, b HEAD) bool {
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+	return a.BlockHash() == b.BlockHash()
+}
@@ -0,0 +1,204 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+type testReadConfig struct {
+	mode       string
+	methods    []string
+	fanOut     uint32
+	hedgeDelay time.Duration
+}
+
+func (c testReadConfig) Mode() string              { return c.mode }
+func (c testReadConfig) Methods() []string         { return c.methods }
+func (c testReadConfig) FanOut() uint32            { return c.fanOut }
+func (c testReadConfig) HedgeDelay() time.Duration { return c.hedgeDelay }
+
+func newReadNode(t *testing.T, name string, state nodeState, balance func() (*big.Int, error)) *mockNode[types.ID, types.Head[Hashable], multiNodeRPCClient] {
+	node := newMockNode[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+	node.On("State").Return(state).Maybe()
+	node.On("String").Return(name).Maybe()
+	rpc := newMultiNodeRPCClient(t)
+	if balance != nil {
+		rpc.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).Return(func(context.Context, Hashable, *big.Int) (*big.Int, error) {
+			return balance()
+		}).Maybe()
+	}
+	node.On("RPC").Return(rpc).Maybe()
+	return node
+}
+
+func newReadTestMultiNode(t *testing.T, cfg ReadConfig, nodes ...Node[types.ID, types.Head[Hashable], multiNodeRPCClient]) testMultiNode {
+	mn := newTestMultiNode(t, multiNodeOpts{
+		selectionMode: NodeSelectionModeRoundRobin,
+		chainID:       types.RandomID(),
+		nodes:         nodes,
+		readCfg:       cfg,
+	})
+	nodeSelector := newMockNodeSelector[types.ID, types.Head[Hashable], multiNodeRPCClient](t)
+	nodeSelector.On("Select").Return(nodes[0]).Maybe()
+	mn.nodeSelector = nodeSelector
+	return mn
+}
+
+func balance(v int64) func() (*big.Int, error) {
+	return func() (*big.Int, error) { return big.NewInt(v), nil }
+}
+
+func TestMultiNode_Reads(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Single mode only uses the active node", func(t *testing.T) {
+		t.Parallel()
+		node1 := newReadNode(t, "node1", nodeStateAlive, balance(1))
+		node2 := newReadNode(t, "node2", nodeStateAlive, nil)
+		mn := newReadTestMultiNode(t, nil, node1, node2)
+		result, err := mn.BalanceAt(tests.Context(t), "", nil)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1), result)
+	})
+
+	t.Run("methods without a configured mode only use the active node", func(t *testing.T) {
+		t.Parallel()
+		node1 := newReadNode(t, "node1", nodeStateAlive, balance(1))
+		node2 := newReadNode(t, "node2", nodeStateAlive, nil)
+		cfg := testReadConfig{mode: ReadModeQuorum, methods: []string{ReadMethodCallContract}}
+		mn := newReadTestMultiNode(t, cfg, node1, node2)
+		result, err := mn.BalanceAt(tests.Context(t), "", nil)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1), result)
+	})
+
+	t.Run("Quorum returns the majority result", func(t *testing.T) {
+		t.Parallel()
+		node1 := newReadNode(t, "node1", nodeStateAlive, balance(10))
+		node2 := newReadNode(t, "node2", nodeStateAlive, balance(11))
+		node3 := newReadNode(t, "node3", nodeStateAlive, balance(10))
+		cfg := testReadConfig{mode: ReadModeQuorum, methods: []string{ReadMethodBalanceAt}}
+		mn := newReadTestMultiNode(t, cfg, node1, node2, node3)
+		result, err := mn.BalanceAt(tests.Context(t), "", nil)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(10), result)
+	})
+
+	t.Run("Quorum fails without a majority", func(t *testing.T) {
+		t.Parallel()
+		node1 := newReadNode(t, "node1", nodeStateAlive, balance(10))
+		node2 := newReadNode(t, "node2", nodeStateAlive, balance(11))
+		node3 := newReadNode(t, "node3", nodeStateAlive, func() (*big.Int, error) { return nil, errors.New("rpc failed") })
+		cfg := testReadConfig{mode: ReadModeQuorum, methods: []string{ReadMethodBalanceAt}}
+		mn := newReadTestMultiNode(t, cfg, node1, node2, node3)
+		_, err := mn.BalanceAt(tests.Context(t), "", nil)
+		require.ErrorIs(t, err, ErrNoReadQuorum)
+		assert.ErrorContains(t, err, "rpc failed")
+	})
+
+	t.Run("Quorum returns without waiting for the nodes outside the majority", func(t *testing.T) {
+		t.Parallel()
+		unblock := make(chan struct{})
+		t.Cleanup(func() { close(unblock) })
+		node1 := newReadNode(t, "node1", nodeStateAlive, balance(10))
+		node2 := newReadNode(t, "node2", nodeStateAlive, balance(10))
+		node3 := newReadNode(t, "node3", nodeStateAlive, func() (*big.Int, error) {
+			<-unblock
+			return big.NewInt(10), nil
+		})
+		cfg := testReadConfig{mode: ReadModeQuorum, methods: []string{ReadMethodBalanceAt}}
+		mn := newReadTestMultiNode(t, cfg, node1, node2, node3)
+		result, err := mn.BalanceAt(tests.Context(t), "", nil)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(10), result)
+	})
+
+	t.Run("Quorum fails as soon as no majority can be reached", func(t *testing.T) {
+		t.Parallel()
+		unblock := make(chan struct{})
+		t.Cleanup(func() { close(unblock) })
+		node1 := newReadNode(t, "node1", nodeStateAlive, func() (*big.Int, error) { return nil, errors.New("node1 failed") })
+		node2 := newReadNode(t, "node2", nodeStateAlive, func() (*big.Int, error) { return nil, errors.New("node2 failed") })
+		node3 := newReadNode(t, "node3", nodeStateAlive, func() (*big.Int, error) {
+			<-unblock
+			return big.NewInt(10), nil
+		})
+		cfg := testReadConfig{mode: ReadModeQuorum, methods: []string{ReadMethodBalanceAt}}
+		mn := newReadTestMultiNode(t, cfg, node1, node2, node3)
+		_, err := mn.BalanceAt(tests.Context(t), "", nil)
+		require.ErrorIs(t, err, ErrNoReadQuorum)
+		assert.ErrorContains(t, err, "node1 failed")
+		assert.ErrorContains(t, err, "node2 failed")
+	})
+
+	t.Run("Quorum requires at least two alive nodes", func(t *testing.T) {
+		t.Parallel()
+		node1 := newReadNode(t, "node1", nodeStateAlive, balance(10))
+		node2 := newReadNode(t, "node2", nodeStateUnreachable, nil)
+		cfg := testReadConfig{mode: ReadModeQuorum, methods: []string{ReadMethodBalanceAt}}
+		mn := newReadTestMultiNode(t, cfg, node1, node2)
+		_, err := mn.BalanceAt(tests.Context(t), "", nil)
+		require.ErrorIs(t, err, ErrNoReadQuorum)
+	})
+
+	t.Run("Quorum skips dead nodes and respects fan out", func(t *testing.T) {
+		t.Parallel()
+		node1 := newReadNode(t, "node1", nodeStateAlive, balance(10))
+		node2 := newReadNode(t, "node2", nodeStateUnreachable, nil)
+		node3 := newReadNode(t, "node3", nodeStateAlive, balance(10))
+		node4 := newReadNode(t, "node4", nodeStateAlive, nil)
+		cfg := testReadConfig{mode: ReadModeQuorum, methods: []string{ReadMethodBalanceAt}, fanOut: 2}
+		mn := newReadTestMultiNode(t, cfg, node1, node2, node3, node4)
+		result, err := mn.BalanceAt(tests.Context(t), "", nil)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(10), result)
+	})
+
+	t.Run("Hedged moves on to the next node when the active node is slow", func(t *testing.T) {
+		t.Parallel()
+		unblock := make(chan struct{})
+		t.Cleanup(func() { close(unblock) })
+		node1 := newReadNode(t, "node1", nodeStateAlive, func() (*big.Int, error) {
+			<-unblock
+			return big.NewInt(1), nil
+		})
+		node2 := newReadNode(t, "node2", nodeStateAlive, balance(2))
+		cfg := testReadConfig{mode: ReadModeHedged, methods: []string{ReadMethodBalanceAt}, hedgeDelay: tests.TestInterval}
+		mn := newReadTestMultiNode(t, cfg, node1, node2)
+		result, err := mn.BalanceAt(tests.Context(t), "", nil)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(2), result)
+	})
+
+	t.Run("Hedged moves on to the next node as soon as the active node fails", func(t *testing.T) {
+		t.Parallel()
+		node1 := newReadNode(t, "node1", nodeStateAlive, func() (*big.Int, error) { return nil, errors.New("rpc failed") })
+		node2 := newReadNode(t, "node2", nodeStateAlive, balance(2))
+		cfg := testReadConfig{mode: ReadModeHedged, methods: []string{ReadMethodBalanceAt}, hedgeDelay: time.Hour}
+		mn := newReadTestMultiNode(t, cfg, node1, node2)
+		result, err := mn.BalanceAt(tests.Context(t), "", nil)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(2), result)
+	})
+
+	t.Run("Hedged returns the last error if every node fails", func(t *testing.T) {
+		t.Parallel()
+		node1 := newReadNode(t, "node1", nodeStateAlive, func() (*big.Int, error) { return nil, errors.New("node1 failed") })
+		node2 := newReadNode(t, "node2", nodeStateAlive, func() (*big.Int, error) { return nil, errors.New("node2 failed") })
+		cfg := testReadConfig{mode: ReadModeHedged, methods: []string{ReadMethodBalanceAt}, hedgeDelay: time.Hour}
+		mn := newReadTestMultiNode(t, cfg, node1, node2)
+		_, err := mn.BalanceAt(tests.Context(t), "", nil)
+		require.EqualError(t, err, "node2 failed")
+	})
+}
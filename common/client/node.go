@@ -72,10 +72,29 @@ type Node[
 	UnsubscribeAllExceptAliveLoop()
 	ConfiguredChainID() CHAIN_ID
 	Order() int32
+	// Latency returns the moving averages of the RTT and error rate observed while polling the RPC.
+	Latency() NodeLatency
 	Start(context.Context) error
 	Close() error
 }
 
+// NodeLatency holds exponentially weighted moving averages of the round-trip time and error rate of the RPC.
+// Only the aliveLoop ClientVersion polls are sampled, not the requests routed to the node: a poll is a cheap call
+// of constant cost, whereas request RTT would penalize a node for being handed the expensive calls (e.g. large log queries).
+type NodeLatency struct {
+	RTT       time.Duration
+	ErrorRate float64
+	// Samples is the number of observations folded into the averages; zero means the node was never measured.
+	Samples uint64
+}
+
+// Score returns the latency score of the node, in seconds. Lower is better.
+// Every failed request is penalized as if it took QueryTimeout, so that a fast but unreliable RPC
+// ranks below a slightly slower healthy one.
+func (l NodeLatency) Score() float64 {
+	return l.RTT.Seconds() + l.ErrorRate*QueryTimeout.Seconds()
+}
+
 type node[
 	CHAIN_ID types.ID,
 	HEAD Head,
@@ -103,6 +122,9 @@ type node[
 	stateLatestTotalDifficulty      *big.Int
 	stateLatestFinalizedBlockNumber int64
 
+	latencyMu sync.RWMutex // protects latency
+	latency   NodeLatency
+
 	stopCh services.StopChan
 	// wg waits for subsidiary goroutines
 	wg sync.WaitGroup
@@ -325,3 +347,9 @@ func (n *node[CHAIN_ID, HEAD, RPC]) disconnectAll() {
 func (n *node[CHAIN_ID, HEAD, RPC]) Order() int32 {
 	return n.order
 }
+
+func (n *node[CHAIN_ID, HEAD, RPC]) Latency() NodeLatency {
+	n.latencyMu.RLock()
+	defer n.latencyMu.RUnlock()
+	return n.latency
+}
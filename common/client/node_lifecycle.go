@@ -42,8 +42,23 @@ var (
 		Name: "pool_rpc_node_polls_success",
 		Help: "The total number of successful poll checks for the given RPC node",
 	}, []string{"chainID", "nodeName"})
+	promPoolRPCNodeLatencyRTT = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pool_rpc_node_latency_rtt_seconds",
+		Help: "The moving average of the round-trip time of poll checks for the given RPC node",
+	}, []string{"chainID", "nodeName"})
+	promPoolRPCNodeLatencyErrorRate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pool_rpc_node_latency_error_rate",
+		Help: "The moving average of the poll check error rate for the given RPC node",
+	}, []string{"chainID", "nodeName"})
+	promPoolRPCNodeLatencyScore = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pool_rpc_node_latency_score",
+		Help: "The latency score used by the LowestLatency selection mode for the given RPC node (lower is better)",
+	}, []string{"chainID", "nodeName"})
 )
 
+// latencyEWMAWeight is the weight given to the most recent sample when updating the node latency averages.
+const latencyEWMAWeight = 0.2
+
 // zombieNodeCheckInterval controls how often to re-check to see if we need to
 // state change in case we have to force a state transition due to no available
 // nodes.
@@ -63,6 +78,30 @@ func (n *node[CHAIN_ID, HEAD, RPC]) setLatestReceived(blockNumber int64, totalDi
 	n.stateLatestTotalDifficulty = totalDifficulty
 }
 
+// recordLatency folds the outcome of a single liveness poll of the RPC into the node latency averages.
+func (n *node[CHAIN_ID, HEAD, RPC]) recordLatency(rtt time.Duration, err error) {
+	var failed float64
+	if err != nil {
+		failed = 1
+	}
+
+	n.latencyMu.Lock()
+	if n.latency.Samples == 0 {
+		n.latency.RTT = rtt
+		n.latency.ErrorRate = failed
+	} else {
+		n.latency.RTT = time.Duration(latencyEWMAWeight*float64(rtt) + (1-latencyEWMAWeight)*float64(n.latency.RTT))
+		n.latency.ErrorRate = latencyEWMAWeight*failed + (1-latencyEWMAWeight)*n.latency.ErrorRate
+	}
+	n.latency.Samples++
+	latency := n.latency
+	n.latencyMu.Unlock()
+
+	promPoolRPCNodeLatencyRTT.WithLabelValues(n.chainID.String(), n.name).Set(latency.RTT.Seconds())
+	promPoolRPCNodeLatencyErrorRate.WithLabelValues(n.chainID.String(), n.name).Set(latency.ErrorRate)
+	promPoolRPCNodeLatencyScore.WithLabelValues(n.chainID.String(), n.name).Set(latency.Score())
+}
+
 const (
 	msgCannotDisable = "but cannot disable this connection because there are no other RPC endpoints, or all other RPC endpoints are dead."
 	msgDegradedState = "Chainlink is now operating in a degraded state and urgent action is required to resolve the issue"
@@ -158,11 +197,13 @@ func (n *node[CHAIN_ID, HEAD, RPC]) aliveLoop() {
 		case <-pollCh:
 			promPoolRPCNodePolls.WithLabelValues(n.chainID.String(), n.name).Inc()
 			lggr.Tracew("Polling for version", "nodeState", n.State(), "pollFailures", pollFailures)
+			pollStart := time.Now()
 			version, err := func(ctx context.Context) (string, error) {
 				ctx, cancel := context.WithTimeout(ctx, pollInterval)
 				defer cancel()
 				return n.RPC().ClientVersion(ctx)
 			}(ctx)
+			n.recordLatency(time.Since(pollStart), err)
 			if err != nil {
 				// prevent overflow
 				if pollFailures < math.MaxUint32 {
@@ -287,7 +328,7 @@ func (n *node[CHAIN_ID, HEAD, RPC]) syncStatus(num int64, td *big.Int) (outOfSyn
 	ln, highest, greatest := n.nLiveNodes()
 	mode := n.nodePoolCfg.SelectionMode()
 	switch mode {
-	case NodeSelectionModeHighestHead, NodeSelectionModeRoundRobin, NodeSelectionModePriorityLevel:
+	case NodeSelectionModeHighestHead, NodeSelectionModeRoundRobin, NodeSelectionModePriorityLevel, NodeSelectionModeLowestLatency:
 		return num < highest-int64(threshold), ln
 	case NodeSelectionModeTotalDifficulty:
 		bigThreshold := big.NewInt(int64(threshold))
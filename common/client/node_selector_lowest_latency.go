@@ -0,0 +1,55 @@
+package client
+
+import (
+	"math"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+type lowestLatencyNodeSelector[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+] []Node[CHAIN_ID, HEAD, RPC]
+
+func NewLowestLatencyNodeSelector[
+	CHAIN_ID types.ID,
+	HEAD Head,
+	RPC NodeClient[CHAIN_ID, HEAD],
+](nodes []Node[CHAIN_ID, HEAD, RPC]) NodeSelector[CHAIN_ID, HEAD, RPC] {
+	return lowestLatencyNodeSelector[CHAIN_ID, HEAD, RPC](nodes)
+}
+
+// Select returns the alive node with the lowest latency score. Nodes that were never measured
+// are only considered if no alive node has been measured yet.
+func (s lowestLatencyNodeSelector[CHAIN_ID, HEAD, RPC]) Select() Node[CHAIN_ID, HEAD, RPC] {
+	lowestScore := math.MaxFloat64
+	var lowestScoreNodes []Node[CHAIN_ID, HEAD, RPC]
+	var unmeasuredNodes []Node[CHAIN_ID, HEAD, RPC]
+	for _, n := range s {
+		if n.State() != nodeStateAlive {
+			continue
+		}
+		latency := n.Latency()
+		if latency.Samples == 0 {
+			unmeasuredNodes = append(unmeasuredNodes, n)
+			continue
+		}
+		score := latency.Score()
+		if score <= lowestScore {
+			if score < lowestScore {
+				lowestScore = score
+				lowestScoreNodes = nil
+			}
+			lowestScoreNodes = append(lowestScoreNodes, n)
+		}
+	}
+	if len(lowestScoreNodes) == 0 {
+		return firstOrHighestPriority(unmeasuredNodes)
+	}
+	return firstOrHighestPriority(lowestScoreNodes)
+}
+
+func (s lowestLatencyNodeSelector[CHAIN_ID, HEAD, RPC]) Name() string {
+	return NodeSelectionModeLowestLatency
+}
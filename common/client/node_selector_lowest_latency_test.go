@@ -0,0 +1,110 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+func TestLowestLatencyNodeSelectorName(t *testing.T) {
+	selector := newNodeSelector[types.ID, Head, NodeClient[types.ID, Head]](NodeSelectionModeLowestLatency, nil)
+	assert.Equal(t, selector.Name(), NodeSelectionModeLowestLatency)
+}
+
+func TestLowestLatencyNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	type nodeClient NodeClient[types.ID, Head]
+
+	t.Run("selects the alive node with the lowest score", func(t *testing.T) {
+		node1 := newMockNode[types.ID, Head, nodeClient](t)
+		node1.On("State").Return(nodeStateOutOfSync)
+
+		node2 := newMockNode[types.ID, Head, nodeClient](t)
+		node2.On("State").Return(nodeStateAlive)
+		node2.On("Latency").Return(NodeLatency{RTT: 200 * time.Millisecond, Samples: 10})
+
+		node3 := newMockNode[types.ID, Head, nodeClient](t)
+		node3.On("State").Return(nodeStateAlive)
+		node3.On("Latency").Return(NodeLatency{RTT: 50 * time.Millisecond, Samples: 10})
+		node3.On("Order").Return(int32(1))
+
+		nodes := []Node[types.ID, Head, nodeClient]{node1, node2, node3}
+		selector := newNodeSelector(NodeSelectionModeLowestLatency, nodes)
+		assert.Same(t, node3, selector.Select())
+	})
+
+	t.Run("errors outweigh a lower round-trip time", func(t *testing.T) {
+		node1 := newMockNode[types.ID, Head, nodeClient](t)
+		node1.On("State").Return(nodeStateAlive)
+		node1.On("Latency").Return(NodeLatency{RTT: 10 * time.Millisecond, ErrorRate: 0.5, Samples: 10})
+
+		node2 := newMockNode[types.ID, Head, nodeClient](t)
+		node2.On("State").Return(nodeStateAlive)
+		node2.On("Latency").Return(NodeLatency{RTT: 300 * time.Millisecond, Samples: 10})
+		node2.On("Order").Return(int32(1))
+
+		nodes := []Node[types.ID, Head, nodeClient]{node1, node2}
+		selector := newNodeSelector(NodeSelectionModeLowestLatency, nodes)
+		assert.Same(t, node2, selector.Select())
+	})
+
+	t.Run("same score uses order as tie-breaker", func(t *testing.T) {
+		node1 := newMockNode[types.ID, Head, nodeClient](t)
+		node1.On("State").Return(nodeStateAlive)
+		node1.On("Latency").Return(NodeLatency{RTT: 100 * time.Millisecond, Samples: 10})
+		node1.On("Order").Return(int32(2))
+
+		node2 := newMockNode[types.ID, Head, nodeClient](t)
+		node2.On("State").Return(nodeStateAlive)
+		node2.On("Latency").Return(NodeLatency{RTT: 100 * time.Millisecond, Samples: 10})
+		node2.On("Order").Return(int32(1))
+
+		nodes := []Node[types.ID, Head, nodeClient]{node1, node2}
+		selector := newNodeSelector(NodeSelectionModeLowestLatency, nodes)
+		assert.Same(t, node2, selector.Select())
+	})
+
+	t.Run("unmeasured nodes are used only when no node was measured", func(t *testing.T) {
+		node1 := newMockNode[types.ID, Head, nodeClient](t)
+		node1.On("State").Return(nodeStateAlive)
+		node1.On("Latency").Return(NodeLatency{})
+		node1.On("Order").Return(int32(1))
+
+		node2 := newMockNode[types.ID, Head, nodeClient](t)
+		node2.On("State").Return(nodeStateAlive)
+		node2.On("Latency").Return(NodeLatency{})
+		node2.On("Order").Return(int32(2))
+
+		nodes := []Node[types.ID, Head, nodeClient]{node1, node2}
+		selector := newNodeSelector(NodeSelectionModeLowestLatency, nodes)
+		assert.Same(t, node1, selector.Select())
+
+		node3 := newMockNode[types.ID, Head, nodeClient](t)
+		node3.On("State").Return(nodeStateAlive)
+		node3.On("Latency").Return(NodeLatency{RTT: time.Second, Samples: 1})
+		node3.On("Order").Return(int32(3))
+
+		selector = newNodeSelector(NodeSelectionModeLowestLatency, append(nodes, node3))
+		assert.Same(t, node3, selector.Select())
+	})
+}
+
+func TestLowestLatencyNodeSelector_None(t *testing.T) {
+	t.Parallel()
+
+	type nodeClient NodeClient[types.ID, Head]
+	var nodes []Node[types.ID, Head, nodeClient]
+
+	for i := 0; i < 3; i++ {
+		node := newMockNode[types.ID, Head, nodeClient](t)
+		node.On("State").Return(nodeStateUnreachable)
+		nodes = append(nodes, node)
+	}
+
+	selector := newNodeSelector(NodeSelectionModeLowestLatency, nodes)
+	assert.Nil(t, selector.Select())
+}
@@ -1,10 +1,13 @@
 package client
 
 import (
+	"errors"
 	"net/url"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 
 	clientMocks "github.com/smartcontractkit/chainlink/v2/common/client/mocks"
@@ -90,3 +93,22 @@ func newTestNode(t *testing.T, opts testNodeOpts) testNode {
 		nodeI.(*node[types.ID, Head, NodeClient[types.ID, Head]]),
 	}
 }
+
+func TestNode_RecordLatency(t *testing.T) {
+	t.Parallel()
+	node := newTestNode(t, testNodeOpts{})
+	assert.Equal(t, NodeLatency{}, node.Latency())
+
+	node.recordLatency(100*time.Millisecond, nil)
+	latency := node.Latency()
+	assert.Equal(t, 100*time.Millisecond, latency.RTT)
+	assert.Equal(t, float64(0), latency.ErrorRate)
+	assert.Equal(t, uint64(1), latency.Samples)
+
+	node.recordLatency(200*time.Millisecond, errors.New("failed"))
+	latency = node.Latency()
+	assert.Equal(t, 120*time.Millisecond, latency.RTT)
+	assert.InDelta(t, 0.2, latency.ErrorRate, 1e-9)
+	assert.Equal(t, uint64(2), latency.Samples)
+	assert.InDelta(t, 0.12+0.2*QueryTimeout.Seconds(), latency.Score(), 1e-9)
+}
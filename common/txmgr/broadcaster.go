@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -50,6 +51,10 @@ var (
 			float64(2 * time.Minute),
 		},
 	}, []string{"chainID"})
+	promTxSimulations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_tx_simulations",
+		Help: "The number of transactions simulated before broadcast, by outcome (succeeded, reverted or failed).",
+	}, []string{"chainID", "outcome"})
 )
 
 var ErrTxRemoved = errors.New("tx removed")
@@ -135,6 +140,11 @@ type Broadcaster[
 	// Each key has its own trigger
 	triggers map[ADDR]chan struct{}
 
+	// fairQueues decide which subject and priority the next unstarted tx of
+	// each address is taken from
+	fairQueues   map[ADDR]*fairTxQueue
+	fairQueuesMu sync.Mutex
+
 	chStop services.StopChan
 	wg     sync.WaitGroup
 
@@ -179,6 +189,7 @@ func NewBroadcaster[
 		checkerFactory:   checkerFactory,
 		autoSyncSequence: autoSyncSequence,
 		sequenceTracker:  sequenceTracker,
+		fairQueues:       make(map[ADDR]*fairTxQueue),
 	}
 
 	b.processUnstartedTxsImpl = b.processUnstartedTxs
@@ -215,6 +226,7 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) star
 	eb.wg = sync.WaitGroup{}
 	eb.wg.Add(len(eb.enabledAddresses))
 	eb.triggers = make(map[ADDR]chan struct{})
+	eb.pruneFairQueues(eb.enabledAddresses)
 	eb.sequenceTracker.LoadNextSequences(ctx, eb.enabledAddresses)
 	for _, addr := range eb.enabledAddresses {
 		triggerCh := make(chan struct{}, 1)
@@ -457,6 +469,13 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 	}
 	cancel()
 
+	if checkerSpec.Simulate {
+		if revertErr := eb.simulate(ctx, lgr, attempt); revertErr != nil {
+			etx.Error = null.StringFrom(fmt.Sprintf("transaction reverted during simulation: %s", revertErr.Error()))
+			return eb.saveFatallyErroredTransaction(lgr, etx), true
+		}
+	}
+
 	if err = eb.txStore.UpdateTxUnstartedToInProgress(ctx, etx, &attempt); errors.Is(err, ErrTxRemoved) {
 		eb.lggr.Debugw("tx removed", "txID", etx.ID, "subject", etx.Subject)
 		return nil, false
@@ -467,6 +486,27 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 	return eb.handleInProgressTx(ctx, *etx, attempt, time.Now())
 }
 
+// simulate runs the attempt against the pending state and returns the revert error if it would revert.
+// If the simulation cannot be completed within TransmitCheckTimeout, the transaction is sent anyway.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) simulate(ctx context.Context, lgr logger.SugaredLogger, attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error {
+	simulateCtx, cancel := context.WithTimeout(ctx, TransmitCheckTimeout)
+	defer cancel()
+	revertErr, err := eb.client.SimulateTransaction(simulateCtx, attempt)
+	if err != nil {
+		promTxSimulations.WithLabelValues(eb.chainID.String(), "failed").Inc()
+		lgr.Warnw("Transaction simulation failed, sending anyway", "err", err)
+		return nil
+	}
+	if revertErr != nil {
+		promTxSimulations.WithLabelValues(eb.chainID.String(), "reverted").Inc()
+		lgr.Warnw("Transaction reverted during simulation, fatally erroring transaction.", "err", revertErr)
+		return revertErr
+	}
+	promTxSimulations.WithLabelValues(eb.chainID.String(), "succeeded").Inc()
+	lgr.Debug("Transaction simulation succeeded")
+	return nil
+}
+
 // There can be at most one in_progress transaction per address.
 // Here we complete the job that we didn't finish last time.
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) handleInProgressTx(ctx context.Context, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], initialBroadcastAt time.Time) (error, bool) {
@@ -605,14 +645,19 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) hand
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) nextUnstartedTransactionWithSequence(fromAddress ADDR) (*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
 	ctx, cancel := eb.chStop.NewCtx()
 	defer cancel()
-	etx, err := eb.txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, eb.chainID)
+	heads, err := eb.txStore.FindUnstartedTransactionQueueHeads(ctx, fromAddress, eb.chainID)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			// Finish. No more transactions left to process. Hoorah!
-			return nil, nil
-		}
-		return nil, fmt.Errorf("findNextUnstartedTransactionFromAddress failed: %w", err)
+		return nil, fmt.Errorf("findUnstartedTransactionQueueHeads failed: %w", err)
+	}
+	if len(heads) == 0 {
+		// Finish. No more transactions left to process. Hoorah!
+		return nil, nil
 	}
+	keys := make([]txQueueKey, len(heads))
+	for i, head := range heads {
+		keys[i] = txQueueKey{priority: head.Priority, subject: head.Subject}
+	}
+	etx := heads[eb.fairQueue(fromAddress).next(keys)]
 
 	sequence, err := eb.sequenceTracker.GetNextSequence(ctx, etx.FromAddress)
 	if err != nil {
@@ -622,6 +667,30 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) next
 	return etx, nil
 }
 
+// fairQueue returns the scheduler used to pick the next unstarted tx of the given address
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) fairQueue(fromAddress ADDR) *fairTxQueue {
+	eb.fairQueuesMu.Lock()
+	defer eb.fairQueuesMu.Unlock()
+	q, ok := eb.fairQueues[fromAddress]
+	if !ok {
+		q = newFairTxQueue()
+		eb.fairQueues[fromAddress] = q
+	}
+	return q
+}
+
+// pruneFairQueues drops the schedulers of addresses which are no longer enabled.
+// The TxMgr restarts the Broadcaster whenever keys are added, disabled or removed.
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) pruneFairQueues(enabledAddresses []ADDR) {
+	eb.fairQueuesMu.Lock()
+	defer eb.fairQueuesMu.Unlock()
+	for addr := range eb.fairQueues {
+		if !slices.Contains(enabledAddresses, addr) {
+			delete(eb.fairQueues, addr)
+		}
+	}
+}
+
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) tryAgainBumpingGas(ctx context.Context, lgr logger.Logger, txError error, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], initialBroadcastAt time.Time) (err error, retryable bool) {
 	logger.With(lgr,
 		"sendError", txError,
@@ -0,0 +1,113 @@
+package txmgr
+
+import (
+	"github.com/google/uuid"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+)
+
+// txQueueKey identifies a queue of unstarted txes from a single address. Txes within a queue are always
+// broadcast in the order they were created.
+type txQueueKey struct {
+	priority txmgrtypes.TxPriority
+	subject  uuid.NullUUID
+}
+
+// fairTxQueue decides which queue the next unstarted tx of an address is taken from, using
+// hierarchical weighted fair queueing:
+//   - each priority lane with unstarted txes is served in proportion to its TxPriority.Weight
+//   - within a lane, each subject with unstarted txes is served in turn
+//
+// Lanes and subjects that run out of unstarted txes are forgotten, and rejoin at the same
+// position as the least served active lane or subject, so that idle periods cannot be banked.
+//
+// fairTxQueue is not safe for concurrent use. The Broadcaster keeps one per address, which is
+// only used by the goroutine monitoring that address.
+type fairTxQueue struct {
+	lanes map[txmgrtypes.TxPriority]*txLane
+}
+
+type txLane struct {
+	// virtualTime advances by 1/weight each time the lane is served
+	virtualTime float64
+	// served counts how many txes were taken from each subject of the lane
+	served map[uuid.NullUUID]uint64
+}
+
+func newFairTxQueue() *fairTxQueue {
+	return &fairTxQueue{lanes: make(map[txmgrtypes.TxPriority]*txLane)}
+}
+
+// next returns the index of the queue head that should be broadcast next.
+// heads must not be empty, hold at most one entry per queue, and be sorted in the order the
+// heads would be broadcast in if all queues were served equally.
+func (q *fairTxQueue) next(heads []txQueueKey) int {
+	active := make(map[txmgrtypes.TxPriority][]int)
+	for i, head := range heads {
+		active[head.priority] = append(active[head.priority], i)
+	}
+
+	minVirtualTime, found := 0.0, false
+	for priority, lane := range q.lanes {
+		if _, ok := active[priority]; !ok {
+			delete(q.lanes, priority)
+			continue
+		}
+		if !found || lane.virtualTime < minVirtualTime {
+			minVirtualTime, found = lane.virtualTime, true
+		}
+	}
+
+	var selected txmgrtypes.TxPriority
+	var selectedLane *txLane
+	for priority := range active {
+		lane, ok := q.lanes[priority]
+		if !ok {
+			lane = &txLane{virtualTime: minVirtualTime, served: make(map[uuid.NullUUID]uint64)}
+			q.lanes[priority] = lane
+		}
+		if selectedLane == nil || lane.virtualTime < selectedLane.virtualTime ||
+			(lane.virtualTime == selectedLane.virtualTime && priority > selected) {
+			selected, selectedLane = priority, lane
+		}
+	}
+
+	i := selectedLane.next(heads, active[selected])
+	selectedLane.virtualTime += 1 / selected.Weight()
+	selectedLane.served[heads[i].subject]++
+	return i
+}
+
+// next returns the index of the least served head among the given indexes of heads, which all belong to this lane.
+// Ties are broken by the order of heads.
+func (l *txLane) next(heads []txQueueKey, indexes []int) int {
+	active := make(map[uuid.NullUUID]struct{}, len(indexes))
+	for _, i := range indexes {
+		active[heads[i].subject] = struct{}{}
+	}
+
+	var minServed uint64
+	found := false
+	for subject, served := range l.served {
+		if _, ok := active[subject]; !ok {
+			delete(l.served, subject)
+			continue
+		}
+		if !found || served < minServed {
+			minServed, found = served, true
+		}
+	}
+
+	selected := -1
+	for _, i := range indexes {
+		served, ok := l.served[heads[i].subject]
+		if !ok {
+			served = minServed
+			l.served[heads[i].subject] = served
+		}
+		if selected < 0 || served < l.served[heads[selected].subject] {
+			selected = i
+		}
+	}
+	return selected
+}
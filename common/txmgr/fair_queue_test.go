@@ -0,0 +1,84 @@
+package txmgr
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+)
+
+func TestFairTxQueue(t *testing.T) {
+	t.Parallel()
+
+	subjectA := uuid.NullUUID{UUID: uuid.New(), Valid: true}
+	subjectB := uuid.NullUUID{UUID: uuid.New(), Valid: true}
+
+	t.Run("single queue is always picked", func(t *testing.T) {
+		q := newFairTxQueue()
+		heads := []txQueueKey{{priority: txmgrtypes.TxPriorityNormal}}
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, 0, q.next(heads))
+		}
+	})
+
+	t.Run("priority lanes are served in proportion to their weight", func(t *testing.T) {
+		q := newFairTxQueue()
+		heads := []txQueueKey{
+			{priority: txmgrtypes.TxPriorityLow},
+			{priority: txmgrtypes.TxPriorityNormal},
+			{priority: txmgrtypes.TxPriorityCritical},
+		}
+		served := make(map[txmgrtypes.TxPriority]int)
+		for i := 0; i < 110; i++ {
+			served[heads[q.next(heads)].priority]++
+		}
+		assert.Equal(t, 10, served[txmgrtypes.TxPriorityLow])
+		assert.Equal(t, 20, served[txmgrtypes.TxPriorityNormal])
+		assert.Equal(t, 80, served[txmgrtypes.TxPriorityCritical])
+	})
+
+	t.Run("ties go to the higher priority", func(t *testing.T) {
+		q := newFairTxQueue()
+		heads := []txQueueKey{
+			{priority: txmgrtypes.TxPriorityNormal},
+			{priority: txmgrtypes.TxPriorityHigh},
+		}
+		assert.Equal(t, 1, q.next(heads))
+		assert.Equal(t, 0, q.next(heads))
+	})
+
+	t.Run("subjects within a lane take turns", func(t *testing.T) {
+		q := newFairTxQueue()
+		heads := []txQueueKey{
+			{priority: txmgrtypes.TxPriorityNormal, subject: subjectA},
+			{priority: txmgrtypes.TxPriorityNormal, subject: subjectB},
+			{priority: txmgrtypes.TxPriorityNormal},
+		}
+		var picked []int
+		for i := 0; i < 6; i++ {
+			picked = append(picked, q.next(heads))
+		}
+		assert.Equal(t, []int{0, 1, 2, 0, 1, 2}, picked)
+	})
+
+	t.Run("idle lanes rejoin without banked credit", func(t *testing.T) {
+		q := newFairTxQueue()
+		normal := []txQueueKey{{priority: txmgrtypes.TxPriorityNormal}}
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, 0, q.next(normal))
+		}
+
+		both := []txQueueKey{
+			{priority: txmgrtypes.TxPriorityNormal},
+			{priority: txmgrtypes.TxPriorityLow},
+		}
+		served := make(map[txmgrtypes.TxPriority]int)
+		for i := 0; i < 6; i++ {
+			served[both[q.next(both)].priority]++
+		}
+		assert.Equal(t, 4, served[txmgrtypes.TxPriorityNormal])
+		assert.Equal(t, 2, served[txmgrtypes.TxPriorityLow])
+	})
+}
@@ -30,7 +30,8 @@ func NewSendEveryStrategy() txmgrtypes.TxStrategy {
 // SendEveryStrategy will always send the tx
 type SendEveryStrategy struct{}
 
-func (SendEveryStrategy) Subject() uuid.NullUUID { return uuid.NullUUID{} }
+func (SendEveryStrategy) Subject() uuid.NullUUID          { return uuid.NullUUID{} }
+func (SendEveryStrategy) Priority() txmgrtypes.TxPriority { return txmgrtypes.TxPriorityNormal }
 func (SendEveryStrategy) PruneQueue(ctx context.Context, pruneService txmgrtypes.UnstartedTxQueuePruner) ([]int64, error) {
 	return nil, nil
 }
@@ -54,6 +55,10 @@ func (s DropOldestStrategy) Subject() uuid.NullUUID {
 	return uuid.NullUUID{UUID: s.subject, Valid: true}
 }
 
+func (s DropOldestStrategy) Priority() txmgrtypes.TxPriority {
+	return txmgrtypes.TxPriorityNormal
+}
+
 func (s DropOldestStrategy) PruneQueue(ctx context.Context, pruneService txmgrtypes.UnstartedTxQueuePruner) (ids []int64, err error) {
 	// NOTE: We prune one less than the queue size to prevent the queue from exceeding the max queue size. Which could occur if a new transaction is added to the queue right after we prune.
 	ids, err = pruneService.PruneUnstartedTxQueue(ctx, s.queueSize-1, s.subject)
@@ -62,3 +67,21 @@ func (s DropOldestStrategy) PruneQueue(ctx context.Context, pruneService txmgrty
 	}
 	return
 }
+
+var _ txmgrtypes.TxStrategy = PriorityStrategy{}
+
+// PriorityStrategy queues and prunes txes like the wrapped strategy, but
+// broadcasts them in the given priority lane
+type PriorityStrategy struct {
+	txmgrtypes.TxStrategy
+	priority txmgrtypes.TxPriority
+}
+
+// NewPriorityStrategy wraps strategy so that its txes are broadcast with the given priority.
+func NewPriorityStrategy(strategy txmgrtypes.TxStrategy, priority txmgrtypes.TxPriority) PriorityStrategy {
+	return PriorityStrategy{strategy, priority}
+}
+
+func (s PriorityStrategy) Priority() txmgrtypes.TxPriority {
+	return s.priority
+}
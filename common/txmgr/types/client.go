@@ -73,6 +73,13 @@ type TransactionClient[
 		attempt TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
 		blockNumber *big.Int,
 	) (rpcErr fmt.Stringer, extractErr error)
+	// SimulateTransaction executes the attempt against the pending state without broadcasting it.
+	// revertErr is set, with the decoded revert reason, if the transaction would revert.
+	// err is set if the simulation could not be completed.
+	SimulateTransaction(
+		ctx context.Context,
+		attempt TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE],
+	) (revertErr error, err error)
 }
 
 // ChainClient contains the interfaces for reading chain parameters (chain id, sequences, etc)
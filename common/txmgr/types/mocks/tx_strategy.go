@@ -16,6 +16,24 @@ type TxStrategy struct {
 	mock.Mock
 }
 
+// Priority provides a mock function with given fields:
+func (_m *TxStrategy) Priority() types.TxPriority {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Priority")
+	}
+
+	var r0 types.TxPriority
+	if rf, ok := ret.Get(0).(func() types.TxPriority); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(types.TxPriority)
+	}
+
+	return r0
+}
+
 // PruneQueue provides a mock function with given fields: ctx, pruneService
 func (_m *TxStrategy) PruneQueue(ctx context.Context, pruneService types.UnstartedTxQueuePruner) ([]int64, error) {
 	ret := _m.Called(ctx, pruneService)
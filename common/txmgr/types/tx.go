@@ -31,6 +31,62 @@ type TxStrategy interface {
 	// It accepts the service responsible for deleting
 	// unstarted txs and deletion options
 	PruneQueue(ctx context.Context, pruneService UnstartedTxQueuePruner) (ids []int64, err error)
+	// Priority will be saved to txes.priority and decides how often the
+	// Broadcaster picks txes of this strategy relative to other priorities
+	Priority() TxPriority
+}
+
+// TxPriority is the priority lane of a tx. Unstarted txes from the same address are
+// broadcast using weighted fair queueing: each priority lane is served in proportion to its
+// weight, and within a lane each subject gets an equal share.
+type TxPriority int8
+
+const (
+	TxPriorityLow TxPriority = iota - 1
+	TxPriorityNormal
+	TxPriorityHigh
+	TxPriorityCritical
+)
+
+var txPriorityStrings = map[TxPriority]string{
+	TxPriorityLow:      "low",
+	TxPriorityNormal:   "normal",
+	TxPriorityHigh:     "high",
+	TxPriorityCritical: "critical",
+}
+
+// NewTxPriority parses a priority name, which is case-insensitive. The empty string is TxPriorityNormal.
+func NewTxPriority(s string) (TxPriority, error) {
+	if s == "" {
+		return TxPriorityNormal, nil
+	}
+	for p, str := range txPriorityStrings {
+		if strings.EqualFold(s, str) {
+			return p, nil
+		}
+	}
+	return TxPriorityNormal, fmt.Errorf("unknown tx priority %q: must be one of low, normal, high or critical", s)
+}
+
+// String returns string formatted priorities for logging
+func (p TxPriority) String() string {
+	if str, ok := txPriorityStrings[p]; ok {
+		return str
+	}
+	return fmt.Sprintf("TxPriority(%d)", int8(p))
+}
+
+// Weight is the relative share of broadcasts the lane gets while other lanes also have unstarted txes.
+// Each priority level doubles the share of the level below it.
+func (p TxPriority) Weight() float64 {
+	switch {
+	case p <= TxPriorityLow:
+		return 1
+	case p >= TxPriorityCritical:
+		return 8
+	default:
+		return float64(int(1) << (p + 1))
+	}
 }
 
 type TxAttemptState int8
@@ -95,6 +151,9 @@ type TxRequest[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 
 	// Mark tx requiring callback
 	SignalCallback bool
+
+	// Blobs is the data carried by an EIP-4844 blob transaction. If set, the Tx is sent as a blob transaction.
+	Blobs [][]byte
 }
 
 // TransmitCheckerSpec defines the check that should be performed before a transaction is submitted
@@ -110,6 +169,10 @@ type TransmitCheckerSpec[ADDR types.Hashable] struct {
 	// VRFRequestBlockNumber is the block number in which the provided VRF request has been made.
 	// This should be set iff CheckerType is TransmitCheckerTypeVRFV2.
 	VRFRequestBlockNumber *big.Int `json:",omitempty"`
+
+	// Simulate runs the transaction against the pending state right before it is first broadcast, and
+	// fatally errors it with the decoded revert reason if it would revert. It can be combined with any CheckerType.
+	Simulate bool `json:",omitempty"`
 }
 
 // TransmitCheckerType describes the type of check that should be performed before a transaction is
@@ -219,9 +282,10 @@ type Tx[
 	// Marshalled TxMeta
 	// Used for additional context around transactions which you want to log
 	// at send time.
-	Meta    *sqlutil.JSON
-	Subject uuid.NullUUID
-	ChainID CHAIN_ID
+	Meta     *sqlutil.JSON
+	Subject  uuid.NullUUID
+	Priority TxPriority
+	ChainID  CHAIN_ID
 
 	PipelineTaskRunID uuid.NullUUID
 	MinConfirmations  clnull.Uint32
@@ -234,6 +298,9 @@ type Tx[
 	SignalCallback bool
 	// Marks tx callback as signaled
 	CallbackCompleted bool
+
+	// Blobs is the data carried by an EIP-4844 blob transaction
+	Blobs [][]byte `json:"-"`
 }
 
 func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) GetError() error {
@@ -48,3 +48,37 @@ func TestTxAttemptState(t *testing.T) {
 		}
 	})
 }
+
+func TestTxPriority(t *testing.T) {
+	t.Run("NewTxPriority", func(t *testing.T) {
+		for str, priority := range map[string]TxPriority{
+			"":         TxPriorityNormal,
+			"low":      TxPriorityLow,
+			"normal":   TxPriorityNormal,
+			"High":     TxPriorityHigh,
+			"CRITICAL": TxPriorityCritical,
+		} {
+			p, err := NewTxPriority(str)
+			assert.NoError(t, err)
+			assert.Equal(t, priority, p)
+		}
+
+		_, err := NewTxPriority("urgent")
+		assert.EqualError(t, err, `unknown tx priority "urgent": must be one of low, normal, high or critical`)
+	})
+
+	t.Run("String", func(t *testing.T) {
+		assert.Equal(t, "low", TxPriorityLow.String())
+		assert.Equal(t, "critical", TxPriorityCritical.String())
+		assert.Equal(t, "TxPriority(100)", TxPriority(100).String())
+	})
+
+	t.Run("Weight", func(t *testing.T) {
+		assert.Equal(t, float64(1), TxPriorityLow.Weight())
+		assert.Equal(t, float64(2), TxPriorityNormal.Weight())
+		assert.Equal(t, float64(4), TxPriorityHigh.Weight())
+		assert.Equal(t, float64(8), TxPriorityCritical.Weight())
+		assert.Equal(t, float64(1), TxPriority(-100).Weight())
+		assert.Equal(t, float64(8), TxPriority(100).Weight())
+	})
+}
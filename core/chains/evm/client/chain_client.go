@@ -38,6 +38,9 @@ type Client interface {
 	// NodeStates returns a map of node Name->node state
 	// It might be nil or empty, e.g. for mock clients etc
 	NodeStates() map[string]string
+	// NodeLatencies returns a map of node Name->latency averages, which are used by the LowestLatency selection mode
+	// It might be nil or empty, e.g. for mock clients etc
+	NodeLatencies() map[string]commonclient.NodeLatency
 
 	TokenBalance(ctx context.Context, address common.Address, contractAddress common.Address) (*big.Int, error)
 	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
@@ -131,6 +134,7 @@ func NewChainClient(
 	chainID *big.Int,
 	chainType config.ChainType,
 	clientErrors evmconfig.ClientErrors,
+	readCfg commonclient.ReadConfig,
 ) Client {
 	multiNode := commonclient.NewMultiNode(
 		lggr,
@@ -146,6 +150,7 @@ func NewChainClient(
 			return ClassifySendError(err, clientErrors, logger.Sugared(logger.Nop()), tx, common.Address{}, chainType.IsL2())
 		},
 		0, // use the default value provided by the implementation
+		readCfg,
 	)
 	return &chainClient{
 		multiNode:    multiNode,
@@ -269,6 +274,10 @@ func (c *chainClient) NodeStates() map[string]string {
 	return c.multiNode.NodeStates()
 }
 
+func (c *chainClient) NodeLatencies() map[string]commonclient.NodeLatency {
+	return c.multiNode.NodeLatencies()
+}
+
 func (c *chainClient) PendingCodeAt(ctx context.Context, account common.Address) (b []byte, err error) {
 	rpc, err := c.multiNode.SelectNodeRPC()
 	if err != nil {
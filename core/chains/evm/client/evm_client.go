@@ -34,5 +34,5 @@ func NewEvmClient(cfg evmconfig.NodePool, chainCfg commonclient.ChainConfig, cli
 	}
 
 	return NewChainClient(lggr, cfg.SelectionMode(), cfg.LeaseDuration(), chainCfg.NodeNoNewHeadsThreshold(),
-		primaries, sendonlys, chainID, chainCfg.ChainType(), clientErrors)
+		primaries, sendonlys, chainID, chainCfg.ChainType(), clientErrors, cfg.Reads())
 }
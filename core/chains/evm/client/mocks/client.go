@@ -617,6 +617,26 @@ func (_m *Client) LatestFinalizedBlock(ctx context.Context) (*evmtypes.Head, err
 	return r0, r1
 }
 
+// NodeLatencies provides a mock function with given fields:
+func (_m *Client) NodeLatencies() map[string]commonclient.NodeLatency {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for NodeLatencies")
+	}
+
+	var r0 map[string]commonclient.NodeLatency
+	if rf, ok := ret.Get(0).(func() map[string]commonclient.NodeLatency); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]commonclient.NodeLatency)
+		}
+	}
+
+	return r0
+}
+
 // NodeStates provides a mock function with given fields:
 func (_m *Client) NodeStates() map[string]string {
 	ret := _m.Called()
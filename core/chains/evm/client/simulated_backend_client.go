@@ -507,6 +507,9 @@ func (c *SimulatedBackendClient) Backend() *backends.SimulatedBackend {
 // NodeStates implements evmclient.Client
 func (c *SimulatedBackendClient) NodeStates() map[string]string { return nil }
 
+// NodeLatencies implements evmclient.Client
+func (c *SimulatedBackendClient) NodeLatencies() map[string]commonclient.NodeLatency { return nil }
+
 // Commit imports all the pending transactions as a single block and starts a
 // fresh new state.
 func (c *SimulatedBackendClient) Commit() common.Hash {
@@ -2,8 +2,11 @@ package client
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -28,6 +31,90 @@ func simulateTransactionDefault(ctx context.Context, client simulatorClient, msg
 	return client.CallContext(ctx, &result, "eth_estimateGas", toCallArg(msg), "pending")
 }
 
+// RevertError is returned by SimulateCall when the simulated call reverts
+type RevertError struct {
+	// Reason is the decoded revert reason
+	Reason string
+	// Data is the raw revert data returned by the RPC, if any
+	Data []byte
+}
+
+func (e *RevertError) Error() string {
+	return fmt.Sprintf("execution reverted: %s", e.Reason)
+}
+
+// SimulateCall executes msg with eth_call against the pending state.
+// It returns a *RevertError if the call would revert, and any other error if the simulation could not be completed.
+func SimulateCall(ctx context.Context, client simulatorClient, msg ethereum.CallMsg) error {
+	var result hexutil.Bytes
+	err := client.CallContext(ctx, &result, "eth_call", toCallArg(msg), "pending")
+	if err == nil {
+		return nil
+	}
+	jErr, extractErr := ExtractRPCError(err)
+	if extractErr != nil || !isRevert(jErr) {
+		return err
+	}
+	return newRevertError(jErr)
+}
+
+// isRevert distinguishes execution reverts from other JSON-RPC errors, such as a node being unable to serve the request.
+// Providers also attach data to errors which are not reverts (e.g. rate limits), so data alone is only trusted if it
+// has the shape of an ABI encoded revert payload.
+func isRevert(jErr *JsonError) bool {
+	// geth and most of its forks use error code 3 for reverts with data
+	if jErr.Code == 3 {
+		return true
+	}
+	return strings.Contains(strings.ToLower(jErr.Message), "revert") || isRevertPayload(revertData(jErr))
+}
+
+// isRevertPayload reports whether data is a 4 byte selector (Error(string), Panic(uint256) or a custom error)
+// followed by zero or more ABI encoded 32 byte words.
+func isRevertPayload(data []byte) bool {
+	return len(data) >= 4 && (len(data)-4)%32 == 0
+}
+
+// revertData returns the hex encoded data of the RPC error, or nil if there is none.
+func revertData(jErr *JsonError) []byte {
+	data, ok := jErr.Data.(string)
+	if !ok {
+		return nil
+	}
+	// some RPCs prefix the revert data, e.g. parity: "Reverted 0xABC123..."
+	i := strings.Index(data, "0x")
+	if i < 0 {
+		return nil
+	}
+	b, err := hexutil.Decode(strings.Fields(data[i:])[0])
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+// newRevertError decodes the revert reason from the revert data if present, falling back to the RPC error message.
+// Error(string) and Panic(uint256) are decoded. Custom errors are reported by their selector, since decoding them
+// requires the contract ABI.
+func newRevertError(jErr *JsonError) *RevertError {
+	revertErr := &RevertError{
+		Reason: strings.TrimPrefix(strings.TrimPrefix(jErr.Message, "execution reverted"), ": "),
+		Data:   revertData(jErr),
+	}
+	if revertErr.Reason == "" {
+		revertErr.Reason = "no reason given"
+	}
+	if len(revertErr.Data) < 4 {
+		return revertErr
+	}
+	if reason, err := abi.UnpackRevert(revertErr.Data); err == nil {
+		revertErr.Reason = reason
+	} else {
+		revertErr.Reason = fmt.Sprintf("custom error %s (data: %s)", hexutil.Encode(revertErr.Data[:4]), hexutil.Encode(revertErr.Data))
+	}
+	return revertErr
+}
+
 func toCallArg(msg ethereum.CallMsg) interface{} {
 	arg := map[string]interface{}{
 		"from": msg.From,
@@ -1,9 +1,14 @@
 package client_test
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
 
@@ -111,3 +116,89 @@ func TestSimulateTx_Default(t *testing.T) {
 		require.Equal(t, false, sendErr.IsOutOfCounters(nil))
 	})
 }
+
+type callContextFunc func(ctx context.Context, result interface{}, method string, args ...interface{}) error
+
+func (f callContextFunc) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	return f(ctx, result, method, args...)
+}
+
+type testRPCError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+func (e testRPCError) Error() string { return e.Message }
+
+func TestSimulateCall(t *testing.T) {
+	t.Parallel()
+
+	toAddress := testutils.NewAddress()
+	msg := ethereum.CallMsg{From: testutils.NewAddress(), To: &toAddress, Data: []byte{1, 2, 3}}
+	simulate := func(err error) error {
+		return client.SimulateCall(tests.Context(t), callContextFunc(func(_ context.Context, _ interface{}, method string, args ...interface{}) error {
+			assert.Equal(t, "eth_call", method)
+			assert.Equal(t, "pending", args[1])
+			return err
+		}), msg)
+	}
+
+	stringType, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	reason, err := abi.Arguments{{Type: stringType}}.Pack("not enough balance")
+	require.NoError(t, err)
+	errorStringData := append(hexutil.MustDecode("0x08c379a0"), reason...)
+
+	t.Run("succeeds", func(t *testing.T) {
+		require.NoError(t, simulate(nil))
+	})
+
+	t.Run("decodes Error(string)", func(t *testing.T) {
+		err := simulate(testRPCError{Code: 3, Message: "execution reverted: not enough balance", Data: hexutil.Encode(errorStringData)})
+		var revertErr *client.RevertError
+		require.ErrorAs(t, err, &revertErr)
+		assert.Equal(t, "not enough balance", revertErr.Reason)
+		assert.Equal(t, errorStringData, revertErr.Data)
+		assert.EqualError(t, err, "execution reverted: not enough balance")
+	})
+
+	t.Run("decodes prefixed revert data", func(t *testing.T) {
+		err := simulate(testRPCError{Code: -32015, Message: "VM execution error.", Data: "Reverted " + hexutil.Encode(errorStringData)})
+		assert.EqualError(t, err, "execution reverted: not enough balance")
+	})
+
+	t.Run("reports custom errors by selector", func(t *testing.T) {
+		err := simulate(testRPCError{Code: 3, Message: "execution reverted", Data: "0xdeadbeef0001"})
+		assert.EqualError(t, err, "execution reverted: custom error 0xdeadbeef (data: 0xdeadbeef0001)")
+	})
+
+	t.Run("falls back to the RPC message", func(t *testing.T) {
+		err := simulate(testRPCError{Code: -32000, Message: "execution reverted"})
+		assert.EqualError(t, err, "execution reverted: no reason given")
+	})
+
+	t.Run("returns other errors as is", func(t *testing.T) {
+		rpcErr := testRPCError{Code: -32000, Message: "header not found"}
+		err := simulate(rpcErr)
+		var revertErr *client.RevertError
+		assert.False(t, errors.As(err, &revertErr))
+		assert.Equal(t, rpcErr, err)
+
+		err = simulate(context.DeadlineExceeded)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("does not treat errors with non-revert data as reverts", func(t *testing.T) {
+		for _, rpcErr := range []testRPCError{
+			{Code: 429, Message: "rate limit exceeded", Data: map[string]interface{}{"retryAfter": 5}},
+			{Code: -32000, Message: "header not found", Data: "block 0x1234 is not available"},
+			{Code: -32005, Message: "limit exceeded", Data: "0xdeadbeef0001"},
+		} {
+			err := simulate(rpcErr)
+			var revertErr *client.RevertError
+			assert.False(t, errors.As(err, &revertErr), rpcErr.Message)
+			assert.Equal(t, rpcErr, err)
+		}
+	})
+}
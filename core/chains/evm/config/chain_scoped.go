@@ -179,3 +179,10 @@ func (e *EVMConfig) OperatorFactoryAddress() string {
 func (e *EVMConfig) LogPrunePageSize() uint32 {
 	return *e.C.LogPrunePageSize
 }
+
+func (e *EVMConfig) LogArchiveDir() string {
+	if e.C.LogArchiveDir == nil {
+		return ""
+	}
+	return *e.C.LogArchiveDir
+}
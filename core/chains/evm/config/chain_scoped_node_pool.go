@@ -41,3 +41,29 @@ func (n *NodePoolConfig) FinalizedBlockPollInterval() time.Duration {
 func (n *NodePoolConfig) Errors() ClientErrors {
 	return &clientErrorsConfig{c: n.C.Errors}
 }
+
+func (n *NodePoolConfig) Reads() NodePoolReads {
+	return &nodePoolReadsConfig{c: n.C.Reads}
+}
+
+type nodePoolReadsConfig struct {
+	c toml.NodePoolReads
+}
+
+func (r *nodePoolReadsConfig) Mode() string { return derefOrDefault(r.c.Mode) }
+
+func (r *nodePoolReadsConfig) Methods() []string { return r.c.Methods }
+
+func (r *nodePoolReadsConfig) FanOut() uint32 {
+	if r.c.FanOut == nil {
+		return 0
+	}
+	return *r.c.FanOut
+}
+
+func (r *nodePoolReadsConfig) HedgeDelay() time.Duration {
+	if r.c.HedgeDelay == nil {
+		return 0
+	}
+	return r.c.HedgeDelay.Duration()
+}
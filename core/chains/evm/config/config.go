@@ -39,6 +39,7 @@ type EVM interface {
 	BackupLogPollerBlockDelay() uint64
 	LogPollInterval() time.Duration
 	LogPrunePageSize() uint32
+	LogArchiveDir() string
 	MinContractPayment() *commonassets.Link
 	MinIncomingConfirmations() uint32
 	NonceAutoSync() bool
@@ -170,6 +171,14 @@ type NodePool interface {
 	NodeIsSyncingEnabled() bool
 	FinalizedBlockPollInterval() time.Duration
 	Errors() ClientErrors
+	Reads() NodePoolReads
+}
+
+type NodePoolReads interface {
+	Mode() string
+	Methods() []string
+	FanOut() uint32
+	HedgeDelay() time.Duration
 }
 
 // TODO BCF-2509 does the chainscopedconfig really need the entire app config?
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
 	"github.com/pelletier/go-toml/v2"
@@ -17,6 +18,7 @@ import (
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	"github.com/smartcontractkit/chainlink/v2/common/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -350,6 +352,7 @@ type Chain struct {
 	LogPollInterval           *commonconfig.Duration
 	LogKeepBlocksDepth        *uint32
 	LogPrunePageSize          *uint32
+	LogArchiveDir             *string
 	BackupLogPollerBlockDelay *uint64
 	MinIncomingConfirmations  *uint32
 	MinContractPayment        *commonassets.Link
@@ -558,6 +561,9 @@ type GasEstimator struct {
 	BlockHistory BlockHistoryEstimator `toml:",omitempty"`
 }
 
+// maxFeeHistoryBlockCount is the maximum number of blocks a single eth_feeHistory call may return on most RPC nodes.
+const maxFeeHistoryBlockCount = 1024
+
 func (e *GasEstimator) ValidateConfig() (err error) {
 	if uint64(*e.BumpPercent) < legacypool.DefaultConfig.PriceBump {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BumpPercent", Value: *e.BumpPercent,
@@ -592,6 +598,15 @@ func (e *GasEstimator) ValidateConfig() (err error) {
 		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BlockHistory.BlockHistorySize", Value: *e.BlockHistory.BlockHistorySize,
 			Msg: "must be greater than or equal to 1 with BlockHistory Mode"})
 	}
+	if *e.Mode == "FeeHistory" {
+		if *e.BlockHistory.BlockHistorySize <= 0 {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BlockHistory.BlockHistorySize", Value: *e.BlockHistory.BlockHistorySize,
+				Msg: "must be greater than or equal to 1 with FeeHistory Mode"})
+		} else if *e.BlockHistory.BlockHistorySize > maxFeeHistoryBlockCount {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BlockHistory.BlockHistorySize", Value: *e.BlockHistory.BlockHistorySize,
+				Msg: fmt.Sprintf("must be less than or equal to %d with FeeHistory Mode", maxFeeHistoryBlockCount)})
+		}
+	}
 
 	return
 }
@@ -845,7 +860,8 @@ type NodePool struct {
 	LeaseDuration              *commonconfig.Duration
 	NodeIsSyncingEnabled       *bool
 	FinalizedBlockPollInterval *commonconfig.Duration
-	Errors                     ClientErrors `toml:",omitempty"`
+	Errors                     ClientErrors  `toml:",omitempty"`
+	Reads                      NodePoolReads `toml:",omitempty"`
 }
 
 func (p *NodePool) setFrom(f *NodePool) {
@@ -871,6 +887,55 @@ func (p *NodePool) setFrom(f *NodePool) {
 		p.FinalizedBlockPollInterval = v
 	}
 	p.Errors.setFrom(&f.Errors)
+	p.Reads.setFrom(&f.Reads)
+}
+
+type NodePoolReads struct {
+	Mode       *string                `toml:",omitempty"`
+	Methods    []string               `toml:",omitempty"`
+	FanOut     *uint32                `toml:",omitempty"`
+	HedgeDelay *commonconfig.Duration `toml:",omitempty"`
+}
+
+func (r *NodePoolReads) setFrom(f *NodePoolReads) {
+	if v := f.Mode; v != nil {
+		r.Mode = v
+	}
+	if v := f.Methods; v != nil {
+		r.Methods = v
+	}
+	if v := f.FanOut; v != nil {
+		r.FanOut = v
+	}
+	if v := f.HedgeDelay; v != nil {
+		r.HedgeDelay = v
+	}
+}
+
+func (r *NodePoolReads) ValidateConfig() (err error) {
+	if r.Mode != nil && !slices.Contains(commonclient.ReadModes, *r.Mode) {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Mode", Value: *r.Mode,
+			Msg: fmt.Sprintf("must be one of: %s", strings.Join(commonclient.ReadModes, ", "))})
+	}
+	for i, method := range r.Methods {
+		if !slices.Contains(commonclient.ReadMethods, method) {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: fmt.Sprintf("Methods.%d", i), Value: method,
+				Msg: fmt.Sprintf("must be one of: %s", strings.Join(commonclient.ReadMethods, ", "))})
+		}
+	}
+	if r.FanOut != nil && *r.FanOut == 1 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "FanOut", Value: *r.FanOut,
+			Msg: "must be 0 (all alive nodes) or greater than 1"})
+	}
+	if r.Mode != nil && *r.Mode == commonclient.ReadModeHedged {
+		if r.HedgeDelay == nil {
+			err = multierr.Append(err, commonconfig.ErrMissing{Name: "HedgeDelay", Msg: "required when Mode is Hedged"})
+		} else if r.HedgeDelay.Duration() <= 0 {
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "HedgeDelay", Value: r.HedgeDelay.Duration(),
+				Msg: "must be greater than 0 when Mode is Hedged"})
+		}
+	}
+	return
 }
 
 type OCR struct {
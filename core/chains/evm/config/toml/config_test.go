@@ -3,11 +3,13 @@ package toml_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/config"
 
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 )
 
@@ -29,3 +31,27 @@ func TestEVMConfig_ValidateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestNodePoolReads_ValidateConfig(t *testing.T) {
+	mode := "Quorum"
+	reads := toml.NodePoolReads{Mode: &mode, Methods: []string{"CallContract", "BalanceAt"}}
+	assert.NoError(t, reads.ValidateConfig())
+
+	invalidMode := "Fastest"
+	fanOut := uint32(1)
+	reads = toml.NodePoolReads{Mode: &invalidMode, Methods: []string{"CallContract", "SendTransaction"}, FanOut: &fanOut}
+	err := reads.ValidateConfig()
+	assert.ErrorContains(t, err, "Mode: invalid value (Fastest)")
+	assert.ErrorContains(t, err, "Methods.1: invalid value (SendTransaction)")
+	assert.ErrorContains(t, err, "FanOut: invalid value (1)")
+
+	hedged := commonclient.ReadModeHedged
+	reads = toml.NodePoolReads{Mode: &hedged, Methods: []string{"CallContract"}}
+	assert.ErrorContains(t, reads.ValidateConfig(), "HedgeDelay: missing: required when Mode is Hedged")
+
+	reads.HedgeDelay = config.MustNewDuration(0)
+	assert.ErrorContains(t, reads.ValidateConfig(), "HedgeDelay: invalid value (0s): must be greater than 0 when Mode is Hedged")
+
+	reads.HedgeDelay = config.MustNewDuration(250 * time.Millisecond)
+	assert.NoError(t, reads.ValidateConfig())
+}
@@ -137,6 +137,9 @@ func (c *Chain) SetFrom(f *Chain) {
 	if v := f.LogPrunePageSize; v != nil {
 		c.LogPrunePageSize = v
 	}
+	if v := f.LogArchiveDir; v != nil {
+		c.LogArchiveDir = v
+	}
 	if v := f.BackupLogPollerBlockDelay; v != nil {
 		c.BackupLogPollerBlockDelay = v
 	}
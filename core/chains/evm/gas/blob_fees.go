@@ -0,0 +1,62 @@
+package gas
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/params"
+	pkgerrors "github.com/pkg/errors"
+
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/label"
+)
+
+// BlobFeeBumpPercent is the minimum percentage by which every fee of a blob transaction must be raised for nodes
+// to accept it as a replacement.
+// See: https://github.com/ethereum/go-ethereum/blob/v1.13.8/core/txpool/blobpool/config.go#L34
+const BlobFeeBumpPercent = 100
+
+// BlobFeeEstimator is implemented by EvmEstimators that can estimate fees for EIP-4844 blob transactions
+type BlobFeeEstimator interface {
+	// GetBlobFee Calculates the initial blob fee cap (max fee per blob gas) for blob transactions
+	// maxBlobFeeWei parameter is the highest possible blob fee cap that the function will return
+	GetBlobFee(ctx context.Context, maxBlobFeeWei *assets.Wei) (blobFeeCap *assets.Wei, err error)
+}
+
+// calcBlobFeeCap returns the blob base fee of the block following a head with the given excess blob gas and blob
+// gas used, assuming the worst case of bufferBlocks full blocks after it. Each full block raises the excess blob gas
+// by the target blob gas per block.
+func calcBlobFeeCap(excessBlobGas, blobGasUsed uint64, bufferBlocks int, maxBlobFeeWei *assets.Wei) *assets.Wei {
+	nextExcessBlobGas := eip4844.CalcExcessBlobGas(excessBlobGas, blobGasUsed)
+	for i := 0; i < bufferBlocks; i++ {
+		nextExcessBlobGas += params.BlobTxTargetBlobGasPerBlock
+	}
+	blobFeeCap := assets.NewWei(eip4844.CalcBlobFee(nextExcessBlobGas))
+	if blobFeeCap.Cmp(maxBlobFeeWei) > 0 {
+		return maxBlobFeeWei
+	}
+	return blobFeeCap
+}
+
+// bumpBlobFee raises each fee of a blob transaction by at least BlobFeeBumpPercent, as required by nodes to
+// replace it. bumpedDynamic is the dynamic fee bumped by the estimator, which is used instead if higher, and
+// currentBlobFeeCap is the blob fee cap currently estimated for new transactions, if any.
+func bumpBlobFee(originalFee EvmFee, bumpedDynamic DynamicFee, currentBlobFeeCap, maxGasPrice *assets.Wei) (bumped EvmFee, err error) {
+	bumped.DynamicTipCap = assets.WeiMax(bumpedDynamic.TipCap, originalFee.DynamicTipCap.AddPercentage(BlobFeeBumpPercent))
+	bumped.DynamicFeeCap = assets.WeiMax(bumpedDynamic.FeeCap, originalFee.DynamicFeeCap.AddPercentage(BlobFeeBumpPercent))
+	bumped.BlobFeeCap = originalFee.BlobFeeCap.AddPercentage(BlobFeeBumpPercent)
+	if currentBlobFeeCap != nil {
+		bumped.BlobFeeCap = assets.WeiMax(bumped.BlobFeeCap, currentBlobFeeCap)
+	}
+
+	if bumped.DynamicFeeCap.Cmp(maxGasPrice) > 0 {
+		return bumped, pkgerrors.Wrapf(commonfee.ErrBumpFeeExceedsLimit, "bumped fee cap of %s would exceed configured max gas price of %s (original fee: tip cap %s, fee cap %s). %s",
+			bumped.DynamicFeeCap.String(), maxGasPrice, originalFee.DynamicTipCap.String(), originalFee.DynamicFeeCap.String(), label.NodeConnectivityProblemWarning)
+	}
+	if bumped.BlobFeeCap.Cmp(maxGasPrice) > 0 {
+		return bumped, pkgerrors.Wrapf(commonfee.ErrBumpFeeExceedsLimit, "bumped blob fee cap of %s would exceed configured max gas price of %s (original blob fee cap: %s). %s",
+			bumped.BlobFeeCap.String(), maxGasPrice, originalFee.BlobFeeCap.String(), label.NodeConnectivityProblemWarning)
+	}
+	return bumped, nil
+}
@@ -352,7 +352,7 @@ func (b *BlockHistoryEstimator) checkConnectivity(attempts []EvmPriorAttempt) er
 		switch attempt.TxType {
 		case 0x0, 0x1:
 			eip1559 = false
-		case 0x2:
+		case 0x2, 0x3:
 			eip1559 = true
 		default:
 			return pkgerrors.Errorf("attempt %s has unknown transaction type 0x%d", attempt.TxHash, attempt.TxType)
@@ -460,6 +460,22 @@ func calcFeeCap(latestAvailableBaseFeePerGas *assets.Wei, bufferBlocks int, tipC
 	return feeCap
 }
 
+// GetBlobFee calculates the blob fee cap of a new EIP-4844 blob transaction from the excess blob gas of the latest head.
+// Like the dynamic fee cap, it leaves headroom for EIP1559FeeCapBufferBlocks full blocks before a bump is needed.
+func (b *BlockHistoryEstimator) GetBlobFee(_ context.Context, maxBlobFeeWei *assets.Wei) (blobFeeCap *assets.Wei, err error) {
+	b.latestMu.RLock()
+	latest := b.latest
+	b.latestMu.RUnlock()
+	if latest == nil {
+		return nil, pkgerrors.New("BlockHistoryEstimator: no latest head yet; cannot estimate blob fee")
+	}
+	if latest.ExcessBlobGas == nil || latest.BlobGasUsed == nil {
+		return nil, pkgerrors.New("BlockHistoryEstimator: latest head has no excess blob gas; cannot estimate blob fee. Are you trying to send blob transactions on a chain without EIP-4844?")
+	}
+	maxBlobFee := getMaxGasPrice(maxBlobFeeWei, b.eConfig.PriceMax())
+	return calcBlobFeeCap(*latest.ExcessBlobGas, *latest.BlobGasUsed, int(b.bhConfig.EIP1559FeeCapBufferBlocks()), maxBlobFee), nil
+}
+
 func (b *BlockHistoryEstimator) BumpDynamicFee(_ context.Context, originalFee DynamicFee, maxGasPriceWei *assets.Wei, attempts []EvmPriorAttempt) (bumped DynamicFee, err error) {
 	if b.bhConfig.CheckInclusionBlocks() > 0 {
 		if err = b.checkConnectivity(attempts); err != nil {
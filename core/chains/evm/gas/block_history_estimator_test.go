@@ -2125,6 +2125,64 @@ func TestBlockHistoryEstimator_GetDynamicFee(t *testing.T) {
 	})
 }
 
+func TestBlockHistoryEstimator_GetBlobFee(t *testing.T) {
+	t.Parallel()
+
+	cfg := gas.NewMockConfig()
+	bhCfg := newBlockHistoryConfig()
+	bhCfg.EIP1559FeeCapBufferBlocksF = uint16(4)
+	maxGasPrice := assets.NewWeiI(1_000_000_000_000)
+	geCfg := &gas.MockGasEstimatorConfig{}
+	geCfg.EIP1559DynamicFeesF = true
+	geCfg.PriceMaxF = maxGasPrice
+
+	bhe := newBlockHistoryEstimator(t, nil, cfg, geCfg, bhCfg, nil)
+
+	t.Run("if estimator has no latest head", func(t *testing.T) {
+		_, err := bhe.GetBlobFee(tests.Context(t), maxGasPrice)
+		require.EqualError(t, err, "BlockHistoryEstimator: no latest head yet; cannot estimate blob fee")
+	})
+
+	t.Run("if latest head has no excess blob gas", func(t *testing.T) {
+		bhe.OnNewLongestChain(tests.Context(t), testutils.Head(1))
+
+		_, err := bhe.GetBlobFee(tests.Context(t), maxGasPrice)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "latest head has no excess blob gas")
+	})
+
+	excessBlobGas, blobGasUsed := uint64(78_643_200), uint64(393_216)
+	h := testutils.Head(2)
+	h.ExcessBlobGas = &excessBlobGas
+	h.BlobGasUsed = &blobGasUsed
+	bhe.OnNewLongestChain(tests.Context(t), h)
+
+	t.Run("leaves headroom for the buffer blocks", func(t *testing.T) {
+		// the current blob base fee is 17002220575 wei, and each full buffer block can raise it by 12.5%
+		blobFeeCap, err := bhe.GetBlobFee(tests.Context(t), maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(27234271276), blobFeeCap)
+	})
+
+	t.Run("accounts for the blob gas used by the latest head", func(t *testing.T) {
+		noBlobGasUsed := uint64(0)
+		h := testutils.Head(3)
+		h.ExcessBlobGas = &excessBlobGas
+		h.BlobGasUsed = &noBlobGasUsed
+		bhe.OnNewLongestChain(tests.Context(t), h)
+
+		blobFeeCap, err := bhe.GetBlobFee(tests.Context(t), maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(24208240824), blobFeeCap)
+	})
+
+	t.Run("is capped by the local max gas price", func(t *testing.T) {
+		blobFeeCap, err := bhe.GetBlobFee(tests.Context(t), assets.NewWeiI(10_000_000_000))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(10_000_000_000), blobFeeCap)
+	})
+}
+
 func TestBlockHistoryEstimator_CheckConnectivity(t *testing.T) {
 	cfg := gas.NewMockConfig()
 	bhCfg := newBlockHistoryConfig()
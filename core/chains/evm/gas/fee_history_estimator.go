@@ -0,0 +1,348 @@
+package gas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/rollups"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+var (
+	_ EvmEstimator = &FeeHistoryEstimator{}
+
+	promFeeHistoryEstimatorGasPrice = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_estimator_fee_history_gas_price",
+		Help: "Gas price calculated by the FeeHistoryEstimator (in Wei)",
+	},
+		[]string{"percentile", "evmChainID"},
+	)
+	promFeeHistoryEstimatorTipCap = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_estimator_fee_history_tip_cap",
+		Help: "Tip cap calculated by the FeeHistoryEstimator (in Wei)",
+	},
+		[]string{"percentile", "evmChainID"},
+	)
+	promFeeHistoryEstimatorBaseFee = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_estimator_fee_history_base_fee",
+		Help: "Base fee of the next block as reported by eth_feeHistory (in Wei)",
+	},
+		[]string{"evmChainID"},
+	)
+)
+
+type feeHistoryEstimatorConfig interface {
+	BumpPercent() uint16
+	BumpMin() *assets.Wei
+	BumpThreshold() uint64
+	EIP1559DynamicFees() bool
+	LimitMultiplier() float32
+	PriceDefault() *assets.Wei
+	PriceMax() *assets.Wei
+	PriceMin() *assets.Wei
+	TipCapDefault() *assets.Wei
+	TipCapMin() *assets.Wei
+}
+
+type feeHistoryConfig interface {
+	BlockHistorySize() uint16
+	EIP1559FeeCapBufferBlocks() uint16
+	TransactionPercentile() uint16
+}
+
+type feeHistoryEstimatorClient interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// feeHistory is the result of an eth_feeHistory call.
+// See: https://ethereum.github.io/execution-apis/api-documentation/
+type feeHistory struct {
+	OldestBlock   *hexutil.Big     `json:"oldestBlock"`
+	Reward        [][]*hexutil.Big `json:"reward"`
+	BaseFeePerGas []*hexutil.Big   `json:"baseFeePerGas"`
+	GasUsedRatio  []float64        `json:"gasUsedRatio"`
+}
+
+// FeeHistoryEstimator is an Estimator which uses eth_feeHistory to estimate fees from the last
+// BlockHistorySize blocks with a single RPC call per refresh:
+//   - the tip cap is the TransactionPercentile of the rewards paid at TransactionPercentile in each non-empty block
+//   - the base fee is that of the next block, which eth_feeHistory reports exactly
+//   - if blocks were more than half full on average, the base fee is trending up, so legacy gas prices
+//     leave headroom for one more base fee increase
+type FeeHistoryEstimator struct {
+	services.StateMachine
+
+	eConfig    feeHistoryEstimatorConfig
+	fhConfig   feeHistoryConfig
+	client     feeHistoryEstimatorClient
+	chainID    *big.Int
+	pollPeriod time.Duration
+	logger     logger.SugaredLogger
+
+	priceMu  sync.RWMutex
+	gasPrice *assets.Wei
+	tipCap   *assets.Wei
+	baseFee  *assets.Wei
+
+	chForceRefetch chan (chan struct{})
+	chInitialised  chan struct{}
+	chStop         services.StopChan
+	chDone         chan struct{}
+
+	l1Oracle rollups.L1Oracle
+}
+
+// NewFeeHistoryEstimator returns a new Estimator which uses eth_feeHistory to estimate fees.
+func NewFeeHistoryEstimator(lggr logger.Logger, client feeEstimatorClient, eCfg feeHistoryEstimatorConfig, fhCfg feeHistoryConfig, chainID *big.Int, l1Oracle rollups.L1Oracle) EvmEstimator {
+	return &FeeHistoryEstimator{
+		eConfig:        eCfg,
+		fhConfig:       fhCfg,
+		client:         client,
+		chainID:        chainID,
+		pollPeriod:     10 * time.Second,
+		logger:         logger.Sugared(logger.Named(lggr, "FeeHistoryEstimator")),
+		chForceRefetch: make(chan (chan struct{})),
+		chInitialised:  make(chan struct{}),
+		chStop:         make(chan struct{}),
+		chDone:         make(chan struct{}),
+		l1Oracle:       l1Oracle,
+	}
+}
+
+func (f *FeeHistoryEstimator) Name() string {
+	return f.logger.Name()
+}
+
+func (f *FeeHistoryEstimator) L1Oracle() rollups.L1Oracle {
+	return f.l1Oracle
+}
+
+func (f *FeeHistoryEstimator) Start(context.Context) error {
+	return f.StartOnce("FeeHistoryEstimator", func() error {
+		go f.run()
+		<-f.chInitialised
+		return nil
+	})
+}
+
+func (f *FeeHistoryEstimator) Close() error {
+	return f.StopOnce("FeeHistoryEstimator", func() error {
+		close(f.chStop)
+		<-f.chDone
+		return nil
+	})
+}
+
+func (f *FeeHistoryEstimator) HealthReport() map[string]error {
+	return map[string]error{f.Name(): f.Healthy()}
+}
+
+func (f *FeeHistoryEstimator) run() {
+	defer close(f.chDone)
+
+	t := f.refreshFees()
+	close(f.chInitialised)
+
+	for {
+		select {
+		case <-f.chStop:
+			return
+		case ch := <-f.chForceRefetch:
+			t.Stop()
+			t = f.refreshFees()
+			close(ch)
+		case <-t.C:
+			t = f.refreshFees()
+		}
+	}
+}
+
+func (f *FeeHistoryEstimator) refreshFees() (t *time.Timer) {
+	t = time.NewTimer(utils.WithJitter(f.pollPeriod))
+
+	ctx, cancel := f.chStop.CtxCancel(evmclient.ContextWithDefaultTimeout())
+	defer cancel()
+
+	percentile := int(f.fhConfig.TransactionPercentile())
+	var history feeHistory
+	if err := f.client.CallContext(ctx, &history, "eth_feeHistory", hexutil.Uint64(f.fhConfig.BlockHistorySize()), "latest", []float64{float64(percentile)}); err != nil {
+		f.logger.Warnw("Failed to refresh fee history", "err", err)
+		return
+	}
+
+	baseFee, tipCap, rising, err := calcFeeHistoryFees(history, percentile)
+	if err != nil {
+		f.logger.Warnw("Failed to calculate fees from fee history", "err", err, "oldestBlock", history.OldestBlock)
+		return
+	}
+
+	var gasPrice *assets.Wei
+	if tipCap != nil {
+		tipCap = assets.WeiMax(tipCap, f.eConfig.TipCapMin())
+		var bufferBlocks int
+		if rising {
+			bufferBlocks = 1
+		}
+		gasPrice = calcFeeCap(baseFee, bufferBlocks, tipCap, f.eConfig.PriceMax())
+		gasPrice = assets.WeiMax(gasPrice, f.eConfig.PriceMin())
+
+		percentileStr := fmt.Sprintf("%v%%", percentile)
+		promFeeHistoryEstimatorGasPrice.WithLabelValues(percentileStr, f.chainID.String()).Set(float64(gasPrice.Int64()))
+		promFeeHistoryEstimatorTipCap.WithLabelValues(percentileStr, f.chainID.String()).Set(float64(tipCap.Int64()))
+	}
+	promFeeHistoryEstimatorBaseFee.WithLabelValues(f.chainID.String()).Set(float64(baseFee.Int64()))
+
+	f.logger.Debugw("refreshFees", "gasPrice", gasPrice, "tipCap", tipCap, "baseFee", baseFee, "baseFeeRising", rising, "oldestBlock", history.OldestBlock)
+
+	f.priceMu.Lock()
+	defer f.priceMu.Unlock()
+	f.gasPrice = gasPrice
+	f.tipCap = tipCap
+	f.baseFee = baseFee
+	return
+}
+
+// calcFeeHistoryFees returns the base fee of the block following the history, the percentile of the rewards paid
+// at percentile in each non-empty block of the history, and whether the base fee is trending up.
+// tipCap is nil if all blocks in the history are empty.
+func calcFeeHistoryFees(history feeHistory, percentile int) (baseFee, tipCap *assets.Wei, rising bool, err error) {
+	if len(history.BaseFeePerGas) == 0 || history.BaseFeePerGas[len(history.BaseFeePerGas)-1] == nil {
+		return nil, nil, false, pkgerrors.New("fee history has no base fee")
+	}
+	if len(history.Reward) != len(history.GasUsedRatio) {
+		return nil, nil, false, pkgerrors.Errorf("fee history has %d rewards for %d blocks", len(history.Reward), len(history.GasUsedRatio))
+	}
+	// Chains without EIP-1559 report a zero base fee, in which case rewards are the full gas price paid
+	baseFee = assets.NewWei(history.BaseFeePerGas[len(history.BaseFeePerGas)-1].ToInt())
+
+	var tips []*assets.Wei
+	var totalGasUsedRatio float64
+	for i, ratio := range history.GasUsedRatio {
+		totalGasUsedRatio += ratio
+		// empty blocks report a reward of zero, which says nothing about the price of inclusion
+		if ratio == 0 || len(history.Reward[i]) == 0 || history.Reward[i][0] == nil {
+			continue
+		}
+		tips = append(tips, assets.NewWei(history.Reward[i][0].ToInt()))
+	}
+	// The base fee of each block increases whenever the previous block used more than half of its gas limit
+	rising = len(history.GasUsedRatio) > 0 && totalGasUsedRatio/float64(len(history.GasUsedRatio)) > 0.5
+
+	if len(tips) == 0 {
+		return baseFee, nil, rising, nil
+	}
+	sort.Slice(tips, func(i, j int) bool { return tips[i].Cmp(tips[j]) < 0 })
+	tipCap = tips[((len(tips)-1)*percentile)/100]
+	return baseFee, tipCap, rising, nil
+}
+
+// Uses the force refetch chan to trigger a fee update and blocks until complete
+func (f *FeeHistoryEstimator) forceRefresh(ctx context.Context) (err error) {
+	ch := make(chan struct{})
+	select {
+	case f.chForceRefetch <- ch:
+	case <-f.chStop:
+		return pkgerrors.New("estimator stopped")
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case <-ch:
+	case <-f.chStop:
+		return pkgerrors.New("estimator stopped")
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return
+}
+
+func (f *FeeHistoryEstimator) OnNewLongestChain(context.Context, *evmtypes.Head) {}
+
+func (f *FeeHistoryEstimator) getFees() (gasPrice, tipCap, baseFee *assets.Wei) {
+	f.priceMu.RLock()
+	defer f.priceMu.RUnlock()
+	return f.gasPrice, f.tipCap, f.baseFee
+}
+
+func (f *FeeHistoryEstimator) GetLegacyGas(ctx context.Context, _ []byte, gasLimit uint64, maxGasPriceWei *assets.Wei, opts ...feetypes.Opt) (gasPrice *assets.Wei, chainSpecificGasLimit uint64, err error) {
+	ok := f.IfStarted(func() {
+		if slices.Contains(opts, feetypes.OptForceRefetch) {
+			err = f.forceRefresh(ctx)
+		}
+		gasPrice, _, _ = f.getFees()
+	})
+	if !ok {
+		return nil, 0, pkgerrors.New("FeeHistoryEstimator is not started; cannot estimate gas")
+	} else if err != nil {
+		return nil, 0, err
+	}
+	if gasPrice == nil {
+		f.logger.Warnw("Failed to estimate gas price. This is likely because the fee history is not available or only contains empty blocks. " +
+			"Using Evm.GasEstimator.PriceDefault as fallback.")
+		gasPrice = f.eConfig.PriceDefault()
+	}
+	gasPrice = capGasPrice(gasPrice, maxGasPriceWei, f.eConfig.PriceMax())
+	chainSpecificGasLimit = gasLimit
+	return
+}
+
+func (f *FeeHistoryEstimator) BumpLegacyGas(_ context.Context, originalGasPrice *assets.Wei, gasLimit uint64, maxGasPriceWei *assets.Wei, _ []EvmPriorAttempt) (bumpedGasPrice *assets.Wei, chainSpecificGasLimit uint64, err error) {
+	currentGasPrice, _, _ := f.getFees()
+	bumpedGasPrice, err = BumpLegacyGasPriceOnly(f.eConfig, f.logger, currentGasPrice, originalGasPrice, maxGasPriceWei)
+	if err != nil {
+		return nil, 0, err
+	}
+	return bumpedGasPrice, gasLimit, nil
+}
+
+func (f *FeeHistoryEstimator) GetDynamicFee(_ context.Context, maxGasPriceWei *assets.Wei) (fee DynamicFee, err error) {
+	if !f.eConfig.EIP1559DynamicFees() {
+		return fee, pkgerrors.New("Can't get dynamic fee, EIP1559 is disabled")
+	}
+
+	var tipCap, baseFee *assets.Wei
+	ok := f.IfStarted(func() {
+		_, tipCap, baseFee = f.getFees()
+	})
+	if !ok {
+		return fee, pkgerrors.New("FeeHistoryEstimator is not started; cannot estimate gas")
+	}
+	if tipCap == nil {
+		f.logger.Warnw("Failed to estimate tip cap. This is likely because the fee history is not available or only contains empty blocks. " +
+			"Using Evm.GasEstimator.TipCapDefault as fallback.")
+		tipCap = f.eConfig.TipCapDefault()
+	}
+	maxGasPrice := getMaxGasPrice(maxGasPriceWei, f.eConfig.PriceMax())
+	if f.eConfig.BumpThreshold() == 0 {
+		// just use the max gas price if gas bumping is disabled
+		fee.FeeCap = maxGasPrice
+	} else if baseFee != nil {
+		fee.FeeCap = calcFeeCap(baseFee, int(f.fhConfig.EIP1559FeeCapBufferBlocks()), tipCap, maxGasPrice)
+	} else {
+		return fee, pkgerrors.New("FeeHistoryEstimator: no value for next block base fee; cannot estimate EIP-1559 fee cap")
+	}
+	fee.TipCap = tipCap
+	return
+}
+
+func (f *FeeHistoryEstimator) BumpDynamicFee(_ context.Context, originalFee DynamicFee, maxGasPriceWei *assets.Wei, _ []EvmPriorAttempt) (bumped DynamicFee, err error) {
+	_, currentTipCap, currentBaseFee := f.getFees()
+	return BumpDynamicFeeOnly(f.eConfig, f.fhConfig.EIP1559FeeCapBufferBlocks(), f.logger, currentTipCap, currentBaseFee, originalFee, maxGasPriceWei)
+}
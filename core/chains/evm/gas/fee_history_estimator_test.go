@@ -0,0 +1,171 @@
+package gas_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
+	rollupMocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/rollups/mocks"
+)
+
+// rising: blocks are 60% full on average, the tip at the 60th percentile of non-empty blocks is 20 wei,
+// and the next block's base fee is 130 wei
+const feeHistoryRising = `{
+	"oldestBlock": "0x10",
+	"reward": [["0xa"], ["0x0"], ["0x1e"], ["0x14"]],
+	"baseFeePerGas": ["0x64", "0x6e", "0x6e", "0x78", "0x82"],
+	"gasUsedRatio": [0.9, 0, 0.8, 0.7]
+}`
+
+// falling: same as rising, but blocks are only 15% full on average
+const feeHistoryFalling = `{
+	"oldestBlock": "0x10",
+	"reward": [["0xa"], ["0x0"], ["0x1e"], ["0x14"]],
+	"baseFeePerGas": ["0x64", "0x6e", "0x6e", "0x78", "0x82"],
+	"gasUsedRatio": [0.1, 0, 0.2, 0.3]
+}`
+
+const feeHistoryEmpty = `{
+	"oldestBlock": "0x10",
+	"reward": [["0x0"], ["0x0"]],
+	"baseFeePerGas": ["0x64", "0x64", "0x64"],
+	"gasUsedRatio": [0, 0]
+}`
+
+func newFeeHistoryClient(t *testing.T, history string) *mocks.FeeEstimatorClient {
+	feeEstimatorClient := mocks.NewFeeEstimatorClient(t)
+	feeEstimatorClient.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", mock.Anything, "latest", []float64{60}).Return(nil).Run(func(args mock.Arguments) {
+		require.NoError(t, json.Unmarshal([]byte(history), args.Get(1)))
+	})
+	return feeEstimatorClient
+}
+
+func TestFeeHistoryEstimator(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.NewWeiI(1000)
+	calldata := []byte{0x00, 0x00, 0x01, 0x02, 0x03}
+	const gasLimit uint64 = 80000
+	chainID := big.NewInt(0)
+
+	cfg := &gas.MockGasEstimatorConfig{
+		EIP1559DynamicFeesF: true,
+		BumpPercentF:        10,
+		BumpMinF:            assets.NewWeiI(1),
+		BumpThresholdF:      1,
+		PriceDefaultF:       assets.NewWeiI(50),
+		PriceMaxF:           maxGasPrice,
+		PriceMinF:           assets.NewWeiI(1),
+		TipCapDefaultF:      assets.NewWeiI(5),
+		TipCapMinF:          assets.NewWeiI(1),
+	}
+	fhCfg := &gas.MockBlockHistoryConfig{BlockHistorySizeF: 4, EIP1559FeeCapBufferBlocksF: 2, TransactionPercentileF: 60}
+
+	t.Run("calling GetLegacyGas on unstarted estimator returns error", func(t *testing.T) {
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), mocks.NewFeeEstimatorClient(t), cfg, fhCfg, chainID, rollupMocks.NewL1Oracle(t))
+		_, _, err := o.GetLegacyGas(tests.Context(t), calldata, gasLimit, maxGasPrice)
+		assert.EqualError(t, err, "FeeHistoryEstimator is not started; cannot estimate gas")
+	})
+
+	t.Run("GetLegacyGas leaves headroom for one more base fee increase if the base fee is rising", func(t *testing.T) {
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), newFeeHistoryClient(t, feeHistoryRising), cfg, fhCfg, chainID, rollupMocks.NewL1Oracle(t))
+		servicetest.RunHealthy(t, o)
+
+		gasPrice, chainSpecificGasLimit, err := o.GetLegacyGas(tests.Context(t), calldata, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		// 130 * 1.125 + 20
+		assert.Equal(t, assets.NewWeiI(166), gasPrice)
+		assert.Equal(t, gasLimit, chainSpecificGasLimit)
+	})
+
+	t.Run("GetLegacyGas uses the next base fee if the base fee is not rising", func(t *testing.T) {
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), newFeeHistoryClient(t, feeHistoryFalling), cfg, fhCfg, chainID, rollupMocks.NewL1Oracle(t))
+		servicetest.RunHealthy(t, o)
+
+		gasPrice, _, err := o.GetLegacyGas(tests.Context(t), calldata, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(150), gasPrice)
+	})
+
+	t.Run("GetLegacyGas caps the gas price at the user specified max gas price", func(t *testing.T) {
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), newFeeHistoryClient(t, feeHistoryRising), cfg, fhCfg, chainID, rollupMocks.NewL1Oracle(t))
+		servicetest.RunHealthy(t, o)
+
+		gasPrice, _, err := o.GetLegacyGas(tests.Context(t), calldata, gasLimit, assets.NewWeiI(100))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(100), gasPrice)
+	})
+
+	t.Run("GetDynamicFee uses the percentile tip and buffers the next base fee", func(t *testing.T) {
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), newFeeHistoryClient(t, feeHistoryRising), cfg, fhCfg, chainID, rollupMocks.NewL1Oracle(t))
+		servicetest.RunHealthy(t, o)
+
+		fee, err := o.GetDynamicFee(tests.Context(t), maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(20), fee.TipCap)
+		// 130 * 1.125^2 + 20
+		assert.Equal(t, assets.NewWeiI(184), fee.FeeCap)
+	})
+
+	t.Run("GetDynamicFee uses the max gas price as fee cap if gas bumping is disabled", func(t *testing.T) {
+		noBumpCfg := *cfg
+		noBumpCfg.BumpThresholdF = 0
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), newFeeHistoryClient(t, feeHistoryRising), &noBumpCfg, fhCfg, chainID, rollupMocks.NewL1Oracle(t))
+		servicetest.RunHealthy(t, o)
+
+		fee, err := o.GetDynamicFee(tests.Context(t), assets.NewWeiI(500))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(20), fee.TipCap)
+		assert.Equal(t, assets.NewWeiI(500), fee.FeeCap)
+	})
+
+	t.Run("falls back to the configured defaults if all blocks are empty", func(t *testing.T) {
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), newFeeHistoryClient(t, feeHistoryEmpty), cfg, fhCfg, chainID, rollupMocks.NewL1Oracle(t))
+		servicetest.RunHealthy(t, o)
+
+		gasPrice, _, err := o.GetLegacyGas(tests.Context(t), calldata, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(50), gasPrice)
+
+		fee, err := o.GetDynamicFee(tests.Context(t), maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(5), fee.TipCap)
+		// 100 * 1.125^2 + 5
+		assert.Equal(t, assets.NewWeiI(131), fee.FeeCap)
+	})
+
+	t.Run("BumpLegacyGas uses the current gas price if it is higher than the bumped price", func(t *testing.T) {
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), newFeeHistoryClient(t, feeHistoryRising), cfg, fhCfg, chainID, rollupMocks.NewL1Oracle(t))
+		servicetest.RunHealthy(t, o)
+
+		gasPrice, chainSpecificGasLimit, err := o.BumpLegacyGas(tests.Context(t), assets.NewWeiI(100), gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(166), gasPrice)
+		assert.Equal(t, gasLimit, chainSpecificGasLimit)
+
+		gasPrice, _, err = o.BumpLegacyGas(tests.Context(t), assets.NewWeiI(200), gasLimit, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(220), gasPrice)
+	})
+
+	t.Run("BumpDynamicFee raises the fee cap to the current fee cap", func(t *testing.T) {
+		o := gas.NewFeeHistoryEstimator(logger.Test(t), newFeeHistoryClient(t, feeHistoryRising), cfg, fhCfg, chainID, rollupMocks.NewL1Oracle(t))
+		servicetest.RunHealthy(t, o)
+
+		bumped, err := o.BumpDynamicFee(tests.Context(t), gas.DynamicFee{TipCap: assets.NewWeiI(10), FeeCap: assets.NewWeiI(100)}, maxGasPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(20), bumped.TipCap)
+		assert.Equal(t, assets.NewWeiI(184), bumped.FeeCap)
+	})
+}
@@ -78,6 +78,36 @@ func (_m *EvmFeeEstimator) Close() error {
 	return r0
 }
 
+// GetBlobFee provides a mock function with given fields: ctx, maxFeePrice
+func (_m *EvmFeeEstimator) GetBlobFee(ctx context.Context, maxFeePrice *assets.Wei) (*assets.Wei, error) {
+	ret := _m.Called(ctx, maxFeePrice)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlobFee")
+	}
+
+	var r0 *assets.Wei
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *assets.Wei) (*assets.Wei, error)); ok {
+		return rf(ctx, maxFeePrice)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *assets.Wei) *assets.Wei); ok {
+		r0 = rf(ctx, maxFeePrice)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*assets.Wei)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *assets.Wei) error); ok {
+		r1 = rf(ctx, maxFeePrice)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetFee provides a mock function with given fields: ctx, calldata, feeLimit, maxFeePrice, opts
 func (_m *EvmFeeEstimator) GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, opts ...types.Opt) (gas.EvmFee, uint64, error) {
 	_va := make([]interface{}, len(opts))
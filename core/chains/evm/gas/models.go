@@ -37,6 +37,8 @@ type EvmFeeEstimator interface {
 	L1Oracle() rollups.L1Oracle
 	GetFee(ctx context.Context, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, opts ...feetypes.Opt) (fee EvmFee, chainSpecificFeeLimit uint64, err error)
 	BumpFee(ctx context.Context, originalFee EvmFee, feeLimit uint64, maxFeePrice *assets.Wei, attempts []EvmPriorAttempt) (bumpedFee EvmFee, chainSpecificFeeLimit uint64, err error)
+	// GetBlobFee returns the blob fee cap for EIP-4844 blob transactions, if the underlying estimator is a BlobFeeEstimator
+	GetBlobFee(ctx context.Context, maxFeePrice *assets.Wei) (blobFeeCap *assets.Wei, err error)
 
 	// GetMaxCost returns the total value = max price x fee units + transferred value
 	GetMaxCost(ctx context.Context, amount assets.Eth, calldata []byte, feeLimit uint64, maxFeePrice *assets.Wei, opts ...feetypes.Opt) (*big.Int, error)
@@ -91,6 +93,10 @@ func NewEstimator(lggr logger.Logger, ethClient feeEstimatorClient, cfg Config,
 		newEstimator = func(l logger.Logger) EvmEstimator {
 			return NewBlockHistoryEstimator(lggr, ethClient, cfg, geCfg, bh, ethClient.ConfiguredChainID(), l1Oracle)
 		}
+	case "FeeHistory":
+		newEstimator = func(l logger.Logger) EvmEstimator {
+			return NewFeeHistoryEstimator(lggr, ethClient, geCfg, bh, ethClient.ConfiguredChainID(), l1Oracle)
+		}
 	case "FixedPrice":
 		newEstimator = func(l logger.Logger) EvmEstimator {
 			return NewFixedPriceEstimator(geCfg, ethClient, bh, lggr, l1Oracle)
@@ -161,9 +167,15 @@ type EvmFee struct {
 	// dynamic/EIP1559 fees
 	DynamicFeeCap *assets.Wei
 	DynamicTipCap *assets.Wei
+
+	// blob/EIP4844 fees, only set for blob transactions alongside the dynamic fees
+	BlobFeeCap *assets.Wei
 }
 
 func (fee EvmFee) String() string {
+	if fee.BlobFeeCap != nil {
+		return fmt.Sprintf("{Legacy: %s, DynamicFeeCap: %s, DynamicTipCap: %s, BlobFeeCap: %s}", fee.Legacy, fee.DynamicFeeCap, fee.DynamicTipCap, fee.BlobFeeCap)
+	}
 	return fmt.Sprintf("{Legacy: %s, DynamicFeeCap: %s, DynamicTipCap: %s}", fee.Legacy, fee.DynamicFeeCap, fee.DynamicTipCap)
 }
 
@@ -306,6 +318,10 @@ func (e *evmFeeEstimator) BumpFee(ctx context.Context, originalFee EvmFee, feeLi
 		err = pkgerrors.New("only one dynamic or legacy fee can be defined")
 		return
 	}
+	if originalFee.BlobFeeCap != nil && !originalFee.ValidDynamic() {
+		err = pkgerrors.New("blob fee can only be defined with a dynamic fee")
+		return
+	}
 
 	// bump fee based on what fee the tx has previously used (not based on config)
 	// bump dynamic original
@@ -319,6 +335,19 @@ func (e *evmFeeEstimator) BumpFee(ctx context.Context, originalFee EvmFee, feeLi
 		if err != nil {
 			return
 		}
+		// bump blob fees together with the dynamic fee, as replacing a blob transaction requires raising all of its fees
+		if originalFee.BlobFeeCap != nil {
+			currentBlobFeeCap, blobErr := e.GetBlobFee(ctx, maxFeePrice)
+			if blobErr != nil {
+				e.lggr.Warnw("Failed to get current blob fee, bumping from the original blob fee cap only", "err", blobErr)
+			}
+			bumpedFee, err = bumpBlobFee(originalFee, bumpedDynamic, currentBlobFeeCap, getMaxGasPrice(maxFeePrice, e.geCfg.PriceMax()))
+			if err != nil {
+				return
+			}
+			chainSpecificFeeLimit, err = commonfee.ApplyMultiplier(feeLimit, e.geCfg.LimitMultiplier())
+			return
+		}
 		chainSpecificFeeLimit, err = commonfee.ApplyMultiplier(feeLimit, e.geCfg.LimitMultiplier())
 		bumpedFee.DynamicFeeCap = bumpedDynamic.FeeCap
 		bumpedFee.DynamicTipCap = bumpedDynamic.TipCap
@@ -334,6 +363,14 @@ func (e *evmFeeEstimator) BumpFee(ctx context.Context, originalFee EvmFee, feeLi
 	return
 }
 
+func (e *evmFeeEstimator) GetBlobFee(ctx context.Context, maxFeePrice *assets.Wei) (blobFeeCap *assets.Wei, err error) {
+	blobEstimator, ok := e.EvmEstimator.(BlobFeeEstimator)
+	if !ok {
+		return nil, pkgerrors.Errorf("%s does not support blob fee estimation, use the BlockHistory estimator to send blob transactions", e.EvmEstimator.Name())
+	}
+	return blobEstimator.GetBlobFee(ctx, maxFeePrice)
+}
+
 // Config defines an interface for configuration in the gas package
 //
 //go:generate mockery --quiet --name Config --output ./mocks/ --case=underscore
@@ -1,6 +1,7 @@
 package gas_test
 
 import (
+	"context"
 	"math/big"
 	"testing"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
 	"github.com/smartcontractkit/chainlink/v2/common/config"
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
@@ -134,6 +136,65 @@ func TestWrappedEvmEstimator(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("BumpFee with blob fee", func(t *testing.T) {
+		lggr := logger.Test(t)
+		evmEstimator := mocks.NewEvmEstimator(t)
+		// bumped by less than what is required to replace a blob transaction
+		evmEstimator.On("BumpDynamicFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(gas.DynamicFee{FeeCap: assets.NewWeiI(22), TipCap: assets.NewWeiI(3)}, nil)
+		getEst := func(logger.Logger) gas.EvmEstimator {
+			return &blobFeeEstimator{EvmEstimator: evmEstimator, blobFeeCap: assets.NewWeiI(50)}
+		}
+		blobGeCfg := gas.NewMockGasConfig()
+		blobGeCfg.LimitMultiplierF = 1
+		blobGeCfg.PriceMaxF = assets.NewWeiI(100)
+		estimator := gas.NewEvmFeeEstimator(lggr, getEst, true, blobGeCfg)
+		maxPrice := assets.NewWeiI(100)
+
+		blobFeeCap, err := estimator.GetBlobFee(ctx, maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(50), blobFeeCap)
+
+		// expect every fee to be at least doubled, and the blob fee cap to be raised to the current estimate
+		fee, _, err := estimator.BumpFee(ctx, gas.EvmFee{
+			DynamicFeeCap: assets.NewWeiI(20),
+			DynamicTipCap: assets.NewWeiI(1),
+			BlobFeeCap:    assets.NewWeiI(10),
+		}, gasLimit, maxPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, gas.EvmFee{
+			DynamicFeeCap: assets.NewWeiI(40),
+			DynamicTipCap: assets.NewWeiI(3),
+			BlobFeeCap:    assets.NewWeiI(50),
+		}, fee)
+
+		// expect error if the bumped blob fee cap exceeds the max price
+		_, _, err = estimator.BumpFee(ctx, gas.EvmFee{
+			DynamicFeeCap: assets.NewWeiI(20),
+			DynamicTipCap: assets.NewWeiI(1),
+			BlobFeeCap:    assets.NewWeiI(60),
+		}, gasLimit, maxPrice, nil)
+		require.ErrorIs(t, err, commonfee.ErrBumpFeeExceedsLimit)
+
+		// expect error if the blob fee is not defined with a dynamic fee
+		_, _, err = estimator.BumpFee(ctx, gas.EvmFee{
+			Legacy:     legacyFee,
+			BlobFeeCap: assets.NewWeiI(10),
+		}, gasLimit, maxPrice, nil)
+		require.EqualError(t, err, "blob fee can only be defined with a dynamic fee")
+	})
+
+	t.Run("GetBlobFee fails if the estimator does not support blob fees", func(t *testing.T) {
+		lggr := logger.Test(t)
+		evmEstimator := mocks.NewEvmEstimator(t)
+		evmEstimator.On("Name").Return(mockEvmEstimatorName).Once()
+		getEst := func(logger.Logger) gas.EvmEstimator { return evmEstimator }
+		estimator := gas.NewEvmFeeEstimator(lggr, getEst, true, geCfg)
+
+		_, err := estimator.GetBlobFee(ctx, nil)
+		require.EqualError(t, err, mockEvmEstimatorName+" does not support blob fee estimation, use the BlockHistory estimator to send blob transactions")
+	})
+
 	t.Run("GetMaxCost", func(t *testing.T) {
 		lggr := logger.Test(t)
 		val := assets.NewEthValue(1)
@@ -249,3 +310,12 @@ func TestWrappedEvmEstimator(t *testing.T) {
 		require.NotNil(t, report[mockEstimatorName])
 	})
 }
+
+type blobFeeEstimator struct {
+	*mocks.EvmEstimator
+	blobFeeCap *assets.Wei
+}
+
+func (e *blobFeeEstimator) GetBlobFee(context.Context, *assets.Wei) (*assets.Wei, error) {
+	return e.blobFeeCap, nil
+}
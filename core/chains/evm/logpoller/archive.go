@@ -0,0 +1,232 @@
+package logpoller
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/lib/pq"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+)
+
+// archiveBucketSize is the number of blocks whose logs are kept in a single archive file.
+const archiveBucketSize = 10_000
+
+var ErrLogArchiveDisabled = errors.New("log archive is not configured")
+
+// LogArchive stores the logs pruned by the LogPoller once they expire, so they can be restored with ReplayFromArchive.
+type LogArchive interface {
+	// Archive stores logs durably. The logs are deleted from the database as soon as it returns without error.
+	// Logs which are already archived, identified by block hash and log index, must not be stored twice.
+	Archive(ctx context.Context, logs []Log) error
+	// Restore returns the archived logs of the blocks in [fromBlock, toBlock], ordered by block number and log index.
+	Restore(ctx context.Context, fromBlock, toBlock int64) ([]Log, error)
+}
+
+// archivedLog is the format of a log in a fileLogArchive.
+type archivedLog struct {
+	BlockHash      common.Hash     `json:"blockHash"`
+	BlockNumber    int64           `json:"blockNumber"`
+	BlockTimestamp time.Time       `json:"blockTimestamp"`
+	LogIndex       int64           `json:"logIndex"`
+	TxHash         common.Hash     `json:"txHash"`
+	Address        common.Address  `json:"address"`
+	EventSig       common.Hash     `json:"eventSig"`
+	Topics         []hexutil.Bytes `json:"topics"`
+	Data           hexutil.Bytes   `json:"data"`
+}
+
+// archivedLogID identifies a log in the archive. A log that is restored and expires again is not archived twice.
+type archivedLogID struct {
+	blockHash common.Hash
+	logIndex  int64
+}
+
+type fileLogArchive struct {
+	chainID *big.Int
+	dir     string
+
+	mu sync.Mutex
+}
+
+var _ LogArchive = &fileLogArchive{}
+
+// NewFileLogArchive returns a LogArchive that stores logs as JSON lines in dir.
+// Each file holds the logs of archiveBucketSize blocks of a chain, in the order they were archived.
+// Logs which are already in the archive, because they were restored by ReplayFromArchive, are not appended again.
+func NewFileLogArchive(dir string, chainID *big.Int) (LogArchive, error) {
+	dir = filepath.Join(dir, chainID.String())
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create log archive directory: %w", err)
+	}
+	return &fileLogArchive{chainID: chainID, dir: dir}, nil
+}
+
+func (a *fileLogArchive) bucketPath(bucket int64) string {
+	return filepath.Join(a.dir, fmt.Sprintf("logs-%012d.jsonl", bucket*archiveBucketSize))
+}
+
+func (a *fileLogArchive) Archive(ctx context.Context, logs []Log) error {
+	buckets := make(map[int64][]Log)
+	for _, l := range logs {
+		bucket := l.BlockNumber / archiveBucketSize
+		buckets[bucket] = append(buckets[bucket], l)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for bucket, bucketLogs := range buckets {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		path := a.bucketPath(bucket)
+		archived, err := a.readLogs(path)
+		if err != nil {
+			return fmt.Errorf("failed to archive logs: %w", err)
+		}
+		newLogs := withoutArchived(bucketLogs, archived)
+		if len(newLogs) == 0 {
+			continue
+		}
+		if err := a.appendLogs(path, newLogs); err != nil {
+			return fmt.Errorf("failed to archive logs: %w", err)
+		}
+	}
+	return nil
+}
+
+// withoutArchived returns the logs that are not in archived.
+func withoutArchived(logs, archived []Log) []Log {
+	seen := make(map[archivedLogID]struct{}, len(archived))
+	for _, l := range archived {
+		seen[archivedLogID{l.BlockHash, l.LogIndex}] = struct{}{}
+	}
+	var newLogs []Log
+	for _, l := range logs {
+		id := archivedLogID{l.BlockHash, l.LogIndex}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		newLogs = append(newLogs, l)
+	}
+	return newLogs
+}
+
+func (a *fileLogArchive) appendLogs(path string, logs []Log) (err error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, f.Close()) }()
+
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, l := range logs {
+		topics := make([]hexutil.Bytes, len(l.Topics))
+		for i, topic := range l.Topics {
+			topics[i] = topic
+		}
+		if err = enc.Encode(archivedLog{
+			BlockHash:      l.BlockHash,
+			BlockNumber:    l.BlockNumber,
+			BlockTimestamp: l.BlockTimestamp,
+			LogIndex:       l.LogIndex,
+			TxHash:         l.TxHash,
+			Address:        l.Address,
+			EventSig:       l.EventSig,
+			Topics:         topics,
+			Data:           l.Data,
+		}); err != nil {
+			return err
+		}
+	}
+	if err = w.Flush(); err != nil {
+		return err
+	}
+	// the logs are deleted from the database right after, so they must be on disk
+	return f.Sync()
+}
+
+func (a *fileLogArchive) Restore(ctx context.Context, fromBlock, toBlock int64) ([]Log, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	seen := make(map[archivedLogID]struct{})
+	var logs []Log
+	for bucket := fromBlock / archiveBucketSize; bucket <= toBlock/archiveBucketSize; bucket++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		bucketLogs, err := a.readLogs(a.bucketPath(bucket))
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore logs: %w", err)
+		}
+		for _, l := range bucketLogs {
+			if l.BlockNumber < fromBlock || l.BlockNumber > toBlock {
+				continue
+			}
+			// archives written before Archive skipped the restored logs may hold them twice
+			id := archivedLogID{l.BlockHash, l.LogIndex}
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			logs = append(logs, l)
+		}
+	}
+	sort.SliceStable(logs, func(i, j int) bool {
+		if logs[i].BlockNumber != logs[j].BlockNumber {
+			return logs[i].BlockNumber < logs[j].BlockNumber
+		}
+		return logs[i].LogIndex < logs[j].LogIndex
+	})
+	return logs, nil
+}
+
+func (a *fileLogArchive) readLogs(path string) (logs []Log, err error) {
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer func() { err = errors.Join(err, f.Close()) }()
+
+	dec := json.NewDecoder(bufio.NewReader(f))
+	for dec.More() {
+		var l archivedLog
+		if err = dec.Decode(&l); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		topics := make(pq.ByteaArray, len(l.Topics))
+		for i, topic := range l.Topics {
+			topics[i] = topic
+		}
+		logs = append(logs, Log{
+			EvmChainId:     ubig.New(a.chainID),
+			LogIndex:       l.LogIndex,
+			BlockHash:      l.BlockHash,
+			BlockNumber:    l.BlockNumber,
+			BlockTimestamp: l.BlockTimestamp,
+			Topics:         topics,
+			EventSig:       l.EventSig,
+			Address:        l.Address,
+			TxHash:         l.TxHash,
+			Data:           l.Data,
+		})
+	}
+	return logs, nil
+}
@@ -0,0 +1,86 @@
+package logpoller_test
+
+import (
+	"bytes"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestFileLogArchive(t *testing.T) {
+	ctx := testutils.Context(t)
+	chainID := big.NewInt(137)
+	dir := t.TempDir()
+	archive, err := logpoller.NewFileLogArchive(dir, chainID)
+	require.NoError(t, err)
+
+	addr := common.HexToAddress("0x1234")
+	topic := common.HexToHash("0x1599").Bytes()
+	ts := time.Unix(1700000000, 0).UTC()
+
+	t.Run("restore from an empty archive returns no logs", func(t *testing.T) {
+		logs, err := archive.Restore(ctx, 1, 100_000)
+		require.NoError(t, err)
+		assert.Empty(t, logs)
+	})
+
+	t.Run("logs are restored in order across archive files", func(t *testing.T) {
+		require.NoError(t, archive.Archive(ctx, []logpoller.Log{
+			GenLogWithTimestamp(chainID, 1, 20_001, "0x3", topic, addr, ts),
+			GenLogWithTimestamp(chainID, 0, 20_001, "0x3", topic, addr, ts),
+			GenLogWithTimestamp(chainID, 0, 9_999, "0x1", topic, addr, ts),
+		}))
+		require.NoError(t, archive.Archive(ctx, []logpoller.Log{
+			GenLogWithTimestamp(chainID, 0, 10_000, "0x2", topic, addr, ts),
+		}))
+
+		files, err := os.ReadDir(filepath.Join(dir, "137"))
+		require.NoError(t, err)
+		require.Len(t, files, 3)
+		assert.Equal(t, "logs-000000000000.jsonl", files[0].Name())
+		assert.Equal(t, "logs-000000010000.jsonl", files[1].Name())
+		assert.Equal(t, "logs-000000020000.jsonl", files[2].Name())
+
+		logs, err := archive.Restore(ctx, 1, 30_000)
+		require.NoError(t, err)
+		require.Len(t, logs, 4)
+		for i, expected := range []struct{ block, index int64 }{{9_999, 0}, {10_000, 0}, {20_001, 0}, {20_001, 1}} {
+			assert.Equal(t, expected.block, logs[i].BlockNumber)
+			assert.Equal(t, expected.index, logs[i].LogIndex)
+			assert.Equal(t, addr, logs[i].Address)
+			assert.True(t, ts.Equal(logs[i].BlockTimestamp))
+			assert.Equal(t, chainID.String(), logs[i].EvmChainId.String())
+		}
+
+		logs, err = archive.Restore(ctx, 10_000, 20_000)
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+		assert.Equal(t, int64(10_000), logs[0].BlockNumber)
+	})
+
+	t.Run("logs archived more than once are stored and restored once", func(t *testing.T) {
+		require.NoError(t, archive.Archive(ctx, []logpoller.Log{
+			GenLogWithTimestamp(chainID, 0, 10_000, "0x2", topic, addr, ts),
+			GenLogWithTimestamp(chainID, 1, 10_000, "0x2", topic, addr, ts),
+		}))
+
+		contents, err := os.ReadFile(filepath.Join(dir, "137", "logs-000000010000.jsonl"))
+		require.NoError(t, err)
+		assert.Equal(t, 2, bytes.Count(contents, []byte("\n")))
+
+		logs, err := archive.Restore(ctx, 10_000, 10_000)
+		require.NoError(t, err)
+		require.Len(t, logs, 2)
+		assert.Equal(t, int64(0), logs[0].LogIndex)
+		assert.Equal(t, int64(1), logs[1].LogIndex)
+	})
+}
@@ -38,6 +38,7 @@ type LogPoller interface {
 	Healthy() error
 	Replay(ctx context.Context, fromBlock int64) error
 	ReplayAsync(fromBlock int64)
+	ReplayFromArchive(ctx context.Context, fromBlock, toBlock int64) error
 	RegisterFilter(ctx context.Context, filter Filter) error
 	UnregisterFilter(ctx context.Context, name string) error
 	HasFilter(name string) bool
@@ -67,7 +68,11 @@ type LogPoller interface {
 	LogsDataWordGreaterThan(ctx context.Context, eventSig common.Hash, address common.Address, wordIndex int, wordValueMin common.Hash, confs evmtypes.Confirmations) ([]Log, error)
 	LogsDataWordBetween(ctx context.Context, eventSig common.Hash, address common.Address, wordIndexMin, wordIndexMax int, wordValue common.Hash, confs evmtypes.Confirmations) ([]Log, error)
 
-	// chainlink-common query filtering
+	// FilteredLogs returns the logs matching filter, compiled into a single parameterized query.
+	// Filters are composed with query.And/query.Or from the chainlink-common primitives (block, timestamp, tx hash,
+	// confidence) and the EVM specific ones in this package: NewAddressFilter, NewEventSigFilter, NewEventByTopicFilter,
+	// NewEventByTopicValuesFilter, NewEventByWordFilter and NewConfirmationsFilter.
+	// Results can be paginated with a query.CursorLimit, using FormatContractReaderCursor of the last log of a page.
 	FilteredLogs(ctx context.Context, filter query.KeyFilter, limitAndSort query.LimitAndSort, queryName string) ([]Log, error)
 }
 
@@ -108,6 +113,7 @@ type logPoller struct {
 	backfillBatchSize        int64         // batch size to use when backfilling finalized logs
 	rpcBatchSize             int64         // batch size to use for fallback RPC calls made in GetBlocks
 	logPrunePageSize         int64
+	logArchive               LogArchive
 	backupPollerNextBlock    int64 // next block to be processed by Backup LogPoller
 	backupPollerBlockDelay   int64 // how far behind regular LogPoller should BackupLogPoller run. 0 = disabled
 
@@ -138,6 +144,7 @@ type Opts struct {
 	KeepFinalizedBlocksDepth int64
 	BackupPollerBlockDelay   int64
 	LogPrunePageSize         int64
+	LogArchive               LogArchive
 }
 
 // NewLogPoller creates a log poller. Note there is an assumption
@@ -166,6 +173,7 @@ func NewLogPoller(orm ORM, ec Client, lggr logger.Logger, opts Opts) *logPoller
 		rpcBatchSize:             opts.RpcBatchSize,
 		keepFinalizedBlocksDepth: opts.KeepFinalizedBlocksDepth,
 		logPrunePageSize:         opts.LogPrunePageSize,
+		logArchive:               opts.LogArchive,
 		filters:                  make(map[string]Filter),
 		filterDirty:              true, // Always build Filter on first call to cache an empty filter if nothing registered yet.
 		finalityViolated:         new(atomic.Bool),
@@ -447,6 +455,51 @@ func (lp *logPoller) Replay(ctx context.Context, fromBlock int64) (err error) {
 	}
 }
 
+// ReplayFromArchive re-inserts the logs of the finalized blocks in [fromBlock, toBlock] from the LogArchive, instead of
+// re-fetching them from the RPC. Only the logs matching the currently registered filters are restored.
+// Restored logs are subject to the filters' retention again. When they expire, the LogArchive skips them as they are already archived.
+func (lp *logPoller) ReplayFromArchive(ctx context.Context, fromBlock, toBlock int64) error {
+	if lp.logArchive == nil {
+		return ErrLogArchiveDisabled
+	}
+	savedFinalizedBlockNumber, err := lp.savedFinalizedBlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	if fromBlock < 1 || toBlock < fromBlock || toBlock > savedFinalizedBlockNumber {
+		return pkgerrors.Errorf("Invalid archive replay block range [%v, %v], acceptable range [1, %v]", fromBlock, toBlock, savedFinalizedBlockNumber)
+	}
+
+	lp.lggr.Debugw("Replaying from archive", "fromBlock", fromBlock, "toBlock", toBlock)
+	logs, err := lp.logArchive.Restore(ctx, fromBlock, toBlock)
+	if err != nil {
+		return err
+	}
+	// Apply the same merged filter as backfill, so the restored logs are the ones a Replay would have fetched.
+	q := lp.Filter(nil, nil, nil)
+	addresses := make(map[common.Address]struct{}, len(q.Addresses))
+	for _, addr := range q.Addresses {
+		addresses[addr] = struct{}{}
+	}
+	eventSigs := make(map[common.Hash]struct{})
+	for _, eventSig := range q.Topics[0] {
+		eventSigs[eventSig] = struct{}{}
+	}
+	var matching []Log
+	for _, l := range logs {
+		_, addrOk := addresses[l.Address]
+		_, sigOk := eventSigs[l.EventSig]
+		if addrOk && sigOk {
+			matching = append(matching, l)
+		}
+	}
+	lp.lggr.Debugw("Restored logs from archive", "fromBlock", fromBlock, "toBlock", toBlock, "logs", len(logs), "matching", len(matching))
+	if len(matching) == 0 {
+		return nil
+	}
+	return lp.orm.InsertLogs(ctx, matching)
+}
+
 // savedFinalizedBlockNumber returns the FinalizedBlockNumber saved with the last processed block in the db
 // (latestFinalizedBlock at the time the last processed block was saved)
 // If this is the first poll and no blocks are in the db, it returns 0
@@ -1099,9 +1152,16 @@ func (lp *logPoller) PruneOldBlocks(ctx context.Context) (bool, error) {
 }
 
 // PruneExpiredLogs logs that are older than their retention period defined in Filter.
+// If a LogArchive is configured, the logs are archived before they are removed, and are kept if archiving fails.
 // Returns whether all logs eligible for pruning were removed. If logPrunePageSize is set to 0, it will always return true.
 func (lp *logPoller) PruneExpiredLogs(ctx context.Context) (bool, error) {
-	rowsRemoved, err := lp.orm.DeleteExpiredLogs(ctx, lp.logPrunePageSize)
+	var rowsRemoved int64
+	var err error
+	if lp.logArchive != nil {
+		rowsRemoved, err = lp.orm.ArchiveExpiredLogs(ctx, lp.logPrunePageSize, lp.logArchive.Archive)
+	} else {
+		rowsRemoved, err = lp.orm.DeleteExpiredLogs(ctx, lp.logPrunePageSize)
+	}
 	return lp.logPrunePageSize == 0 || rowsRemoved < lp.logPrunePageSize, err
 }
 
@@ -808,6 +808,24 @@ func (_m *LogPoller) ReplayAsync(fromBlock int64) {
 	_m.Called(fromBlock)
 }
 
+// ReplayFromArchive provides a mock function with given fields: ctx, fromBlock, toBlock
+func (_m *LogPoller) ReplayFromArchive(ctx context.Context, fromBlock int64, toBlock int64) error {
+	ret := _m.Called(ctx, fromBlock, toBlock)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayFromArchive")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, fromBlock, toBlock)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *LogPoller) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -142,6 +142,12 @@ func (o *ObservedORM) DeleteExpiredLogs(ctx context.Context, limit int64) (int64
 	})
 }
 
+func (o *ObservedORM) ArchiveExpiredLogs(ctx context.Context, limit int64, archive func(context.Context, []Log) error) (int64, error) {
+	return withObservedExecAndRowsAffected(o, "ArchiveExpiredLogs", del, func() (int64, error) {
+		return o.ORM.ArchiveExpiredLogs(ctx, limit, archive)
+	})
+}
+
 func (o *ObservedORM) SelectBlockByNumber(ctx context.Context, n int64) (*LogPollerBlock, error) {
 	return withObservedQuery(o, "SelectBlockByNumber", func() (*LogPollerBlock, error) {
 		return o.ORM.SelectBlockByNumber(ctx, n)
@@ -36,6 +36,7 @@ type ORM interface {
 	DeleteBlocksBefore(ctx context.Context, end int64, limit int64) (int64, error)
 	DeleteLogsAndBlocksAfter(ctx context.Context, start int64) error
 	DeleteExpiredLogs(ctx context.Context, limit int64) (int64, error)
+	ArchiveExpiredLogs(ctx context.Context, limit int64, archive func(context.Context, []Log) error) (int64, error)
 
 	GetBlocksRange(ctx context.Context, start int64, end int64) ([]LogPollerBlock, error)
 	SelectBlockByNumber(ctx context.Context, blockNumber int64) (*LogPollerBlock, error)
@@ -314,10 +315,40 @@ type Exp struct {
 }
 
 func (o *DSORM) DeleteExpiredLogs(ctx context.Context, limit int64) (int64, error) {
-	var err error
-	var result sql.Result
+	result, err := o.ds.ExecContext(ctx, deleteExpiredLogsQuery(limit), deleteExpiredLogsArgs(o.chainID, limit)...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// ArchiveExpiredLogs deletes expired logs like DeleteExpiredLogs, and passes the deleted logs to archive before the
+// deletion is committed. If archive returns an error, no logs are deleted.
+func (o *DSORM) ArchiveExpiredLogs(ctx context.Context, limit int64, archive func(context.Context, []Log) error) (int64, error) {
+	var logs []Log
+	err := o.Transact(ctx, func(orm *DSORM) error {
+		// the query without limit joins the retention of the filters, which must not be returned
+		returning := " RETURNING *"
+		if limit <= 0 {
+			returning = " RETURNING l.*"
+		}
+		if err := orm.ds.SelectContext(ctx, &logs, deleteExpiredLogsQuery(limit)+returning, deleteExpiredLogsArgs(o.chainID, limit)...); err != nil {
+			return err
+		}
+		if len(logs) == 0 {
+			return nil
+		}
+		return archive(ctx, logs)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(logs)), nil
+}
+
+func deleteExpiredLogsQuery(limit int64) string {
 	if limit > 0 {
-		result, err = o.ds.ExecContext(ctx, `
+		return `
 		DELETE FROM evm.logs
 		WHERE (evm_chain_id, address, event_sig, block_number) IN (
 			SELECT l.evm_chain_id, l.address, l.event_sig, l.block_number
@@ -331,22 +362,22 @@ func (o *DSORM) DeleteExpiredLogs(ctx context.Context, limit int64) (int64, erro
 			) r ON l.evm_chain_id = $1 AND l.address = r.address AND l.event_sig = r.event
 			AND l.block_timestamp <= STATEMENT_TIMESTAMP() - (r.retention / 10^9 * interval '1 second')
 			LIMIT $2
-		)`, ubig.New(o.chainID), limit)
-	} else {
-		result, err = o.ds.ExecContext(ctx, `WITH r AS
+		)`
+	}
+	return `WITH r AS
 		( SELECT address, event, MAX(retention) AS retention
 			FROM evm.log_poller_filters WHERE evm_chain_id=$1 
 			GROUP BY evm_chain_id,address, event HAVING NOT 0 = ANY(ARRAY_AGG(retention))
 		) DELETE FROM evm.logs l USING r
 			WHERE l.evm_chain_id = $1 AND l.address=r.address AND l.event_sig=r.event
-			AND l.block_timestamp <= STATEMENT_TIMESTAMP() - (r.retention / 10^9 * interval '1 second')`, // retention is in nanoseconds (time.Duration aka BIGINT)
-			ubig.New(o.chainID))
-	}
+			AND l.block_timestamp <= STATEMENT_TIMESTAMP() - (r.retention / 10^9 * interval '1 second')` // retention is in nanoseconds (time.Duration aka BIGINT)
+}
 
-	if err != nil {
-		return 0, err
+func deleteExpiredLogsArgs(chainID *big.Int, limit int64) []any {
+	if limit > 0 {
+		return []any{ubig.New(chainID), limit}
 	}
-	return result.RowsAffected()
+	return []any{ubig.New(chainID)}
 }
 
 // InsertLogs is idempotent to support replays.
@@ -587,6 +587,79 @@ func insertLogsTopicValueRange(t *testing.T, chainID *big.Int, o logpoller.ORM,
 	require.NoError(t, o.InsertLogs(testutils.Context(t), lgs))
 }
 
+func TestORM_ArchiveExpiredLogs(t *testing.T) {
+	th := SetupTH(t, lpOpts)
+	o := th.ORM
+	ctx := testutils.Context(t)
+
+	expiring := common.HexToAddress("0x1234")
+	permanent := common.HexToAddress("0x1235")
+	topic := common.HexToHash("0x1599")
+	require.NoError(t, o.InsertFilter(ctx, logpoller.Filter{
+		Name:      "expiring",
+		Addresses: []common.Address{expiring},
+		EventSigs: types.HashArray{topic},
+		Retention: time.Millisecond,
+	}))
+	require.NoError(t, o.InsertFilter(ctx, logpoller.Filter{
+		Name:      "permanent",
+		Addresses: []common.Address{permanent},
+		EventSigs: types.HashArray{topic},
+	}))
+
+	old := time.Now().Add(-time.Hour)
+	require.NoError(t, o.InsertLogs(ctx, []logpoller.Log{
+		GenLogWithTimestamp(th.ChainID, 1, 1, "0x1", topic.Bytes(), expiring, old),
+		GenLogWithTimestamp(th.ChainID, 2, 1, "0x1", topic.Bytes(), permanent, old),
+		GenLogWithTimestamp(th.ChainID, 1, 2, "0x2", topic.Bytes(), expiring, old),
+		GenLogWithTimestamp(th.ChainID, 1, 3, "0x3", topic.Bytes(), expiring, old),
+	}))
+
+	// logs are kept if they can't be archived
+	archiveErr := pkgerrors.New("archive unavailable")
+	archived, err := o.ArchiveExpiredLogs(ctx, 0, func(context.Context, []logpoller.Log) error { return archiveErr })
+	require.ErrorIs(t, err, archiveErr)
+	assert.Zero(t, archived)
+	logs, err := o.SelectLogsByBlockRange(ctx, 1, 3)
+	require.NoError(t, err)
+	require.Len(t, logs, 4)
+
+	archive, err := logpoller.NewFileLogArchive(t.TempDir(), th.ChainID)
+	require.NoError(t, err)
+
+	archived, err = o.ArchiveExpiredLogs(ctx, 2, archive.Archive)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), archived)
+	archived, err = o.ArchiveExpiredLogs(ctx, 2, archive.Archive)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), archived)
+	archived, err = o.ArchiveExpiredLogs(ctx, 2, archive.Archive)
+	require.NoError(t, err)
+	assert.Zero(t, archived)
+
+	logs, err = o.SelectLogsByBlockRange(ctx, 1, 3)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.Equal(t, permanent, logs[0].Address)
+
+	restored, err := archive.Restore(ctx, 2, 3)
+	require.NoError(t, err)
+	require.Len(t, restored, 2)
+	for i, l := range restored {
+		assert.Equal(t, int64(i+2), l.BlockNumber)
+		assert.Equal(t, expiring, l.Address)
+		assert.Equal(t, topic, l.EventSig)
+		assert.Equal(t, []byte{'h', 'e', 'l', 'l', 'o', ' ', byte(i + 2)}, l.Data)
+		assert.Equal(t, th.ChainID.String(), l.EvmChainId.String())
+	}
+
+	// restored logs can be inserted back into the database
+	require.NoError(t, o.InsertLogs(ctx, restored))
+	logs, err = o.SelectLogsByBlockRange(ctx, 1, 3)
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+}
+
 func TestORM_IndexedLogs(t *testing.T) {
 	th := SetupTH(t, lpOpts)
 	o1 := th.ORM
@@ -758,6 +831,79 @@ func TestORM_IndexedLogs(t *testing.T) {
 	assert.Equal(t, 1, len(lgs))
 }
 
+func TestORM_FilteredLogs_Pagination(t *testing.T) {
+	th := SetupTH(t, lpOpts)
+	o1 := th.ORM
+	ctx := testutils.Context(t)
+	eventSig := common.HexToHash("0x1599")
+	addr1 := common.HexToAddress("0x1234")
+	addr2 := common.HexToAddress("0x5678")
+	addr3 := common.HexToAddress("0x9abc")
+
+	var logs []logpoller.Log
+	for block := int64(1); block <= 3; block++ {
+		for logIdx := int64(0); logIdx < 3; logIdx++ {
+			addr := []common.Address{addr1, addr2, addr3}[logIdx]
+			logs = append(logs, GenLog(th.ChainID, logIdx, block, fmt.Sprintf("0x%d", block), eventSig[:], addr))
+		}
+	}
+	require.NoError(t, o1.InsertLogs(ctx, logs))
+
+	filter := query.KeyFilter{
+		Expressions: []query.Expression{
+			logpoller.NewAddressFilter(addr1, addr3),
+			logpoller.NewEventSigFilter(eventSig),
+		},
+	}
+
+	lgs, err := o1.FilteredLogs(ctx, filter, query.NewLimitAndSort(query.Limit{}, query.NewSortBySequence(query.Asc)), "")
+	require.NoError(t, err)
+	require.Len(t, lgs, 6)
+
+	// page through all matching logs two at a time
+	var paged []logpoller.Log
+	limiter := query.NewLimitAndSort(query.CursorLimit("0-0x0-0", query.CursorFollowing, 2))
+	for {
+		page, err := o1.FilteredLogs(ctx, filter, limiter, "")
+		require.NoError(t, err)
+		if len(page) == 0 {
+			break
+		}
+		require.LessOrEqual(t, len(page), 2)
+		paged = append(paged, page...)
+		limiter = query.NewLimitAndSort(query.CursorLimit(logpoller.FormatContractReaderCursor(page[len(page)-1]), query.CursorFollowing, 2))
+	}
+	require.Len(t, paged, 6)
+	for i := range paged {
+		assert.Equal(t, lgs[i].BlockNumber, paged[i].BlockNumber)
+		assert.Equal(t, lgs[i].LogIndex, paged[i].LogIndex)
+	}
+
+	// and backwards from the last log
+	page, err := o1.FilteredLogs(ctx, filter, query.NewLimitAndSort(query.CursorLimit(logpoller.FormatContractReaderCursor(lgs[5]), query.CursorPrevious, 3)), "")
+	require.NoError(t, err)
+	require.Len(t, page, 3)
+	assert.Equal(t, []int64{3, 2, 2}, []int64{page[0].BlockNumber, page[1].BlockNumber, page[2].BlockNumber})
+	assert.Equal(t, []int64{0, 2, 0}, []int64{page[0].LogIndex, page[1].LogIndex, page[2].LogIndex})
+
+	// sets of values can be combined with OR expressions
+	lgs, err = o1.FilteredLogs(ctx, query.KeyFilter{
+		Expressions: []query.Expression{
+			logpoller.NewAddressFilter(addr2),
+			logpoller.NewEventByTopicValuesFilter(1, []common.Hash{common.HexToHash("0x1"), eventSig}),
+			query.Or(
+				query.Block(1, primitives.Eq),
+				query.Block(3, primitives.Eq),
+			),
+		},
+	}, query.NewLimitAndSort(query.Limit{}, query.NewSortBySequence(query.Asc)), "")
+	require.NoError(t, err)
+	require.Len(t, lgs, 2)
+	assert.Equal(t, addr2, lgs[0].Address)
+	assert.Equal(t, int64(1), lgs[0].BlockNumber)
+	assert.Equal(t, int64(3), lgs[1].BlockNumber)
+}
+
 func TestORM_SelectIndexedLogsByTxHash(t *testing.T) {
 	th := SetupTH(t, lpOpts)
 	o1 := th.ORM
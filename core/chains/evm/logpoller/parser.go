@@ -109,18 +109,39 @@ func (v *pgDSLParser) TxHash(p primitives.TxHash) {
 }
 
 func (v *pgDSLParser) VisitAddressFilter(p *addressFilter) {
-	v.expression = fmt.Sprintf(
-		"address = :%s",
-		v.args.withIndexedField("address", p.address),
-	)
+	switch len(p.addresses) {
+	case 0:
+		v.err = errors.New("address filter requires at least one address")
+	case 1:
+		v.expression = fmt.Sprintf(
+			"address = :%s",
+			v.args.withIndexedField("address", p.addresses[0]),
+		)
+	default:
+		v.expression = fmt.Sprintf(
+			"address = ANY(:%s)",
+			v.args.withIndexedField("address", p.addresses),
+		)
+	}
 }
 
 func (v *pgDSLParser) VisitEventSigFilter(p *eventSigFilter) {
-	v.expression = fmt.Sprintf(
-		"%s = :%s",
-		eventSigFieldName,
-		v.args.withIndexedField(eventSigFieldName, p.eventSig),
-	)
+	switch len(p.eventSigs) {
+	case 0:
+		v.err = errors.New("event sig filter requires at least one event sig")
+	case 1:
+		v.expression = fmt.Sprintf(
+			"%s = :%s",
+			eventSigFieldName,
+			v.args.withIndexedField(eventSigFieldName, p.eventSigs[0]),
+		)
+	default:
+		v.expression = fmt.Sprintf(
+			"%s = ANY(:%s)",
+			eventSigFieldName,
+			v.args.withIndexedField(eventSigFieldName, p.eventSigs),
+		)
+	}
 }
 
 func (v *pgDSLParser) nestedConfQuery(finalized bool, confs uint64) string {
@@ -188,6 +209,27 @@ func (v *pgDSLParser) VisitEventTopicsByValueFilter(p *eventByTopicFilter) {
 	}
 }
 
+func (v *pgDSLParser) VisitEventTopicsByValuesFilter(p *eventByTopicValuesFilter) {
+	if !(p.Topic == 1 || p.Topic == 2 || p.Topic == 3) {
+		v.err = fmt.Errorf("invalid index for topic: %d", p.Topic)
+
+		return
+	}
+
+	if len(p.Values) == 0 {
+		v.err = errors.New("topic values filter requires at least one value")
+
+		return
+	}
+
+	v.expression = fmt.Sprintf(
+		"topics[:%s] = ANY(:%s)",
+		// Add 1 since postgresql arrays are 1-indexed.
+		v.args.withIndexedField("topic_index", p.Topic+1),
+		v.args.withIndexedField("topic_values", p.Values),
+	)
+}
+
 func (v *pgDSLParser) VisitConfirmationsFilter(p *confirmationsFilter) {
 	switch p.Confirmations {
 	case evmtypes.Finalized:
@@ -268,15 +310,15 @@ func (v *pgDSLParser) whereClause(expressions []query.Expression, limiter query.
 			return "", errors.New("invalid cursor direction")
 		}
 
-		block, txHash, logIdx, err := valuesFromCursor(limiter.Limit.Cursor)
+		block, _, logIdx, err := valuesFromCursor(limiter.Limit.Cursor)
 		if err != nil {
 			return "", err
 		}
 
-		segment = fmt.Sprintf("%s AND block_number %s= :cursor_block AND tx_hash %s= :cursor_txhash AND log_index %s :cursor_log_index", segment, op, op, op)
+		// logs are uniquely identified by their block number and log index, which is also the order of sequence sorting
+		segment = fmt.Sprintf("%s AND (block_number %s :cursor_block_number OR (block_number = :cursor_block_number AND log_index %s :cursor_log_index))", segment, op, op)
 
 		v.args.withField("cursor_block_number", block).
-			withField("cursor_txhash", common.HexToHash(txHash)).
 			withField("cursor_log_index", logIdx)
 	}
 
@@ -319,7 +361,7 @@ func (v *pgDSLParser) orderClause(limiter query.LimitAndSort) (string, error) {
 		case query.SortByBlock:
 			name = blockFieldName
 		case query.SortBySequence:
-			sort[idx] = fmt.Sprintf("block_number %s, tx_hash %s, log_index %s", order, order, order)
+			sort[idx] = fmt.Sprintf("block_number %s, log_index %s", order, order)
 
 			continue
 		case query.SortByTimestamp:
@@ -434,13 +476,20 @@ func valuesFromCursor(cursor string) (int64, string, int, error) {
 	return block, parts[1], int(logIdx), nil
 }
 
+// FormatContractReaderCursor returns the cursor pointing at log, which is used to fetch the logs
+// following or preceding it with a query.CursorLimit.
+func FormatContractReaderCursor(log Log) string {
+	return fmt.Sprintf("%d-%s-%d", log.BlockNumber, log.TxHash, log.LogIndex)
+}
+
 type addressFilter struct {
-	address common.Address
+	addresses []common.Address
 }
 
-func NewAddressFilter(address common.Address) query.Expression {
+// NewAddressFilter matches logs emitted by any of the given addresses.
+func NewAddressFilter(addresses ...common.Address) query.Expression {
 	return query.Expression{
-		Primitive: &addressFilter{address: address},
+		Primitive: &addressFilter{addresses: addresses},
 	}
 }
 
@@ -452,12 +501,13 @@ func (f *addressFilter) Accept(visitor primitives.Visitor) {
 }
 
 type eventSigFilter struct {
-	eventSig common.Hash
+	eventSigs []common.Hash
 }
 
-func NewEventSigFilter(hash common.Hash) query.Expression {
+// NewEventSigFilter matches logs with any of the given event signatures.
+func NewEventSigFilter(hashes ...common.Hash) query.Expression {
 	return query.Expression{
-		Primitive: &eventSigFilter{eventSig: hash},
+		Primitive: &eventSigFilter{eventSigs: hashes},
 	}
 }
 
@@ -508,6 +558,26 @@ func (f *eventByTopicFilter) Accept(visitor primitives.Visitor) {
 	}
 }
 
+type eventByTopicValuesFilter struct {
+	Topic  uint64
+	Values []common.Hash
+}
+
+// NewEventByTopicValuesFilter matches logs whose topic at topicIndex is any of the given values.
+func NewEventByTopicValuesFilter(topicIndex uint64, values []common.Hash) query.Expression {
+	return query.Expression{Primitive: &eventByTopicValuesFilter{
+		Topic:  topicIndex,
+		Values: values,
+	}}
+}
+
+func (f *eventByTopicValuesFilter) Accept(visitor primitives.Visitor) {
+	switch v := visitor.(type) {
+	case *pgDSLParser:
+		v.VisitEventTopicsByValuesFilter(f)
+	}
+}
+
 type confirmationsFilter struct {
 	Confirmations evmtypes.Confirmations
 }
@@ -54,14 +54,14 @@ func TestDSLParser(t *testing.T) {
 			"FROM evm.logs " +
 			"WHERE evm_chain_id = :evm_chain_id " +
 			"AND (address = :address_0 AND event_sig = :event_sig_0) " +
-			"AND block_number >= :cursor_block AND tx_hash >= :cursor_txhash AND log_index > :cursor_log_index " +
-			"ORDER BY block_number ASC, tx_hash ASC, log_index ASC " +
+			"AND (block_number > :cursor_block_number OR (block_number = :cursor_block_number AND log_index > :cursor_log_index)) " +
+			"ORDER BY block_number ASC, log_index ASC " +
 			"LIMIT 20"
 
 		require.NoError(t, err)
 		assert.Equal(t, expected, result)
 
-		assertArgs(t, args, 6)
+		assertArgs(t, args, 5)
 	})
 
 	t.Run("query with limit and no order by", func(t *testing.T) {
@@ -100,7 +100,7 @@ func TestDSLParser(t *testing.T) {
 		expected := "SELECT evm.logs.* " +
 			"FROM evm.logs " +
 			"WHERE evm_chain_id = :evm_chain_id " +
-			"ORDER BY block_number DESC, tx_hash DESC, log_index DESC"
+			"ORDER BY block_number DESC, log_index DESC"
 
 		require.NoError(t, err)
 		assert.Equal(t, expected, result)
@@ -152,13 +152,13 @@ func TestDSLParser(t *testing.T) {
 			"AND block_number <= " +
 			"(SELECT finalized_block_number FROM evm.log_poller_blocks WHERE evm_chain_id = :evm_chain_id ORDER BY block_number DESC LIMIT 1) " +
 			"AND block_number <= (SELECT greatest(block_number - :confs_0, 0) FROM evm.log_poller_blocks WHERE evm_chain_id = :evm_chain_id ORDER BY block_number DESC LIMIT 1)) " +
-			"AND block_number <= :cursor_block AND tx_hash <= :cursor_txhash AND log_index < :cursor_log_index " +
-			"ORDER BY block_number DESC, tx_hash DESC, log_index DESC LIMIT 20"
+			"AND (block_number < :cursor_block_number OR (block_number = :cursor_block_number AND log_index < :cursor_log_index)) " +
+			"ORDER BY block_number DESC, log_index DESC LIMIT 20"
 
 		require.NoError(t, err)
 		assert.Equal(t, expected, result)
 
-		assertArgs(t, args, 8)
+		assertArgs(t, args, 7)
 	})
 
 	t.Run("query for finality", func(t *testing.T) {
@@ -276,6 +276,61 @@ func TestDSLParser(t *testing.T) {
 		assertArgs(t, args, 4)
 	})
 
+	t.Run("query for sets of addresses, event sigs and topic values", func(t *testing.T) {
+		t.Parallel()
+
+		parser := &pgDSLParser{}
+		chainID := big.NewInt(1)
+		expressions := []query.Expression{
+			NewAddressFilter(common.HexToAddress("0x42"), common.HexToAddress("0x43")),
+			NewEventSigFilter(common.HexToHash("0x21"), common.HexToHash("0x22")),
+			NewEventByTopicValuesFilter(1, []common.Hash{common.HexToHash("0x1"), common.HexToHash("0x2")}),
+		}
+		limiter := query.LimitAndSort{}
+
+		result, args, err := parser.buildQuery(chainID, expressions, limiter)
+		expected := "SELECT evm.logs.* " +
+			"FROM evm.logs " +
+			"WHERE evm_chain_id = :evm_chain_id " +
+			"AND (address = ANY(:address_0) AND event_sig = ANY(:event_sig_0) AND topics[:topic_index_0] = ANY(:topic_values_0))"
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+
+		assert.Equal(t, [][]byte{common.HexToAddress("0x42").Bytes(), common.HexToAddress("0x43").Bytes()}, args.args["address_0"])
+		assert.Equal(t, 2, args.args["topic_index_0"])
+		assertArgs(t, args, 5)
+	})
+
+	t.Run("query for empty sets fails", func(t *testing.T) {
+		t.Parallel()
+
+		for _, expression := range []query.Expression{
+			NewAddressFilter(),
+			NewEventSigFilter(),
+			NewEventByTopicValuesFilter(1, nil),
+		} {
+			_, _, err := (&pgDSLParser{}).buildQuery(big.NewInt(1), []query.Expression{expression}, query.LimitAndSort{})
+			require.Error(t, err)
+		}
+
+		_, _, err := (&pgDSLParser{}).buildQuery(big.NewInt(1), []query.Expression{
+			NewEventByTopicValuesFilter(4, []common.Hash{common.HexToHash("0x1")}),
+		}, query.LimitAndSort{})
+		require.EqualError(t, err, "invalid index for topic: 4")
+	})
+
+	t.Run("cursor of a log can be parsed", func(t *testing.T) {
+		t.Parallel()
+
+		cursor := FormatContractReaderCursor(Log{BlockNumber: 10, TxHash: common.HexToHash("0x42"), LogIndex: 5})
+		block, txHash, logIdx, err := valuesFromCursor(cursor)
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), block)
+		assert.Equal(t, common.HexToHash("0x42").String(), txHash)
+		assert.Equal(t, 5, logIdx)
+	})
+
 	// nested query -> a & (b || c)
 	t.Run("nested query", func(t *testing.T) {
 		t.Parallel()
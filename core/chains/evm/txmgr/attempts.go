@@ -8,6 +8,9 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
 	pkgerrors "github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
@@ -46,11 +49,18 @@ func NewEvmTxAttemptBuilder(chainID big.Int, feeConfig evmTxAttemptBuilderFeeCon
 
 // NewTxAttempt builds an new attempt using the configured fee estimator + using the EIP1559 config to determine tx type
 // used for when a brand new transaction is being created in the txm
+// Txs carrying blobs are always built as EIP4844 blob transactions, which requires EIP1559 to be enabled
 func (c *evmTxAttemptBuilder) NewTxAttempt(ctx context.Context, etx Tx, lggr logger.Logger, opts ...feetypes.Opt) (attempt TxAttempt, fee gas.EvmFee, feeLimit uint64, retryable bool, err error) {
 	txType := 0x0
 	if c.feeConfig.EIP1559DynamicFees() {
 		txType = 0x2
 	}
+	if len(etx.Blobs) > 0 {
+		if !c.feeConfig.EIP1559DynamicFees() {
+			return attempt, fee, feeLimit, false, pkgerrors.Errorf("cannot create blob transaction %v: EIP1559DynamicFees must be enabled to send blob transactions", etx.ID) // not retryable
+		}
+		txType = 0x3
+	}
 	return c.NewTxAttemptWithType(ctx, etx, lggr, txType, opts...)
 }
 
@@ -62,6 +72,12 @@ func (c *evmTxAttemptBuilder) NewTxAttemptWithType(ctx context.Context, etx Tx,
 	if err != nil {
 		return attempt, fee, feeLimit, true, pkgerrors.Wrap(err, "failed to get fee") // estimator errors are retryable
 	}
+	if txType == 0x3 {
+		fee.BlobFeeCap, err = c.EvmFeeEstimator.GetBlobFee(ctx, keySpecificMaxGasPriceWei)
+		if err != nil {
+			return attempt, fee, feeLimit, true, pkgerrors.Wrap(err, "failed to get blob fee") // estimator errors are retryable
+		}
+	}
 
 	attempt, retryable, err = c.NewCustomTxAttempt(ctx, etx, fee, feeLimit, txType, lggr)
 	return attempt, fee, feeLimit, retryable, err
@@ -134,6 +150,22 @@ func (c *evmTxAttemptBuilder) NewCustomTxAttempt(ctx context.Context, etx Tx, fe
 			TipCap: fee.DynamicTipCap,
 		}, gasLimit)
 		return attempt, true, err
+	case 0x3: // blob, EIP4844
+		if !fee.ValidDynamic() || fee.BlobFeeCap == nil {
+			err = pkgerrors.Errorf("Attempt %v is a type 3 transaction but estimator did not return dynamic and blob fee bump", attempt.ID)
+			logger.Sugared(lggr).AssumptionViolation(err.Error())
+			return attempt, false, err // not retryable
+		}
+		var sidecar *types.BlobTxSidecar
+		sidecar, err = newBlobTxSidecar(etx.Blobs)
+		if err != nil {
+			return attempt, false, pkgerrors.Wrapf(err, "invalid blobs for transaction %v", etx.ID) // not retryable
+		}
+		attempt, err = c.newBlobAttempt(ctx, etx, gas.DynamicFee{
+			FeeCap: fee.DynamicFeeCap,
+			TipCap: fee.DynamicTipCap,
+		}, fee.BlobFeeCap, sidecar, gasLimit)
+		return attempt, true, err
 	default:
 		err = pkgerrors.Errorf("invariant violation: Attempt %v had unrecognised transaction type %v"+
 			"This is a bug! Please report to https://github.com/smartcontractkit/chainlink/issues", attempt.ID, attempt.TxType)
@@ -200,6 +232,99 @@ func (c *evmTxAttemptBuilder) newDynamicFeeAttempt(ctx context.Context, etx Tx,
 	return attempt, nil
 }
 
+func (c *evmTxAttemptBuilder) newBlobAttempt(ctx context.Context, etx Tx, fee gas.DynamicFee, blobFeeCap *assets.Wei, sidecar *types.BlobTxSidecar, gasLimit uint64) (attempt TxAttempt, err error) {
+	if err = validateDynamicFeeGas(c.feeConfig, c.feeConfig.TipCapMin(), fee, etx); err != nil {
+		return attempt, pkgerrors.Wrap(err, "error validating gas")
+	}
+	if err = validateBlobFeeGas(c.feeConfig, blobFeeCap, etx); err != nil {
+		return attempt, pkgerrors.Wrap(err, "error validating blob gas")
+	}
+
+	b := newBlobTransaction(
+		uint64(*etx.Sequence),
+		etx.ToAddress,
+		&etx.Value,
+		gasLimit,
+		&c.chainID,
+		fee.TipCap,
+		fee.FeeCap,
+		blobFeeCap,
+		etx.EncodedPayload,
+		sidecar,
+	)
+	tx := types.NewTx(&b)
+	attempt, err = c.newSignedAttempt(ctx, etx, tx)
+	if err != nil {
+		return attempt, err
+	}
+	attempt.TxFee = gas.EvmFee{
+		DynamicFeeCap: fee.FeeCap,
+		DynamicTipCap: fee.TipCap,
+		BlobFeeCap:    blobFeeCap,
+	}
+	attempt.ChainSpecificFeeLimit = gasLimit
+	attempt.TxType = 3
+	return attempt, nil
+}
+
+// validateBlobFeeGas is a sanity check on the blob fee cap, on top of validateDynamicFeeGas
+func validateBlobFeeGas(kse keySpecificEstimator, blobFeeCap *assets.Wei, etx Tx) error {
+	if blobFeeCap == nil {
+		panic("blob fee cap missing")
+	}
+	if blobFeeCap.ToInt().Cmp(Max256BitUInt) >= 0 {
+		return pkgerrors.New("impossibly large blob fee cap")
+	}
+	max := kse.PriceMaxKey(etx.FromAddress)
+	if blobFeeCap.Cmp(max) > 0 {
+		return pkgerrors.Errorf("cannot create tx attempt: specified blob fee cap of %s would exceed max configured gas price of %s for key %s", blobFeeCap.String(), max.String(), etx.FromAddress.String())
+	}
+	return nil
+}
+
+// newBlobTxSidecar computes the KZG commitments and proofs of blobs
+func newBlobTxSidecar(blobs [][]byte) (*types.BlobTxSidecar, error) {
+	if maxBlobs := params.MaxBlobGasPerBlock / params.BlobTxBlobGasPerBlob; len(blobs) > maxBlobs {
+		return nil, pkgerrors.Errorf("transaction has %d blobs, at most %d are allowed", len(blobs), maxBlobs)
+	}
+	sidecar := new(types.BlobTxSidecar)
+	for i, data := range blobs {
+		var blob kzg4844.Blob
+		if len(data) != len(blob) {
+			return nil, pkgerrors.Errorf("blob %d has length %d, blobs must be exactly %d bytes", i, len(data), len(blob))
+		}
+		copy(blob[:], data)
+		commitment, err := kzg4844.BlobToCommitment(blob)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to compute KZG commitment of blob %d", i)
+		}
+		proof, err := kzg4844.ComputeBlobProof(blob, commitment)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "failed to compute KZG proof of blob %d", i)
+		}
+		sidecar.Blobs = append(sidecar.Blobs, blob)
+		sidecar.Commitments = append(sidecar.Commitments, commitment)
+		sidecar.Proofs = append(sidecar.Proofs, proof)
+	}
+	return sidecar, nil
+}
+
+func newBlobTransaction(nonce uint64, to common.Address, value *big.Int, gasLimit uint64, chainID *big.Int, gasTipCap, gasFeeCap, blobFeeCap *assets.Wei, data []byte, sidecar *types.BlobTxSidecar) types.BlobTx {
+	return types.BlobTx{
+		ChainID:    uint256.MustFromBig(chainID),
+		Nonce:      nonce,
+		GasTipCap:  uint256.MustFromBig(gasTipCap.ToInt()),
+		GasFeeCap:  uint256.MustFromBig(gasFeeCap.ToInt()),
+		Gas:        gasLimit,
+		To:         to,
+		Value:      uint256.MustFromBig(value),
+		Data:       data,
+		BlobFeeCap: uint256.MustFromBig(blobFeeCap.ToInt()),
+		BlobHashes: sidecar.BlobHashes(),
+		Sidecar:    sidecar,
+	}
+}
+
 var Max256BitUInt = big.NewInt(0).Exp(big.NewInt(2), big.NewInt(256), nil)
 
 type keySpecificEstimator interface {
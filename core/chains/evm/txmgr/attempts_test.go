@@ -1,6 +1,7 @@
 package txmgr_test
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"testing"
@@ -226,6 +227,89 @@ func TestTxm_NewLegacyAttempt(t *testing.T) {
 	})
 }
 
+func TestTxm_NewBlobAttempt(t *testing.T) {
+	addr := NewEvmAddress()
+	kst := ksmocks.NewEth(t)
+	kst.On("SignTx", mock.Anything, addr, mock.Anything, big.NewInt(1)).Return(
+		func(_ context.Context, _ gethcommon.Address, tx *types.Transaction, _ *big.Int) (*types.Transaction, error) {
+			return tx, nil
+		}).Maybe()
+	gc := newFeeConfig()
+	gc.eip1559DynamicFees = true
+	gc.priceMax = assets.GWei(50)
+	cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, nil)
+	lggr := logger.Test(t)
+	ctx := testutils.Context(t)
+
+	blobFee := gas.EvmFee{DynamicTipCap: assets.GWei(1), DynamicFeeCap: assets.GWei(2), BlobFeeCap: assets.GWei(3)}
+	newBlobTx := func(blobs ...[]byte) txmgr.Tx {
+		var n evmtypes.Nonce
+		return txmgr.Tx{Sequence: &n, FromAddress: addr, Blobs: blobs}
+	}
+
+	t.Run("creates attempt with fields", func(t *testing.T) {
+		a, _, err := cks.NewCustomTxAttempt(ctx, newBlobTx(make([]byte, 131072), make([]byte, 131072)), blobFee, 100, 0x3, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, 3, a.TxType)
+		assert.Equal(t, 100, int(a.ChainSpecificFeeLimit))
+		assert.Nil(t, a.TxFee.Legacy)
+		assert.Equal(t, blobFee, a.TxFee)
+
+		signedTx, err := txmgr.GetGethSignedTx(a.SignedRawTx)
+		require.NoError(t, err)
+		assert.Equal(t, a.Hash, signedTx.Hash())
+		assert.Equal(t, uint8(types.BlobTxType), signedTx.Type())
+		assert.Equal(t, assets.GWei(3).ToInt(), signedTx.BlobGasFeeCap())
+		assert.Len(t, signedTx.BlobHashes(), 2)
+		require.NotNil(t, signedTx.BlobTxSidecar())
+		assert.Len(t, signedTx.BlobTxSidecar().Blobs, 2)
+	})
+
+	t.Run("verifies blobs", func(t *testing.T) {
+		_, retryable, err := cks.NewCustomTxAttempt(ctx, newBlobTx([]byte{1}), blobFee, 100, 0x3, lggr)
+		require.ErrorContains(t, err, "blob 0 has length 1, blobs must be exactly 131072 bytes")
+		assert.False(t, retryable)
+	})
+
+	t.Run("verifies max blob fee cap", func(t *testing.T) {
+		fee := blobFee
+		fee.BlobFeeCap = assets.GWei(60)
+		_, _, err := cks.NewCustomTxAttempt(ctx, newBlobTx(make([]byte, 131072)), fee, 100, 0x3, lggr)
+		require.ErrorContains(t, err, fmt.Sprintf("specified blob fee cap of 60 gwei would exceed max configured gas price of 50 gwei for key %s", addr.String()))
+	})
+
+	t.Run("requires blob fee", func(t *testing.T) {
+		_, retryable, err := cks.NewCustomTxAttempt(ctx, newBlobTx(make([]byte, 131072)), gas.EvmFee{
+			DynamicTipCap: blobFee.DynamicTipCap,
+			DynamicFeeCap: blobFee.DynamicFeeCap,
+		}, 100, 0x3, lggr)
+		require.Error(t, err)
+		assert.False(t, retryable)
+	})
+
+	t.Run("estimates blob fee for new attempts of txes with blobs", func(t *testing.T) {
+		est := gasmocks.NewEvmFeeEstimator(t)
+		est.On("GetFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(gas.EvmFee{
+			DynamicTipCap: blobFee.DynamicTipCap,
+			DynamicFeeCap: blobFee.DynamicFeeCap,
+		}, uint64(100), nil).Once()
+		est.On("GetBlobFee", mock.Anything, gc.priceMax).Return(blobFee.BlobFeeCap, nil).Once()
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, est)
+
+		a, fee, _, _, err := cks.NewTxAttempt(ctx, newBlobTx(make([]byte, 131072)), lggr)
+		require.NoError(t, err)
+		assert.Equal(t, 3, a.TxType)
+		assert.Equal(t, blobFee, fee)
+	})
+
+	t.Run("requires EIP1559 for txes with blobs", func(t *testing.T) {
+		cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), newFeeConfig(), kst, nil)
+		_, _, _, retryable, err := cks.NewTxAttempt(ctx, newBlobTx(make([]byte, 131072)), lggr)
+		require.ErrorContains(t, err, "EIP1559DynamicFees must be enabled to send blob transactions")
+		assert.False(t, retryable)
+	})
+}
+
 func TestTxm_NewPurgeAttempt(t *testing.T) {
 	addr := NewEvmAddress()
 	kst := ksmocks.NewEth(t)
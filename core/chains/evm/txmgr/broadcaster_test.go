@@ -604,6 +604,86 @@ func TestEthBroadcaster_TransmitChecking(t *testing.T) {
 	})
 }
 
+func TestEthBroadcaster_ProcessUnstartedEthTxs_Simulate(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	ctx := testutils.Context(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(0), nil).Once()
+	nonceTracker := txmgr.NewNonceTracker(logger.Test(t), txStore, txmgr.NewEvmTxmClient(ethClient, nil))
+	eb := NewTestEthBroadcaster(t, txStore, ethClient, ethKeyStore, cfg, evmcfg, &testCheckerFactory{}, false, nonceTracker)
+
+	checker := txmgr.TransmitCheckerSpec{Simulate: true}
+	simulation := func(value string) interface{} {
+		return mock.MatchedBy(func(callarg map[string]interface{}) bool {
+			return fmt.Sprintf("%s", callarg["value"]) == value
+		})
+	}
+
+	t.Run("when simulation succeeds, sends tx as normal", func(t *testing.T) {
+		ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", simulation("0x1ba"), "pending").Return(nil).Once() // 442
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 0
+		}), fromAddress).Return(commonclient.Successful, nil).Once()
+
+		ethTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID,
+			txRequestWithChecker(checker),
+			txRequestWithValue(big.Int(assets.NewEthValue(442))))
+		retryable, err := eb.ProcessUnstartedTxs(ctx, fromAddress)
+		require.NoError(t, err)
+		assert.False(t, retryable)
+
+		ethTx, err = txStore.FindTxWithAttempts(ctx, ethTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, ethTx.State)
+	})
+
+	t.Run("when simulation cannot be completed, sends tx as normal", func(t *testing.T) {
+		ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", simulation("0x21e"), "pending").Return(errors.New("connection reset")).Once() // 542
+		ethClient.On("SendTransactionReturnCode", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == 1
+		}), fromAddress).Return(commonclient.Successful, nil).Once()
+
+		ethTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID,
+			txRequestWithChecker(checker),
+			txRequestWithValue(big.Int(assets.NewEthValue(542))))
+		retryable, err := eb.ProcessUnstartedTxs(ctx, fromAddress)
+		require.NoError(t, err)
+		assert.False(t, retryable)
+
+		ethTx, err = txStore.FindTxWithAttempts(ctx, ethTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, ethTx.State)
+	})
+
+	t.Run("on revert, marks tx as fatally errored with the decoded revert reason and does not send", func(t *testing.T) {
+		jerr := client.JsonError{
+			Code:    3,
+			Message: "execution reverted: not allowed",
+			// Error(string) encoding of "not allowed"
+			Data: "0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000b6e6f7420616c6c6f776564000000000000000000000000000000000000000000",
+		}
+		ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", simulation("0x282"), "pending").Return(&jerr).Once() // 642
+
+		ethTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID,
+			txRequestWithChecker(checker),
+			txRequestWithValue(big.Int(assets.NewEthValue(642))))
+		retryable, err := eb.ProcessUnstartedTxs(ctx, fromAddress)
+		require.NoError(t, err)
+		assert.False(t, retryable)
+
+		ethTx, err = txStore.FindTxWithAttempts(ctx, ethTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxFatalError, ethTx.State)
+		assert.Equal(t, "transaction reverted during simulation: execution reverted: not allowed", ethTx.Error.String)
+	})
+}
+
 func TestEthBroadcaster_ProcessUnstartedEthTxs_OptimisticLockingOnEthTx(t *testing.T) {
 	// non-transactional DB needed because we deliberately test for FK violation
 	cfg, db := heavyweight.FullTestDBV2(t, nil)
@@ -707,6 +787,52 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success_WithMultiplier(t *testing
 	}
 }
 
+func TestEthBroadcaster_ProcessUnstartedEthTxs_PriorityLanes(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	ctx := testutils.Context(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("PendingNonceAt", mock.Anything, fromAddress).Return(uint64(0), nil).Once()
+	ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, fromAddress).Return(commonclient.Successful, nil).Times(6)
+	nonceTracker := txmgr.NewNonceTracker(logger.Test(t), txStore, txmgr.NewEvmTxmClient(ethClient, nil))
+	eb := NewTestEthBroadcaster(t, txStore, ethClient, ethKeyStore, cfg, evmcfg, &testCheckerFactory{}, false, nonceTracker)
+
+	criticalStrategy := txmgrcommon.NewPriorityStrategy(txmgrcommon.NewSendEveryStrategy(), txmgrtypes.TxPriorityCritical)
+	var normal, critical []int64
+	for i := 0; i < 3; i++ {
+		normal = append(normal, mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID).ID)
+	}
+	for i := 0; i < 3; i++ {
+		critical = append(critical, mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID, txRequestWithStrategy(criticalStrategy)).ID)
+	}
+
+	retryable, err := eb.ProcessUnstartedTxs(ctx, fromAddress)
+	require.NoError(t, err)
+	assert.False(t, retryable)
+
+	// The critical lane gets four times the share of the normal lane, and wins ties.
+	// Txes within a lane keep their order.
+	expected := map[int64]evmtypes.Nonce{
+		critical[0]: 0,
+		normal[0]:   1,
+		critical[1]: 2,
+		critical[2]: 3,
+		normal[1]:   4,
+		normal[2]:   5,
+	}
+	for id, nonce := range expected {
+		etx, err := txStore.FindTxWithAttempts(ctx, id)
+		require.NoError(t, err)
+		require.NotNil(t, etx.Sequence)
+		assert.Equal(t, nonce, *etx.Sequence, "tx %d", id)
+	}
+}
+
 func TestEthBroadcaster_ProcessUnstartedEthTxs_ResumingFromCrash(t *testing.T) {
 	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
 	value := big.Int(assets.NewEthValue(142))
@@ -169,6 +169,23 @@ func (c *evmTxmClient) SendEmptyTransaction(
 	return signedTx.Hash().String(), err
 }
 
+func (c *evmTxmClient) SimulateTransaction(ctx context.Context, a TxAttempt) (revertErr error, err error) {
+	err = client.SimulateCall(ctx, c.client, ethereum.CallMsg{
+		From: a.Tx.FromAddress,
+		To:   &a.Tx.ToAddress,
+		Gas:  a.ChainSpecificFeeLimit,
+		// NOTE: Deliberately do not include gas prices. We never want to fatally error a
+		// transaction just because the wallet has insufficient eth.
+		Value: &a.Tx.Value,
+		Data:  a.Tx.EncodedPayload,
+	})
+	var rErr *client.RevertError
+	if errors.As(err, &rErr) {
+		return rErr, nil
+	}
+	return nil, err
+}
+
 func (c *evmTxmClient) CallContract(ctx context.Context, a TxAttempt, blockNumber *big.Int) (rpcErr fmt.Stringer, extractErr error) {
 	_, errCall := c.client.CallContract(ctx, ethereum.CallMsg{
 		From:       a.Tx.FromAddress,
@@ -1,7 +1,6 @@
 package txmgr_test
 
 import (
-	"database/sql"
 	"fmt"
 	"math/big"
 	"testing"
@@ -1243,29 +1242,77 @@ func TestORM_SaveReplacementInProgressAttempt(t *testing.T) {
 	})
 }
 
-func TestORM_FindNextUnstartedTransactionFromAddress(t *testing.T) {
+func TestORM_FindUnstartedTransactionQueueHeads(t *testing.T) {
 	t.Parallel()
 
 	db := pgtest.NewSqlxDB(t)
 	txStore := cltest.NewTestTxStore(t, db)
 	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
 	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ctx := testutils.Context(t)
 
 	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
 
-	t.Run("cannot find unstarted tx", func(t *testing.T) {
+	t.Run("no unstarted txes", func(t *testing.T) {
 		mustInsertInProgressEthTxWithAttempt(t, txStore, 13, fromAddress)
 
-		resultEtx, err := txStore.FindNextUnstartedTransactionFromAddress(testutils.Context(t), fromAddress, ethClient.ConfiguredChainID())
-		assert.ErrorIs(t, err, sql.ErrNoRows)
-		assert.Nil(t, resultEtx)
+		heads, err := txStore.FindUnstartedTransactionQueueHeads(ctx, fromAddress, ethClient.ConfiguredChainID())
+		require.NoError(t, err)
+		assert.Empty(t, heads)
 	})
 
-	t.Run("finds unstarted tx", func(t *testing.T) {
+	t.Run("returns the oldest unstarted tx of each priority and subject", func(t *testing.T) {
+		subject := uuid.New()
+		highStrategy := txmgrcommon.NewPriorityStrategy(txmgrcommon.NewDropOldestStrategy(subject, 10), txmgrtypes.TxPriorityHigh)
+
+		normal1 := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID)
 		mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID)
-		resultEtx, err := txStore.FindNextUnstartedTransactionFromAddress(testutils.Context(t), fromAddress, ethClient.ConfiguredChainID())
+		high1 := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID, txRequestWithStrategy(highStrategy))
+		mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID, txRequestWithStrategy(highStrategy))
+
+		heads, err := txStore.FindUnstartedTransactionQueueHeads(ctx, fromAddress, ethClient.ConfiguredChainID())
 		require.NoError(t, err)
-		assert.NotNil(t, resultEtx)
+		require.Len(t, heads, 2)
+		assert.Equal(t, normal1.ID, heads[0].ID)
+		assert.Equal(t, txmgrtypes.TxPriorityNormal, heads[0].Priority)
+		assert.False(t, heads[0].Subject.Valid)
+		assert.Equal(t, high1.ID, heads[1].ID)
+		assert.Equal(t, txmgrtypes.TxPriorityHigh, heads[1].Priority)
+		assert.Equal(t, subject, heads[1].Subject.UUID)
+	})
+}
+
+func TestORM_BlobTxes(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	ctx := testutils.Context(t)
+
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	blob := make([]byte, 131072)
+	blob[0] = 1
+
+	etx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, &cltest.FixtureChainID, txRequestWithBlobs(blob))
+	assert.Equal(t, [][]byte{blob}, etx.Blobs)
+
+	attempt := cltest.NewDynamicFeeEthTxAttempt(t, etx.ID)
+	attempt.TxType = 0x3
+	attempt.TxFee.BlobFeeCap = assets.NewWeiI(7)
+	require.NoError(t, txStore.InsertTxAttempt(ctx, &attempt))
+
+	etx, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{blob}, etx.Blobs)
+	require.Len(t, etx.TxAttempts, 1)
+	assert.Equal(t, 3, etx.TxAttempts[0].TxType)
+	assert.Equal(t, assets.NewWeiI(7), etx.TxAttempts[0].TxFee.BlobFeeCap)
+
+	t.Run("blob attempts require a blob fee cap", func(t *testing.T) {
+		attempt := cltest.NewDynamicFeeEthTxAttempt(t, etx.ID)
+		attempt.TxType = 0x3
+		require.Error(t, txStore.InsertTxAttempt(ctx, &attempt))
 	})
 }
 
@@ -1733,6 +1780,7 @@ func TestORM_CreateTransaction(t *testing.T) {
 		subject := uuid.New()
 		strategy := newMockTxStrategy(t)
 		strategy.On("Subject").Return(uuid.NullUUID{UUID: subject, Valid: true})
+		strategy.On("Priority").Return(txmgrtypes.TxPriorityNormal)
 		etx, err := txStore.CreateTransaction(testutils.Context(t), txmgr.TxRequest{
 			FromAddress:    fromAddress,
 			ToAddress:      toAddress,
@@ -1789,6 +1837,7 @@ func TestORM_CreateTransaction(t *testing.T) {
 		subject := uuid.New()
 		strategy := newMockTxStrategy(t)
 		strategy.On("Subject").Return(uuid.NullUUID{UUID: subject, Valid: true})
+		strategy.On("Priority").Return(txmgrtypes.TxPriorityNormal)
 		etx, err := txStore.CreateTransaction(testutils.Context(t), txmgr.TxRequest{
 			FromAddress:    fromAddress,
 			ToAddress:      toAddress,
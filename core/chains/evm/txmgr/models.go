@@ -48,7 +48,8 @@ var _ KeyStore = (keystore.Eth)(nil) // check interface in txmgr to avoid circul
 
 const (
 	// TransmitCheckerTypeSimulate is a checker that simulates the transaction before executing on
-	// chain.
+	// chain. New callers should set TransmitCheckerSpec.Simulate instead, which simulates against the
+	// pending state, decodes the revert reason, and can be combined with other checkers.
 	TransmitCheckerTypeSimulate = txmgrtypes.TransmitCheckerType("simulate")
 
 	// TransmitCheckerTypeVRFV1 is a checker that will not submit VRF V1 fulfillment requests that
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 )
@@ -19,6 +20,7 @@ func Test_SendEveryStrategy(t *testing.T) {
 	s := txmgrcommon.SendEveryStrategy{}
 
 	assert.Equal(t, uuid.NullUUID{}, s.Subject())
+	assert.Equal(t, txmgrtypes.TxPriorityNormal, s.Priority())
 
 	ids, err := s.PruneQueue(testutils.Context(t), nil)
 	assert.NoError(t, err)
@@ -33,6 +35,7 @@ func Test_DropOldestStrategy_Subject(t *testing.T) {
 
 	assert.True(t, s.Subject().Valid)
 	assert.Equal(t, subject, s.Subject().UUID)
+	assert.Equal(t, txmgrtypes.TxPriorityNormal, s.Priority())
 }
 
 func Test_DropOldestStrategy_PruneQueue(t *testing.T) {
@@ -49,3 +52,19 @@ func Test_DropOldestStrategy_PruneQueue(t *testing.T) {
 		assert.Equal(t, []int64{1, 2}, ids)
 	})
 }
+
+func Test_PriorityStrategy(t *testing.T) {
+	t.Parallel()
+	subject := uuid.New()
+	queueSize := uint32(2)
+	mockTxStore := mocks.NewEvmTxStore(t)
+	s := txmgrcommon.NewPriorityStrategy(txmgrcommon.NewDropOldestStrategy(subject, queueSize), txmgrtypes.TxPriorityHigh)
+
+	assert.Equal(t, txmgrtypes.TxPriorityHigh, s.Priority())
+	assert.Equal(t, uuid.NullUUID{UUID: subject, Valid: true}, s.Subject())
+
+	mockTxStore.On("PruneUnstartedTxQueue", mock.Anything, queueSize-1, subject, mock.Anything, mock.Anything).Once().Return([]int64{1}, nil)
+	ids, err := s.PruneQueue(testutils.Context(t), mockTxStore)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, ids)
+}
@@ -127,6 +127,7 @@ func TestTxm_CreateTransaction(t *testing.T) {
 		subject := uuid.New()
 		strategy := newMockTxStrategy(t)
 		strategy.On("Subject").Return(uuid.NullUUID{UUID: subject, Valid: true})
+		strategy.On("Priority").Return(txmgrtypes.TxPriorityNormal)
 		strategy.On("PruneQueue", mock.Anything, mock.Anything).Return(nil, nil)
 		evmConfig.MaxQueued = uint64(1)
 		etx, err := txm.CreateTransaction(testutils.Context(t), txmgr.TxRequest{
@@ -411,6 +412,7 @@ func TestTxm_CreateTransaction_OutOfEth(t *testing.T) {
 		mustInsertUnconfirmedEthTxWithInsufficientEthAttempt(t, txStore, 0, otherKey.Address)
 		strategy := newMockTxStrategy(t)
 		strategy.On("Subject").Return(uuid.NullUUID{})
+		strategy.On("Priority").Return(txmgrtypes.TxPriorityNormal)
 		strategy.On("PruneQueue", mock.Anything, mock.Anything).Return(nil, nil)
 
 		etx, err := txm.CreateTransaction(testutils.Context(t), txmgr.TxRequest{
@@ -435,6 +437,7 @@ func TestTxm_CreateTransaction_OutOfEth(t *testing.T) {
 		mustInsertUnconfirmedEthTxWithInsufficientEthAttempt(t, txStore, 0, thisKey.Address)
 		strategy := newMockTxStrategy(t)
 		strategy.On("Subject").Return(uuid.NullUUID{})
+		strategy.On("Priority").Return(txmgrtypes.TxPriorityNormal)
 		strategy.On("PruneQueue", mock.Anything, mock.Anything).Return(nil, nil)
 
 		etx, err := txm.CreateTransaction(testutils.Context(t), txmgr.TxRequest{
@@ -456,6 +459,7 @@ func TestTxm_CreateTransaction_OutOfEth(t *testing.T) {
 		cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 42, thisKey.Address)
 		strategy := newMockTxStrategy(t)
 		strategy.On("Subject").Return(uuid.NullUUID{})
+		strategy.On("Priority").Return(txmgrtypes.TxPriorityNormal)
 		strategy.On("PruneQueue", mock.Anything, mock.Anything).Return(nil, nil)
 
 		evmConfig.MaxQueued = uint64(1)
@@ -833,6 +837,12 @@ func txRequestWithValue(value big.Int) func(*txmgr.TxRequest) {
 	}
 }
 
+func txRequestWithBlobs(blobs ...[]byte) func(*txmgr.TxRequest) {
+	return func(tx *txmgr.TxRequest) {
+		tx.Blobs = blobs
+	}
+}
+
 func txRequestWithIdempotencyKey(idempotencyKey string) func(*txmgr.TxRequest) {
 	return func(tx *txmgr.TxRequest) {
 		tx.IdempotencyKey = &idempotencyKey
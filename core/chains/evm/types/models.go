@@ -45,6 +45,9 @@ type Head struct {
 	Difficulty       *big.Int
 	TotalDifficulty  *big.Int
 	IsFinalized      bool
+	// ExcessBlobGas and BlobGasUsed are only set on chains with EIP-4844 blob transactions, and are not persisted
+	ExcessBlobGas *uint64
+	BlobGasUsed   *uint64
 }
 
 var _ commontypes.Head[common.Hash] = &Head{}
@@ -259,17 +262,19 @@ func (h *Head) AsSlice(k int) (heads []*Head) {
 
 func (h *Head) UnmarshalJSON(bs []byte) error {
 	type head struct {
-		Hash             common.Hash    `json:"hash"`
-		Number           *hexutil.Big   `json:"number"`
-		ParentHash       common.Hash    `json:"parentHash"`
-		Timestamp        hexutil.Uint64 `json:"timestamp"`
-		L1BlockNumber    *hexutil.Big   `json:"l1BlockNumber"`
-		BaseFeePerGas    *hexutil.Big   `json:"baseFeePerGas"`
-		ReceiptsRoot     common.Hash    `json:"receiptsRoot"`
-		TransactionsRoot common.Hash    `json:"transactionsRoot"`
-		StateRoot        common.Hash    `json:"stateRoot"`
-		Difficulty       *hexutil.Big   `json:"difficulty"`
-		TotalDifficulty  *hexutil.Big   `json:"totalDifficulty"`
+		Hash             common.Hash     `json:"hash"`
+		Number           *hexutil.Big    `json:"number"`
+		ParentHash       common.Hash     `json:"parentHash"`
+		Timestamp        hexutil.Uint64  `json:"timestamp"`
+		L1BlockNumber    *hexutil.Big    `json:"l1BlockNumber"`
+		BaseFeePerGas    *hexutil.Big    `json:"baseFeePerGas"`
+		ReceiptsRoot     common.Hash     `json:"receiptsRoot"`
+		TransactionsRoot common.Hash     `json:"transactionsRoot"`
+		StateRoot        common.Hash     `json:"stateRoot"`
+		Difficulty       *hexutil.Big    `json:"difficulty"`
+		TotalDifficulty  *hexutil.Big    `json:"totalDifficulty"`
+		ExcessBlobGas    *hexutil.Uint64 `json:"excessBlobGas"`
+		BlobGasUsed      *hexutil.Uint64 `json:"blobGasUsed"`
 	}
 
 	var jsonHead head
@@ -296,6 +301,8 @@ func (h *Head) UnmarshalJSON(bs []byte) error {
 	h.StateRoot = jsonHead.StateRoot
 	h.Difficulty = jsonHead.Difficulty.ToInt()
 	h.TotalDifficulty = jsonHead.TotalDifficulty.ToInt()
+	h.ExcessBlobGas = (*uint64)(jsonHead.ExcessBlobGas)
+	h.BlobGasUsed = (*uint64)(jsonHead.BlobGasUsed)
 	return nil
 }
 
@@ -310,6 +317,8 @@ func (h *Head) MarshalJSON() ([]byte, error) {
 		StateRoot        *common.Hash    `json:"stateRoot,omitempty"`
 		Difficulty       *hexutil.Big    `json:"difficulty,omitempty"`
 		TotalDifficulty  *hexutil.Big    `json:"totalDifficulty,omitempty"`
+		ExcessBlobGas    *hexutil.Uint64 `json:"excessBlobGas,omitempty"`
+		BlobGasUsed      *hexutil.Uint64 `json:"blobGasUsed,omitempty"`
 	}
 
 	var jsonHead head
@@ -335,6 +344,8 @@ func (h *Head) MarshalJSON() ([]byte, error) {
 	}
 	jsonHead.Difficulty = (*hexutil.Big)(h.Difficulty)
 	jsonHead.TotalDifficulty = (*hexutil.Big)(h.TotalDifficulty)
+	jsonHead.ExcessBlobGas = (*hexutil.Uint64)(h.ExcessBlobGas)
+	jsonHead.BlobGasUsed = (*hexutil.Uint64)(h.BlobGasUsed)
 	return json.Marshal(jsonHead)
 }
 
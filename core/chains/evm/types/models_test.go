@@ -348,6 +348,17 @@ func TestHead_UnmarshalJSON(t *testing.T) {
 				StateRoot:        common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000000"),
 			},
 		},
+		{"cancun",
+			`{"number":"0x12d6ae0","hash":"0x41800b5c3f1717687d85fc9018faac0a6e90b39deaa0b99e7fe4fe796ddeb26a","parentHash":"0x41941023680923e0fe4d74a34bdac8141f2540e3ae90623718e47d66d1ca4a2d","timestamp":"0x65f5c9a3","baseFeePerGas":"0x6fc23ac00","blobGasUsed":"0x60000","excessBlobGas":"0x4b00000","transactions":[],"uncles":[]}`,
+			evmtypes.Head{
+				Hash:          common.HexToHash("0x41800b5c3f1717687d85fc9018faac0a6e90b39deaa0b99e7fe4fe796ddeb26a"),
+				Number:        0x12d6ae0,
+				ParentHash:    common.HexToHash("0x41941023680923e0fe4d74a34bdac8141f2540e3ae90623718e47d66d1ca4a2d"),
+				Timestamp:     time.Unix(0x65f5c9a3, 0).UTC(),
+				ExcessBlobGas: ptr[uint64](0x4b00000),
+				BlobGasUsed:   ptr[uint64](0x60000),
+			},
+		},
 		{"not found",
 			`null`,
 			evmtypes.Head{},
@@ -368,6 +379,8 @@ func TestHead_UnmarshalJSON(t *testing.T) {
 			assert.Equal(t, test.expected.ReceiptsRoot, head.ReceiptsRoot)
 			assert.Equal(t, test.expected.TransactionsRoot, head.TransactionsRoot)
 			assert.Equal(t, test.expected.StateRoot, head.StateRoot)
+			assert.Equal(t, test.expected.ExcessBlobGas, head.ExcessBlobGas)
+			assert.Equal(t, test.expected.BlobGasUsed, head.BlobGasUsed)
 		})
 	}
 }
@@ -1186,3 +1199,5 @@ func mustHexToBig(t *testing.T, hx string) *big.Int {
 	require.NoError(t, err)
 	return n
 }
+
+func ptr[T any](t T) *T { return &t }
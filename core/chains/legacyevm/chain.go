@@ -15,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mailbox"
 
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains"
 	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
@@ -87,6 +88,20 @@ func NewLegacyChains(m map[string]Chain, evmCfgs toml.EVMConfigs) *LegacyChains
 	}
 }
 
+// NodeLatency returns the latency averages of the named node of the given chain, as used by the LowestLatency
+// selection mode. ok is false if the chain is not running or the node has not been measured yet.
+func NodeLatency(chains LegacyChainContainer, chainID string, nodeName string) (latency commonclient.NodeLatency, ok bool) {
+	if chains == nil {
+		return latency, false
+	}
+	c, err := chains.Get(chainID)
+	if err != nil {
+		return latency, false
+	}
+	latency, ok = c.Client().NodeLatencies()[nodeName]
+	return latency, ok && latency.Samples > 0
+}
+
 func (c *LegacyChains) ChainNodeConfigs() evmtypes.Configs {
 	return c.cfgs
 }
@@ -245,6 +260,13 @@ func newChain(ctx context.Context, cfg *evmconfig.ChainScoped, nodes []*toml.Nod
 				LogPrunePageSize:         int64(cfg.EVM().LogPrunePageSize()),
 				BackupPollerBlockDelay:   int64(cfg.EVM().BackupLogPollerBlockDelay()),
 			}
+			if dir := cfg.EVM().LogArchiveDir(); dir != "" {
+				logArchive, err := logpoller.NewFileLogArchive(dir, chainID)
+				if err != nil {
+					return nil, fmt.Errorf("failed to create log archive for chain with ID %s: %w", chainID.String(), err)
+				}
+				lpOpts.LogArchive = logArchive
+			}
 			logPoller = logpoller.NewLogPoller(logpoller.NewObservedORM(chainID, opts.DS, l), client, l, lpOpts)
 		}
 	}
@@ -198,6 +198,9 @@ func NewApp(s *Shell) *cli.App {
 				keysCommand("DKGEncrypt", NewDKGEncryptKeysClient(s)),
 
 				initVRFKeysSubCmd(s),
+
+				initKeysBackupSubCmd(s),
+				initKeysRestoreSubCmd(s),
 			},
 		},
 		{
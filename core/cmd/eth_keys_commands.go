@@ -71,6 +71,27 @@ func initEthKeysSubCmd(s *Shell) cli.Command {
 				},
 				Action: s.ImportETHKey,
 			},
+			{
+				Name:  "import-remote",
+				Usage: format(`Import an ETH key held by an external signer, such as web3signer or clef, which signs its transactions with eth_signTransaction`),
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:     "address",
+						Usage:    "address of the key",
+						Required: true,
+					},
+					cli.StringFlag{
+						Name:     "signer-url, signerURL",
+						Usage:    "JSON-RPC URL of the external signer",
+						Required: true,
+					},
+					cli.StringFlag{
+						Name:  "evm-chain-id, evmChainID",
+						Usage: "Chain ID for the key. If left blank, default chain will be used.",
+					},
+				},
+				Action: s.ImportRemoteETHKey,
+			},
 			{
 				Name:  "export",
 				Usage: format(`Exports an ETH key to a JSON file`),
@@ -303,6 +324,38 @@ func (s *Shell) ImportETHKey(c *cli.Context) (err error) {
 	return s.renderAPIResponse(resp, &EthKeyPresenter{}, "🔑 Imported ETH key")
 }
 
+// ImportRemoteETHKey imports an Ethereum key held by an external signer
+func (s *Shell) ImportRemoteETHKey(c *cli.Context) (err error) {
+	request, err := json.Marshal(map[string]string{
+		"address":   c.String("address"),
+		"signerURL": c.String("signer-url"),
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	importUrl := url.URL{
+		Path: "/v2/keys/evm/import_remote",
+	}
+	if c.IsSet("evmChainID") {
+		query := importUrl.Query()
+		query.Set("evmChainID", c.String("evmChainID"))
+		importUrl.RawQuery = query.Encode()
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), importUrl.String(), bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &EthKeyPresenter{}, "🔑 Imported remote ETH key")
+}
+
 // ExportETHKey exports an ETH key,
 // address must be passed
 func (s *Shell) ExportETHKey(c *cli.Context) (err error) {
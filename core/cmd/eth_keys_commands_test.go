@@ -3,7 +3,10 @@ package cmd_test
 import (
 	"bytes"
 	"flag"
+	"fmt"
 	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -207,6 +210,47 @@ func TestShell_CreateETHKey(t *testing.T) {
 	require.Equal(t, 2, len(keys))
 }
 
+func TestShell_ImportRemoteETHKey(t *testing.T) {
+	t.Parallel()
+
+	ethClient := newEthMock(t)
+	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).Return(big.NewInt(42), nil)
+	ethClient.On("LINKBalance", mock.Anything, mock.Anything, mock.Anything).Return(commonassets.NewLinkFromJuels(42), nil)
+	ethClient.On("PendingNonceAt", mock.Anything, mock.Anything).Return(uint64(0), nil)
+
+	app := startNewApplicationV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].Enabled = ptr(true)
+		c.EVM[0].NonceAutoSync = ptr(false)
+		c.EVM[0].BalanceMonitor.Enabled = ptr(false)
+	},
+		withKey(),
+		withMocks(ethClient),
+	)
+	client, r := app.NewShellAndRenderer()
+
+	address := testutils.NewAddress()
+	signer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":["%s"]}`, address.Hex())
+	}))
+	t.Cleanup(signer.Close)
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ImportRemoteETHKey, set, "")
+
+	require.NoError(t, set.Set("address", address.Hex()))
+	require.NoError(t, set.Set("signer-url", signer.URL))
+	require.NoError(t, set.Set("evm-chain-id", testutils.FixtureChainID.String()))
+
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.ImportRemoteETHKey(c))
+	require.Len(t, r.Renders, 1)
+	assert.True(t, r.Renders[0].(*cmd.EthKeyPresenter).Remote)
+
+	key, err := app.KeyStore.Eth().Get(testutils.Context(t), address.Hex())
+	require.NoError(t, err)
+	assert.True(t, key.IsRemote())
+}
+
 func TestShell_DeleteETHKey(t *testing.T) {
 	t.Parallel()
 
@@ -50,6 +50,31 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Trigger a job run",
 			Action: s.TriggerPipelineRun,
 		},
+		{
+			Name:      "simulate",
+			Usage:     "Execute the pipeline of a job without side effects. Transactions are not sent and the results are not saved",
+			ArgsUsage: "[TOML|path]",
+			Action:    s.SimulateJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "job",
+					Usage: "id of an existing job to simulate instead of a TOML spec",
+				},
+				cli.StringFlag{
+					Name:  "vars",
+					Usage: "JSON object, or path to a JSON file, of the pipeline variables, e.g. '{\"jobRun\": {\"requestBody\": \"{}\"}}'",
+				},
+				cli.StringFlag{
+					Name:  "fixtures",
+					Usage: "JSON object, or path to a JSON file, of the results replacing those of the tasks keyed by task name, e.g. '{\"ds\": {\"value\": \"{}\"}}'",
+				},
+			},
+		},
+		{
+			Name:        "executions",
+			Usage:       "Inspect and rerun the executions of workflow jobs",
+			Subcommands: initWorkflowExecutionsSubCmds(s),
+		},
 	}
 }
 
@@ -272,6 +297,86 @@ func (s *Shell) CreateJob(c *cli.Context) (err error) {
 	return err
 }
 
+// SimulateJob executes the pipeline of a job without side effects.
+// Valid input is a TOML string or a path to TOML file, or the --job flag.
+func (s *Shell) SimulateJob(c *cli.Context) (err error) {
+	request := web.SimulateJobRequest{JobID: c.String("job")}
+	if c.Args().Present() {
+		if request.TOML, err = getTOMLString(c.Args().First()); err != nil {
+			return s.errorOut(err)
+		}
+	}
+	if request.JobID == "" && request.TOML == "" {
+		return s.errorOut(errors.New("must pass in TOML or filepath, or the --job flag"))
+	}
+	if v := c.String("vars"); v != "" {
+		if err = decodeJSONFlag(v, &request.Vars); err != nil {
+			return s.errorOut(errors.Wrap(err, "invalid --vars"))
+		}
+	}
+	if v := c.String("fixtures"); v != "" {
+		if err = decodeJSONFlag(v, &request.Fixtures); err != nil {
+			return s.errorOut(errors.Wrap(err, "invalid --fixtures"))
+		}
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/simulate", bytes.NewReader(body))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &SimulatedPipelineRunPresenter{}, "Pipeline run simulated")
+}
+
+// decodeJSONFlag decodes the JSON string, or the JSON file at path v, into out.
+func decodeJSONFlag(v string, out interface{}) error {
+	buf, err := getBufferFromJSON(v)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(buf.Bytes(), out)
+}
+
+// SimulatedPipelineRunPresenter wraps the JSONAPI PipelineRun Resource of a
+// simulated run and adds rendering functionality
+type SimulatedPipelineRunPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.PipelineRunResource
+}
+
+// RenderTable implements TableRenderer
+func (p *SimulatedPipelineRunPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Outputs", "Fatal Errors"})
+	table.Append([]string{joinStringPtrs(p.Outputs), joinStringPtrs(p.FatalErrors)})
+	render("Simulated Pipeline Run", table)
+
+	tasks := rt.newTable([]string{"Name", "Type", "Output", "Error"})
+	for _, tr := range p.TaskRuns {
+		tasks.Append([]string{tr.DotID, string(tr.Type), stringOrEmpty(tr.Output), stringOrEmpty(tr.Error)})
+	}
+	render("Task Runs", tasks)
+	return nil
+}
+
+func joinStringPtrs(ss []*string) string {
+	var strs []string
+	for _, s := range ss {
+		if s != nil {
+			strs = append(strs, *s)
+		}
+	}
+	return strings.Join(strs, ", ")
+}
+
 // DeleteJob deletes a job
 func (s *Shell) DeleteJob(c *cli.Context) error {
 	if !c.Args().Present() {
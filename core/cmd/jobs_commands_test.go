@@ -403,6 +403,37 @@ func TestShell_CreateJobV2(t *testing.T) {
 	assert.Equal(t, "0x27548a32b9aD5D64c5945EaE9Da5337bc3169D15", output.OffChainReportingSpec.ContractAddress.String())
 }
 
+func TestShell_SimulateJob(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	flagSetApplyFromAction(client.SimulateJob, fs, "")
+
+	spec := `
+type = "webhook"
+schemaVersion = 1
+observationSource = """
+ds    [type=http method=GET url="https://example.invalid"];
+parse [type=jsonparse path="price"];
+ds -> parse;
+"""
+`
+	require.NoError(t, fs.Parse([]string{"--fixtures", `{"ds": {"value": "{\"price\": 42}"}}`, spec}))
+
+	err := client.SimulateJob(cli.NewContext(nil, fs, nil))
+	require.NoError(t, err)
+
+	requireJobsCount(t, app.JobORM(), 0)
+
+	output := *r.Renders[0].(*cmd.SimulatedPipelineRunPresenter)
+	require.Len(t, output.Outputs, 1)
+	assert.Equal(t, "42", *output.Outputs[0])
+	assert.Len(t, output.TaskRuns, 2)
+}
+
 func TestShell_DeleteJob(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initKeysBackupSubCmd(s *Shell) cli.Command {
+	return cli.Command{
+		Name:  "backup",
+		Usage: format(`Back up all the keys of the node, and the chains their ETH keys are enabled on, to a single encrypted file`),
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "new-password, newpassword, p",
+				Usage: "`FILE` containing the password to encrypt the backup (required)",
+			},
+			cli.StringFlag{
+				Name:  "output, o",
+				Usage: "Path where the backup will be saved (required)",
+			},
+		},
+		Action: s.BackupKeystore,
+	}
+}
+
+func initKeysRestoreSubCmd(s *Shell) cli.Command {
+	return cli.Command{
+		Name:  "restore",
+		Usage: format(`Restore the keys of a backup which are not in the node's keystore; nothing is restored if a key conflicts with the keystore`),
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "old-password, oldpassword, p",
+				Usage: "`FILE` containing the password used to encrypt the backup (required)",
+			},
+			cli.BoolFlag{
+				Name:  "dry-run",
+				Usage: "only report the keys which would be restored, and the conflicts with the keystore",
+			},
+		},
+		Action: s.RestoreKeystore,
+	}
+}
+
+type KeystoreRestorePresenter struct {
+	presenters.KeystoreRestoreResource
+}
+
+// RenderTable implements TableRenderer
+func (p *KeystoreRestorePresenter) RenderTable(rt RendererTable) error {
+	keyRows := [][]string{}
+	for _, k := range p.Keys {
+		id := k.ID
+		if k.Legacy {
+			id = "(legacy)"
+		}
+		keyRows = append(keyRows, []string{k.Type, id, string(k.Status)})
+	}
+	renderList([]string{"Type", "ID", "Status"}, keyRows, rt.Writer)
+
+	stateRows := [][]string{}
+	for _, s := range p.EthKeyStates {
+		stateRows = append(stateRows, []string{s.Address.Hex(), s.EVMChainID.String(), strconv.FormatBool(s.Disabled), string(s.Status)})
+	}
+	renderList([]string{"Address", "EVM Chain ID", "Disabled", "Status"}, stateRows, rt.Writer)
+
+	if p.DryRun {
+		_, err := rt.Write([]byte("Dry run, nothing was restored\n"))
+		return err
+	}
+	return nil
+}
+
+// BackupKeystore backs up all the keys of the keystore, and the states of the ETH keys, to a single encrypted file
+func (s *Shell) BackupKeystore(c *cli.Context) (err error) {
+	newPasswordFile := c.String("new-password")
+	if len(newPasswordFile) == 0 {
+		return s.errorOut(errors.New("Must specify --new-password/-p flag"))
+	}
+	newPassword, err := os.ReadFile(newPasswordFile)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not read password file"))
+	}
+
+	filepath := c.String("output")
+	if len(filepath) == 0 {
+		return s.errorOut(errors.New("Must specify --output/-o flag"))
+	}
+
+	backupUrl := url.URL{
+		Path: "/v2/keys/backup",
+	}
+	query := backupUrl.Query()
+	query.Set("newpassword", strings.TrimSpace(string(newPassword)))
+
+	backupUrl.RawQuery = query.Encode()
+	resp, err := s.HTTP.Post(s.ctx(), backupUrl.String(), nil)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not make HTTP request"))
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.errorOut(fmt.Errorf("error backing up keystore: %w", httpError(resp)))
+	}
+
+	backupJSON, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not read response body"))
+	}
+
+	err = utils.WriteFileWithMaxPerms(filepath, backupJSON, 0o600)
+	if err != nil {
+		return s.errorOut(errors.Wrapf(err, "Could not write %v", filepath))
+	}
+
+	_, err = os.Stderr.WriteString("🔑 Backed up keystore to " + filepath + "\n")
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	return nil
+}
+
+// RestoreKeystore restores the keys of a backup, file path must be passed
+func (s *Shell) RestoreKeystore(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("Must pass the filepath of the backup to be restored"))
+	}
+
+	oldPasswordFile := c.String("old-password")
+	if len(oldPasswordFile) == 0 {
+		return s.errorOut(errors.New("Must specify --old-password/-p flag"))
+	}
+	oldPassword, err := os.ReadFile(oldPasswordFile)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not read password file"))
+	}
+
+	backupJSON, err := os.ReadFile(c.Args().Get(0))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	restoreUrl := url.URL{
+		Path: "/v2/keys/restore",
+	}
+	query := restoreUrl.Query()
+	query.Set("oldpassword", strings.TrimSpace(string(oldPassword)))
+	query.Set("dryRun", strconv.FormatBool(c.Bool("dry-run")))
+
+	restoreUrl.RawQuery = query.Encode()
+	resp, err := s.HTTP.Post(s.ctx(), restoreUrl.String(), bytes.NewReader(backupJSON))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &KeystoreRestorePresenter{}, "🔑 Restored keystore backup")
+}
@@ -0,0 +1,97 @@
+package cmd_test
+
+import (
+	"bytes"
+	"flag"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestKeystoreRestorePresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer  = bytes.NewBufferString("")
+		r       = cmd.RendererTable{Writer: buffer}
+		address = common.HexToAddress("0x5431F5F973781809D18643b87B44921b11355d81")
+	)
+
+	p := cmd.KeystoreRestorePresenter{
+		KeystoreRestoreResource: presenters.KeystoreRestoreResource{
+			DryRun: true,
+			Keys: []keystore.RestoredKey{
+				{Type: "P2P", ID: "p2p-key-id", Status: keystore.RestoreStatusAdded},
+				{Type: "Foo", Legacy: true, Status: keystore.RestoreStatusExists},
+			},
+			EthKeyStates: []keystore.RestoredEthKeyState{
+				{Address: address, EVMChainID: *ubig.New(big.NewInt(1337)), Disabled: true, Status: keystore.RestoreStatusConflict},
+			},
+		},
+	}
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "p2p-key-id")
+	assert.Contains(t, output, "(legacy)")
+	assert.Contains(t, output, address.Hex())
+	assert.Contains(t, output, "1337")
+	assert.Contains(t, output, "conflict")
+	assert.Contains(t, output, "Dry run, nothing was restored")
+}
+
+func TestShell_BackupRestoreKeystore(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+	key, err := app.GetKeyStore().P2P().Create(ctx)
+	require.NoError(t, err)
+	backupFile := filepath.Join(t.TempDir(), "backup.json")
+
+	set := flag.NewFlagSet("test keystore backup", 0)
+	flagSetApplyFromAction(client.BackupKeystore, set, "")
+	require.NoError(t, set.Set("new-password", "../internal/fixtures/incorrect_password.txt"))
+	require.NoError(t, set.Set("output", backupFile))
+	require.NoError(t, client.BackupKeystore(cli.NewContext(nil, set, nil)))
+
+	restore := func(t *testing.T, dryRun bool) *cmd.KeystoreRestorePresenter {
+		r.Renders = nil
+		set := flag.NewFlagSet("test keystore restore", 0)
+		flagSetApplyFromAction(client.RestoreKeystore, set, "")
+		require.NoError(t, set.Parse([]string{backupFile}))
+		require.NoError(t, set.Set("old-password", "../internal/fixtures/incorrect_password.txt"))
+		if dryRun {
+			require.NoError(t, set.Set("dry-run", "true"))
+		}
+		require.NoError(t, client.RestoreKeystore(cli.NewContext(nil, set, nil)))
+		require.Len(t, r.Renders, 1)
+		return r.Renders[0].(*cmd.KeystoreRestorePresenter)
+	}
+
+	// Restore a backup of the keys of the keystore
+	report := restore(t, true)
+	assert.True(t, report.DryRun)
+	assert.Contains(t, report.Keys, keystore.RestoredKey{Type: "P2P", ID: key.ID(), Status: keystore.RestoreStatusExists})
+
+	// Restore a deleted key
+	_, err = app.GetKeyStore().P2P().Delete(ctx, key.PeerID())
+	require.NoError(t, err)
+	report = restore(t, false)
+	assert.False(t, report.DryRun)
+	assert.Contains(t, report.Keys, keystore.RestoredKey{Type: "P2P", ID: key.ID(), Status: keystore.RestoreStatusAdded})
+	_, err = app.GetKeyStore().P2P().Get(key.PeerID())
+	require.NoError(t, err)
+}
@@ -42,6 +42,7 @@ import (
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pg"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
@@ -254,6 +255,28 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:  "keys",
+			Usage: "Commands for administering the node's keystore",
+			Subcommands: []cli.Command{
+				{
+					Name:   "rotate-password",
+					Usage:  "Re-encrypt all the keys of the keystore with a new password and the configured scrypt params; the node must be stopped",
+					Action: s.RotateKeystorePassword,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "password, p",
+							Usage: "`FILE` containing the current keystore password, defaults to the keystore password of the secrets",
+						},
+						cli.StringFlag{
+							Name:     "new-password",
+							Usage:    "`FILE` containing the new keystore password",
+							Required: true,
+						},
+					},
+				},
+			},
+		},
 		{
 			Name:   "remove-blocks",
 			Usage:  "Deletes block range and all associated data",
@@ -1200,6 +1223,71 @@ func insertFixtures(dbURL url.URL, pathToFixtures string) (err error) {
 	return err
 }
 
+// RotateKeystorePassword re-encrypts all the keys of the keystore with a new password and the configured scrypt
+// params, in a single transaction which is committed only once the keys were verified to decrypt with the new password.
+// It holds the DB lock, so the node must be stopped.
+func (s *Shell) RotateKeystorePassword(c *cli.Context) error {
+	newPassword, err := utils.PasswordFromFile(c.String("new-password"))
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "error reading new password"))
+	}
+	if err = utils.VerifyPasswordComplexity(newPassword); err != nil {
+		return s.errorOut(errors.Wrap(err, "invalid new password"))
+	}
+
+	if c.IsSet("password") {
+		pwd, err2 := utils.PasswordFromFile(c.String("password"))
+		if err2 != nil {
+			return s.errorOut(fmt.Errorf("error reading password: %+v", err2))
+		}
+		s.Config.SetPasswords(&pwd, nil)
+	}
+
+	cfg := s.Config
+	err = cfg.Validate()
+	if err != nil {
+		return s.errorOut(fmt.Errorf("error validating configuration: %+v", err))
+	}
+	oldPassword := cfg.Password().Keystore()
+	if oldPassword == "" {
+		return s.errorOut(errors.New("no keystore password provided, set Password.Keystore in the secrets or pass --password"))
+	}
+	if oldPassword == newPassword {
+		return s.errorOut(errors.New("new password must differ from the current one"))
+	}
+
+	lggr := logger.Sugared(s.Logger.Named("RotateKeystorePassword"))
+	ldb := pg.NewLockedDB(cfg.AppID(), cfg.Database(), cfg.Database().Lock(), lggr)
+	ctx, cancel := context.WithCancel(context.Background())
+	go shutdown.HandleShutdown(func(sig string) {
+		cancel()
+		lggr.Info("received signal to stop - closing the database and releasing lock")
+
+		if cErr := ldb.Close(); cErr != nil {
+			lggr.Criticalf("Failed to close LockedDB: %v", cErr)
+		}
+
+		if cErr := s.CloseLogger(); cErr != nil {
+			log.Printf("Failed to close Logger: %v", cErr)
+		}
+	})
+
+	if err = ldb.Open(ctx); err != nil {
+		// If not successful, we know neither locks nor connection remains opened
+		return s.errorOut(errors.Wrap(err, "opening db"))
+	}
+	defer lggr.ErrorIfFn(ldb.Close, "Error closing db")
+
+	keyStore := keystore.New(ldb.DB(), utils.GetScryptParams(cfg), lggr)
+	if err = keyStore.RotatePassword(ctx, oldPassword, newPassword); err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to rotate keystore password"))
+	}
+
+	lggr.Info("RotateKeystorePassword: successfully re-encrypted all keys, set Password.Keystore to the new password before starting the node")
+
+	return nil
+}
+
 // RemoveBlocks - removes blocks after the specified blocks number
 func (s *Shell) RemoveBlocks(c *cli.Context) error {
 	start := c.Int64("start")
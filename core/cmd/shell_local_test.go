@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -27,6 +28,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	chainlinkmocks "github.com/smartcontractkit/chainlink/v2/core/services/chainlink/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	evmrelayer "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/localauth"
 	"github.com/smartcontractkit/chainlink/v2/core/store/dialects"
@@ -568,3 +570,53 @@ func TestShell_RemoveBlocks(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestShell_RotateKeystorePassword(t *testing.T) {
+	cfg, db := heavyweight.FullTestDBV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		// seems to be needed for config validate
+		c.Insecure.OCRDevelopmentMode = nil
+	})
+	ctx := testutils.Context(t)
+	lggr := logger.TestLogger(t)
+
+	keyStore := keystore.New(db, utils.FastScryptParams, lggr)
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	p2pKey, err := keyStore.P2P().Create(ctx)
+	require.NoError(t, err)
+
+	newPasswordFile := filepath.Join(t.TempDir(), "new_password.txt")
+	const newPassword = "n3w-k3yst0re-p4ssw0rd"
+	require.NoError(t, os.WriteFile(newPasswordFile, []byte(newPassword+"\n"), 0600))
+
+	shell := cmd.Shell{
+		Config: cfg,
+		Logger: lggr,
+	}
+	rotate := func(password, newPassword string) error {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.RotateKeystorePassword, set, "")
+		require.NoError(t, set.Set("password", password))
+		require.NoError(t, set.Set("new-password", newPassword))
+		return shell.RotateKeystorePassword(cli.NewContext(nil, set, nil))
+	}
+
+	t.Run("rejects a weak new password", func(t *testing.T) {
+		require.ErrorContains(t, rotate("../internal/fixtures/correct_password.txt", "../internal/fixtures/new_password.txt"), "invalid new password")
+	})
+
+	t.Run("rejects a wrong current password", func(t *testing.T) {
+		require.ErrorContains(t, rotate("../internal/fixtures/incorrect_password.txt", newPasswordFile), "failed to rotate keystore password")
+		require.NoError(t, keystore.New(db, utils.FastScryptParams, lggr).Unlock(ctx, cltest.Password))
+	})
+
+	t.Run("re-encrypts the keys with the new password", func(t *testing.T) {
+		require.NoError(t, rotate("../internal/fixtures/correct_password.txt", newPasswordFile))
+
+		require.Error(t, keystore.New(db, utils.FastScryptParams, lggr).Unlock(ctx, cltest.Password))
+		reloaded := keystore.New(db, utils.FastScryptParams, lggr)
+		require.NoError(t, reloaded.Unlock(ctx, newPassword))
+		got, err := reloaded.P2P().Get(p2pKey.PeerID())
+		require.NoError(t, err)
+		assert.Equal(t, p2pKey.Raw(), got.Raw())
+	})
+}
@@ -0,0 +1,221 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initWorkflowExecutionsSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:      "list",
+			Usage:     "List the executions of a workflow job, most recent first",
+			ArgsUsage: "JOB_ID",
+			Action:    s.ListWorkflowExecutions,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "status",
+					Usage: "comma separated statuses to filter by. Options: 'started', 'completed', 'errored', 'timeout'",
+				},
+				cli.StringFlag{
+					Name:  "from",
+					Usage: "only list the executions created at or after this RFC3339 time",
+				},
+				cli.StringFlag{
+					Name:  "to",
+					Usage: "only list the executions created before this RFC3339 time",
+				},
+				cli.IntFlag{
+					Name:  "page",
+					Usage: "page of results to display",
+				},
+			},
+		},
+		{
+			Name:   "dead-letters",
+			Usage:  "List the executions of all workflows that errored or timed out, most recently finished first",
+			Action: s.ListWorkflowDeadLetters,
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "page",
+					Usage: "page of results to display",
+				},
+			},
+		},
+		{
+			Name:      "show",
+			Usage:     "Show an execution of a workflow job along with the inputs and outputs of its steps",
+			ArgsUsage: "JOB_ID EXECUTION_ID",
+			Action:    s.ShowWorkflowExecution,
+		},
+		{
+			Name:      "rerun",
+			Usage:     "Execute an execution of a workflow job again from a step. Without a step, an errored or timed out execution is resumed",
+			ArgsUsage: "JOB_ID EXECUTION_ID",
+			Action:    s.RerunWorkflowExecution,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "step",
+					Usage: "ref of the step to rerun the execution from. The steps depending on it are executed again too",
+				},
+			},
+		},
+	}
+}
+
+// WorkflowExecutionPresenter wraps the JSONAPI WorkflowExecution Resource and adds rendering functionality
+type WorkflowExecutionPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.WorkflowExecutionResource
+}
+
+// ToRow presents the WorkflowExecutionPresenter as a slice of strings.
+func (p *WorkflowExecutionPresenter) ToRow() []string {
+	return []string{
+		p.GetID(),
+		p.Status,
+		friendlyTime(p.CreatedAt),
+		friendlyTime(p.FinishedAt),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *WorkflowExecutionPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"ID", "Status", "Created At", "Finished At"})
+	table.Append(p.ToRow())
+	render(fmt.Sprintf("Workflow Execution (workflow %s)", p.WorkflowID), table)
+
+	steps := rt.newTable([]string{"Ref", "Status", "Attempts", "Inputs", "Outputs", "Error", "Updated At"})
+	for _, step := range p.Steps {
+		steps.Append([]string{
+			step.Ref,
+			step.Status,
+			strconv.Itoa(step.Attempts),
+			stringOrEmpty(step.Inputs),
+			stringOrEmpty(step.Outputs),
+			stringOrEmpty(step.Error),
+			friendlyTime(step.UpdatedAt),
+		})
+	}
+	render("Steps", steps)
+	return nil
+}
+
+type WorkflowExecutionPresenters []WorkflowExecutionPresenter
+
+// RenderTable implements TableRenderer
+func (ps WorkflowExecutionPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"ID", "Status", "Created At", "Finished At"})
+	for _, p := range ps {
+		table.Append(p.ToRow())
+	}
+
+	render("Workflow Executions", table)
+	return nil
+}
+
+// WorkflowDeadLetterPresenters renders the executions of several workflows
+type WorkflowDeadLetterPresenters []WorkflowExecutionPresenter
+
+// RenderTable implements TableRenderer
+func (ps WorkflowDeadLetterPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"ID", "Workflow ID", "Status", "Created At", "Finished At"})
+	for _, p := range ps {
+		table.Append([]string{
+			p.GetID(),
+			p.WorkflowID,
+			p.Status,
+			friendlyTime(p.CreatedAt),
+			friendlyTime(p.FinishedAt),
+		})
+	}
+
+	render("Workflow Dead Letters", table)
+	return nil
+}
+
+// ListWorkflowExecutions lists the executions of a workflow job
+func (s *Shell) ListWorkflowExecutions(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the id of the workflow job"))
+	}
+
+	q := url.Values{}
+	for _, param := range []string{"status", "from", "to"} {
+		if v := c.String(param); v != "" {
+			q.Set(param, v)
+		}
+	}
+	uri := url.URL{Path: "/v2/jobs/" + c.Args().First() + "/executions", RawQuery: q.Encode()}
+
+	return s.getPage(uri.String(), c.Int("page"), &WorkflowExecutionPresenters{})
+}
+
+// ListWorkflowDeadLetters lists the executions of all workflows that errored or timed out
+func (s *Shell) ListWorkflowDeadLetters(c *cli.Context) (err error) {
+	return s.getPage("/v2/workflow_executions/dead_letters", c.Int("page"), &WorkflowDeadLetterPresenters{})
+}
+
+// ShowWorkflowExecution displays the details of an execution of a workflow job
+func (s *Shell) ShowWorkflowExecution(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must provide the id of the workflow job and of the execution"))
+	}
+
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/jobs/"+c.Args().Get(0)+"/executions/"+c.Args().Get(1))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowExecutionPresenter{})
+}
+
+// RerunWorkflowExecution executes an execution of a workflow job again
+func (s *Shell) RerunWorkflowExecution(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must provide the id of the workflow job and of the execution"))
+	}
+
+	uri := url.URL{Path: "/v2/jobs/" + c.Args().Get(0) + "/executions/" + c.Args().Get(1) + "/rerun"}
+	if step := c.String("step"); step != "" {
+		uri.RawQuery = url.Values{"step": []string{step}}.Encode()
+	}
+	resp, err := s.HTTP.Post(s.ctx(), uri.String(), nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowExecutionPresenter{}, "Workflow execution restarted")
+}
+
+func friendlyTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,77 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestWorkflowExecutionPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id         = "8c3a1a5e1e4f4c3b"
+		workflowID = "15c631d295ef5e32deb99a10ee6804bc4af1385568f9b3363f6552ac6dbb2cef"
+		inputs     = `{"feedID":"0x1111"}`
+		outputs    = `{"price":100}`
+		stepErr    = "no quorum"
+
+		createdAt = time.Now()
+		buffer    = bytes.NewBufferString("")
+		r         = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.WorkflowExecutionPresenter{
+		JAID: cmd.JAID{ID: id},
+		WorkflowExecutionResource: presenters.WorkflowExecutionResource{
+			JAID:       presenters.NewJAID(id),
+			WorkflowID: workflowID,
+			Status:     "errored",
+			CreatedAt:  &createdAt,
+			Steps: []presenters.WorkflowExecutionStepResource{
+				{Ref: "consensus", Status: "errored", Attempts: 3, Error: &stepErr},
+				{Ref: "trigger", Status: "completed", Attempts: 1, Inputs: &inputs, Outputs: &outputs},
+			},
+		},
+	}
+
+	// Render a single resource
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, id)
+	assert.Contains(t, output, "errored")
+	assert.Contains(t, output, createdAt.Format(time.RFC3339))
+	assert.Contains(t, output, "consensus")
+	assert.Contains(t, output, stepErr)
+	assert.Contains(t, output, inputs)
+	assert.Contains(t, output, outputs)
+
+	// Render many resources
+	buffer.Reset()
+	ps := cmd.WorkflowExecutionPresenters{p}
+	require.NoError(t, ps.RenderTable(r))
+
+	output = buffer.String()
+	assert.Contains(t, output, id)
+	assert.Contains(t, output, "errored")
+	assert.Contains(t, output, createdAt.Format(time.RFC3339))
+	assert.NotContains(t, output, "consensus")
+
+	// Render dead letters
+	buffer.Reset()
+	dl := cmd.WorkflowDeadLetterPresenters{p}
+	require.NoError(t, dl.RenderTable(r))
+
+	output = buffer.String()
+	assert.Contains(t, output, id)
+	assert.Contains(t, output, workflowID)
+	assert.Contains(t, output, "errored")
+	assert.NotContains(t, output, "consensus")
+}
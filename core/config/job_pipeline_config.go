@@ -16,4 +16,8 @@ type JobPipeline interface {
 	ResultWriteQueueDepth() uint64
 	ExternalInitiatorsEnabled() bool
 	VerboseLogging() bool
+	CircuitBreakerEnabled() bool
+	CircuitBreakerFailureThreshold() uint32
+	CircuitBreakerFailureWindow() time.Duration
+	CircuitBreakerOpenTimeout() time.Duration
 }
@@ -593,6 +593,7 @@ type WebServer struct {
 	ListenIP                *net.IP
 
 	LDAP      WebServerLDAP      `toml:",omitempty"`
+	OIDC      WebServerOIDC      `toml:",omitempty"`
 	MFA       WebServerMFA       `toml:",omitempty"`
 	RateLimit WebServerRateLimit `toml:",omitempty"`
 	TLS       WebServerTLS       `toml:",omitempty"`
@@ -637,12 +638,18 @@ func (w *WebServer) setFrom(f *WebServer) {
 	}
 
 	w.LDAP.setFrom(&f.LDAP)
+	w.OIDC.setFrom(&f.OIDC)
 	w.MFA.setFrom(&f.MFA)
 	w.RateLimit.setFrom(&f.RateLimit)
 	w.TLS.setFrom(&f.TLS)
 }
 
 func (w *WebServer) ValidateConfig() (err error) {
+	// Validate OIDC fields when authentication method is OIDCAuth
+	if *w.AuthenticationMethod == string(sessions.OIDCAuth) {
+		return w.OIDC.validateConfig()
+	}
+
 	// Validate LDAP fields when authentication method is LDAPAuth
 	if *w.AuthenticationMethod != string(sessions.LDAPAuth) {
 		return
@@ -819,6 +826,83 @@ func (w *WebServerLDAP) setFrom(f *WebServerLDAP) {
 	}
 }
 
+type WebServerOIDC struct {
+	ProviderURL    *commonconfig.URL
+	ClientID       *string
+	RedirectURL    *commonconfig.URL
+	Scopes         *[]string
+	GroupsClaim    *string
+	AdminUserGroup *string
+	EditUserGroup  *string
+	RunUserGroup   *string
+	ReadUserGroup  *string
+	RequestTimeout *commonconfig.Duration
+}
+
+func (w *WebServerOIDC) setFrom(f *WebServerOIDC) {
+	if v := f.ProviderURL; v != nil {
+		w.ProviderURL = v
+	}
+	if v := f.ClientID; v != nil {
+		w.ClientID = v
+	}
+	if v := f.RedirectURL; v != nil {
+		w.RedirectURL = v
+	}
+	if v := f.Scopes; v != nil {
+		w.Scopes = v
+	}
+	if v := f.GroupsClaim; v != nil {
+		w.GroupsClaim = v
+	}
+	if v := f.AdminUserGroup; v != nil {
+		w.AdminUserGroup = v
+	}
+	if v := f.EditUserGroup; v != nil {
+		w.EditUserGroup = v
+	}
+	if v := f.RunUserGroup; v != nil {
+		w.RunUserGroup = v
+	}
+	if v := f.ReadUserGroup; v != nil {
+		w.ReadUserGroup = v
+	}
+	if v := f.RequestTimeout; v != nil {
+		w.RequestTimeout = v
+	}
+}
+
+// validateConfig is only called when the authentication method is OIDCAuth.
+func (w *WebServerOIDC) validateConfig() (err error) {
+	if w.ProviderURL == nil || w.ProviderURL.IsZero() {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "OIDC.ProviderURL", Msg: "must be set when AuthenticationMethod is oidc"})
+	}
+	if w.ClientID == nil || *w.ClientID == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "OIDC.ClientID", Msg: "must be set when AuthenticationMethod is oidc"})
+	}
+	if w.RedirectURL == nil || w.RedirectURL.IsZero() {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "OIDC.RedirectURL", Msg: "must be set when AuthenticationMethod is oidc"})
+	} else if !strings.HasSuffix(w.RedirectURL.URL().Path, "/oidc/callback") {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OIDC.RedirectURL", Value: w.RedirectURL.URL().String(), Msg: "must end with /oidc/callback"})
+	}
+	if w.GroupsClaim == nil || *w.GroupsClaim == "" {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OIDC.GroupsClaim", Msg: "OIDC GroupsClaim can not be empty"})
+	}
+	if w.AdminUserGroup == nil || *w.AdminUserGroup == "" {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OIDC.AdminUserGroup", Msg: "OIDC AdminUserGroup can not be empty"})
+	}
+	if w.EditUserGroup == nil || *w.EditUserGroup == "" {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OIDC.EditUserGroup", Msg: "OIDC EditUserGroup can not be empty"})
+	}
+	if w.RunUserGroup == nil || *w.RunUserGroup == "" {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OIDC.RunUserGroup", Msg: "OIDC RunUserGroup can not be empty"})
+	}
+	if w.ReadUserGroup == nil || *w.ReadUserGroup == "" {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OIDC.ReadUserGroup", Msg: "OIDC ReadUserGroup can not be empty"})
+	}
+	return err
+}
+
 type WebServerLDAPSecrets struct {
 	ServerAddress     *models.SecretURL
 	ReadOnlyUserLogin *models.Secret
@@ -837,12 +921,24 @@ func (w *WebServerLDAPSecrets) setFrom(f *WebServerLDAPSecrets) {
 	}
 }
 
+type WebServerOIDCSecrets struct {
+	ClientSecret *models.Secret
+}
+
+func (w *WebServerOIDCSecrets) setFrom(f *WebServerOIDCSecrets) {
+	if v := f.ClientSecret; v != nil {
+		w.ClientSecret = v
+	}
+}
+
 type WebServerSecrets struct {
 	LDAP WebServerLDAPSecrets `toml:",omitempty"`
+	OIDC WebServerOIDCSecrets `toml:",omitempty"`
 }
 
 func (w *WebServerSecrets) SetFrom(f *WebServerSecrets) error {
 	w.LDAP.setFrom(&f.LDAP)
+	w.OIDC.setFrom(&f.OIDC)
 	return nil
 }
 
@@ -855,7 +951,8 @@ type JobPipeline struct {
 	ResultWriteQueueDepth     *uint32
 	VerboseLogging            *bool
 
-	HTTPRequest JobPipelineHTTPRequest `toml:",omitempty"`
+	HTTPRequest    JobPipelineHTTPRequest    `toml:",omitempty"`
+	CircuitBreaker JobPipelineCircuitBreaker `toml:",omitempty"`
 }
 
 func (j *JobPipeline) setFrom(f *JobPipeline) {
@@ -881,6 +978,7 @@ func (j *JobPipeline) setFrom(f *JobPipeline) {
 		j.VerboseLogging = v
 	}
 	j.HTTPRequest.setFrom(&f.HTTPRequest)
+	j.CircuitBreaker.setFrom(&f.CircuitBreaker)
 }
 
 type JobPipelineHTTPRequest struct {
@@ -897,6 +995,44 @@ func (j *JobPipelineHTTPRequest) setFrom(f *JobPipelineHTTPRequest) {
 	}
 }
 
+type JobPipelineCircuitBreaker struct {
+	Enabled          *bool
+	FailureThreshold *uint32
+	FailureWindow    *commonconfig.Duration
+	OpenTimeout      *commonconfig.Duration
+}
+
+func (j *JobPipelineCircuitBreaker) setFrom(f *JobPipelineCircuitBreaker) {
+	if v := f.Enabled; v != nil {
+		j.Enabled = v
+	}
+	if v := f.FailureThreshold; v != nil {
+		j.FailureThreshold = v
+	}
+	if v := f.FailureWindow; v != nil {
+		j.FailureWindow = v
+	}
+	if v := f.OpenTimeout; v != nil {
+		j.OpenTimeout = v
+	}
+}
+
+func (j *JobPipelineCircuitBreaker) ValidateConfig() (err error) {
+	if j.Enabled == nil || !*j.Enabled {
+		return
+	}
+	if j.FailureThreshold != nil && *j.FailureThreshold == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "FailureThreshold", Value: 0, Msg: "must be greater than zero"})
+	}
+	if j.FailureWindow != nil && j.FailureWindow.Duration() == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "FailureWindow", Value: j.FailureWindow.String(), Msg: "must be greater than zero"})
+	}
+	if j.OpenTimeout != nil && j.OpenTimeout.Duration() == 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OpenTimeout", Value: j.OpenTimeout.String(), Msg: "must be greater than zero"})
+	}
+	return
+}
+
 type FluxMonitor struct {
 	DefaultTransactionQueueDepth *uint32
 	SimulateTransactions         *bool
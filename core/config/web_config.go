@@ -55,6 +55,20 @@ type LDAP interface {
 	UpstreamSyncRateLimit() commonconfig.Duration
 }
 
+type OIDC interface {
+	ProviderURL() string
+	ClientID() string
+	ClientSecret() string
+	RedirectURL() string
+	Scopes() []string
+	GroupsClaim() string
+	AdminUserGroup() string
+	EditUserGroup() string
+	RunUserGroup() string
+	ReadUserGroup() string
+	RequestTimeout() time.Duration
+}
+
 type WebServer interface {
 	AuthenticationMethod() string
 	AllowOrigins() string
@@ -74,4 +88,5 @@ type WebServer interface {
 	RateLimit() RateLimit
 	MFA() MFA
 	LDAP() LDAP
+	OIDC() OIDC
 }
@@ -45,6 +45,8 @@ import (
 
 	webhook "github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 
+	workflows "github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+
 	zapcore "go.uber.org/zap/zapcore"
 )
 
@@ -665,6 +667,45 @@ func (_m *Application) SetLogLevel(lvl zapcore.Level) error {
 	return r0
 }
 
+// SimulateJobV2 provides a mock function with given fields: ctx, jb, vars, fixtures
+func (_m *Application) SimulateJobV2(ctx context.Context, jb job.Job, vars map[string]interface{}, fixtures pipeline.Fixtures) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, jb, vars, fixtures)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateJobV2")
+	}
+
+	var r0 *pipeline.Run
+	var r1 pipeline.TaskRunResults
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job, map[string]interface{}, pipeline.Fixtures) (*pipeline.Run, pipeline.TaskRunResults, error)); ok {
+		return rf(ctx, jb, vars, fixtures)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, job.Job, map[string]interface{}, pipeline.Fixtures) *pipeline.Run); ok {
+		r0 = rf(ctx, jb, vars, fixtures)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pipeline.Run)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, job.Job, map[string]interface{}, pipeline.Fixtures) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, jb, vars, fixtures)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(pipeline.TaskRunResults)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, job.Job, map[string]interface{}, pipeline.Fixtures) error); ok {
+		r2 = rf(ctx, jb, vars, fixtures)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Application) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -726,6 +767,26 @@ func (_m *Application) WakeSessionReaper() {
 	_m.Called()
 }
 
+// WorkflowExecutionService provides a mock function with given fields:
+func (_m *Application) WorkflowExecutionService() workflows.ExecutionService {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowExecutionService")
+	}
+
+	var r0 workflows.ExecutionService
+	if rf, ok := ret.Get(0).(func() workflows.ExecutionService); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(workflows.ExecutionService)
+		}
+	}
+
+	return r0
+}
+
 // NewApplication creates a new instance of Application. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewApplication(t interface {
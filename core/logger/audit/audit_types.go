@@ -7,6 +7,7 @@ const (
 	AuthLoginFailedEmail    EventID = "AUTH_LOGIN_FAILED_EMAIL"
 	AuthLoginFailedPassword EventID = "AUTH_LOGIN_FAILED_PASSWORD"
 	AuthLoginFailed2FA      EventID = "AUTH_LOGIN_FAILED_2FA"
+	AuthLoginFailedOIDC     EventID = "AUTH_LOGIN_FAILED_OIDC"
 	AuthLoginSuccessWith2FA EventID = "AUTH_LOGIN_SUCCESS_WITH_2FA"
 	AuthLoginSuccessNo2FA   EventID = "AUTH_LOGIN_SUCCESS_NO_2FA"
 	Auth2FAEnrolled         EventID = "AUTH_2FA_ENROLLED"
@@ -20,9 +21,16 @@ const (
 	APITokenDeleteAttemptPasswordMismatch EventID = "API_TOKEN_DELETE_ATTEMPT_PASSWORD_MISMATCH"
 	APITokenDeleted                       EventID = "API_TOKEN_DELETED"
 
+	CustomRoleCreated  EventID = "CUSTOM_ROLE_CREATED"
+	CustomRoleUpdated  EventID = "CUSTOM_ROLE_UPDATED"
+	CustomRoleDeleted  EventID = "CUSTOM_ROLE_DELETED"
+	CustomRoleAssigned EventID = "CUSTOM_ROLE_ASSIGNED"
+
 	FeedsManCreated EventID = "FEEDS_MAN_CREATED"
 	FeedsManUpdated EventID = "FEEDS_MAN_UPDATED"
 
+	FeedsManSpecAutoApproved EventID = "FEEDS_MAN_SPEC_AUTO_APPROVED"
+
 	FeedsManChainConfigCreated EventID = "FEEDS_MAN_CHAIN_CONFIG_CREATED"
 	FeedsManChainConfigUpdated EventID = "FEEDS_MAN_CHAIN_CONFIG_UPDATED"
 	FeedsManChainConfigDeleted EventID = "FEEDS_MAN_CHAIN_CONFIG_DELETED"
@@ -48,6 +56,9 @@ const (
 	KeyExported EventID = "KEY_EXPORTED"
 	KeyDeleted  EventID = "KEY_DELETED"
 
+	KeystoreBackedUp EventID = "KEYSTORE_BACKED_UP"
+	KeystoreRestored EventID = "KEYSTORE_RESTORED"
+
 	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
 	CosmosTransactionCreated EventID = "COSMOS_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
@@ -85,6 +96,8 @@ const (
 	JobErrorDismissed EventID = "JOB_ERROR_DISMISSED"
 	JobRunSet         EventID = "JOB_RUN_SET"
 
+	WorkflowExecutionRerun EventID = "WORKFLOW_EXECUTION_RERUN"
+
 	EnvNoncriticalEnvDumped EventID = "ENV_NONCRITICAL_ENV_DUMPED"
 
 	UnauthedRunResumed EventID = "UNAUTHED_RUN_RESUMED"
@@ -69,6 +69,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/ldapauth"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/localauth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/oidcauth"
 	"github.com/smartcontractkit/chainlink/v2/plugins"
 )
 
@@ -106,6 +107,8 @@ type Application interface {
 	DeleteJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	SimulateJobV2(ctx context.Context, jb job.Job, vars map[string]interface{}, fixtures pipeline.Fixtures) (*pipeline.Run, pipeline.TaskRunResults, error)
+	WorkflowExecutionService() workflows.ExecutionService
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 
@@ -142,6 +145,7 @@ type ChainlinkApplication struct {
 	txmStorageService        txmgr.EvmTxStore
 	FeedsService             feeds.Service
 	webhookJobRunner         webhook.JobRunner
+	workflowExecutionService workflows.ExecutionService
 	Config                   GeneralConfig
 	KeyStore                 keystore.Master
 	ExternalInitiatorManager webhook.ExternalInitiatorManager
@@ -302,7 +306,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	localAdminUsersORM := localauth.NewORM(opts.DS, cfg.WebServer().SessionTimeout().Duration(), globalLogger, auditLogger)
 
 	// Initialize Sessions ORM based on environment configured authenticator
-	// localDB auth or remote LDAP auth
+	// localDB auth, remote LDAP auth or OIDC single sign-on
 	authMethod := cfg.WebServer().AuthenticationMethod()
 	var authenticationProvider sessions.AuthenticationProvider
 	var sessionReaper *utils.SleeperTask
@@ -317,11 +321,21 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			return nil, errors.Wrap(err, "NewApplication: failed to initialize LDAP Authentication module")
 		}
 		sessionReaper = ldapauth.NewLDAPServerStateSync(opts.DS, cfg.WebServer().LDAP(), globalLogger)
+	case sessions.OIDCAuth:
+		var err error
+		authenticationProvider, err = oidcauth.NewOIDCAuthenticator(
+			opts.DS, cfg.WebServer().OIDC(), cfg.WebServer().SessionTimeout().Duration(), cfg.Insecure().DevWebServer(), globalLogger, auditLogger,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "NewApplication: failed to initialize OIDC Authentication module")
+		}
+		// OIDC sessions are stored with the local sessions
+		sessionReaper = localauth.NewSessionReaper(opts.DS, cfg.WebServer(), globalLogger)
 	case sessions.LocalAuth:
 		authenticationProvider = localauth.NewORM(opts.DS, cfg.WebServer().SessionTimeout().Duration(), globalLogger, auditLogger)
 		sessionReaper = localauth.NewSessionReaper(opts.DS, cfg.WebServer(), globalLogger)
 	default:
-		return nil, errors.Errorf("NewApplication: Unexpected 'AuthenticationMethod': %s supported values: %s, %s", authMethod, sessions.LocalAuth, sessions.LDAPAuth)
+		return nil, errors.Errorf("NewApplication: Unexpected 'AuthenticationMethod': %s supported values: %s, %s, %s", authMethod, sessions.LocalAuth, sessions.LDAPAuth, sessions.OIDCAuth)
 	}
 
 	var (
@@ -398,7 +412,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		webhookJobRunner = delegates[job.Webhook].(*webhook.Delegate).WebhookJobRunner()
 	)
 
-	delegates[job.Workflow] = workflows.NewDelegate(
+	workflowDelegate := workflows.NewDelegate(
 		globalLogger,
 		opts.CapabilitiesRegistry,
 		workflowORM,
@@ -411,6 +425,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			return &peerID
 		},
 	)
+	delegates[job.Workflow] = workflowDelegate
 
 	// Flux monitor requires ethereum just to boot, silence errors with a null delegate
 	if !cfg.EVMRPCEnabled() {
@@ -531,6 +546,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			cfg.OCR2(),
 			legacyEVMChains,
 			globalLogger,
+			auditLogger,
 			opts.Version,
 			loopRegistrarConfig,
 		)
@@ -560,6 +576,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		FeedsService:             feedsService,
 		Config:                   cfg,
 		webhookJobRunner:         webhookJobRunner,
+		workflowExecutionService: workflowDelegate,
 		KeyStore:                 keyStore,
 		SessionReaper:            sessionReaper,
 		ExternalInitiatorManager: externalInitiatorManager,
@@ -756,6 +773,11 @@ func (app *ChainlinkApplication) GetExternalInitiatorManager() webhook.ExternalI
 	return app.ExternalInitiatorManager
 }
 
+// WorkflowExecutionService returns the service used to inspect and rerun workflow executions.
+func (app *ChainlinkApplication) WorkflowExecutionService() workflows.ExecutionService {
+	return app.workflowExecutionService
+}
+
 func (app *ChainlinkApplication) SecretGenerator() SecretGenerator {
 	return app.secretGenerator
 }
@@ -860,6 +882,22 @@ func (app *ChainlinkApplication) ResumeJobV2(
 	return app.pipelineRunner.ResumeRun(ctx, taskID, result.Value, result.Error)
 }
 
+// SimulateJobV2 executes the pipeline of the job in memory without side effects: the tasks
+// sending transactions, and the async bridges, are stubbed and the results of any task can
+// be overridden with fixtures. Nothing is saved, so the job doesn't have to exist.
+func (app *ChainlinkApplication) SimulateJobV2(
+	ctx context.Context,
+	jb job.Job,
+	vars map[string]interface{},
+	fixtures pipeline.Fixtures,
+) (*pipeline.Run, pipeline.TaskRunResults, error) {
+	spec, err := jb.SimulationSpec()
+	if err != nil {
+		return nil, nil, err
+	}
+	return app.pipelineRunner.SimulateRun(ctx, spec, pipeline.NewVarsFrom(vars), fixtures, app.logger)
+}
+
 func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
@@ -49,3 +49,19 @@ func (j *jobPipelineConfig) ExternalInitiatorsEnabled() bool {
 func (j *jobPipelineConfig) VerboseLogging() bool {
 	return *j.c.VerboseLogging
 }
+
+func (j *jobPipelineConfig) CircuitBreakerEnabled() bool {
+	return *j.c.CircuitBreaker.Enabled
+}
+
+func (j *jobPipelineConfig) CircuitBreakerFailureThreshold() uint32 {
+	return *j.c.CircuitBreaker.FailureThreshold
+}
+
+func (j *jobPipelineConfig) CircuitBreakerFailureWindow() time.Duration {
+	return j.c.CircuitBreaker.FailureWindow.Duration()
+}
+
+func (j *jobPipelineConfig) CircuitBreakerOpenTimeout() time.Duration {
+	return j.c.CircuitBreaker.OpenTimeout.Duration()
+}
@@ -30,4 +30,8 @@ func TestJobPipelineConfigTest(t *testing.T) {
 	assert.Equal(t, 168*time.Hour, jp.ReaperThreshold())
 	assert.Equal(t, uint64(10), jp.ResultWriteQueueDepth())
 	assert.True(t, jp.ExternalInitiatorsEnabled())
+	assert.True(t, jp.CircuitBreakerEnabled())
+	assert.Equal(t, uint32(3), jp.CircuitBreakerFailureThreshold())
+	assert.Equal(t, 2*time.Minute, jp.CircuitBreakerFailureWindow())
+	assert.Equal(t, time.Minute, jp.CircuitBreakerOpenTimeout())
 }
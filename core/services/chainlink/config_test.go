@@ -349,6 +349,18 @@ func TestConfig_Marshal(t *testing.T) {
 			UpstreamSyncInterval:        commoncfg.MustNewDuration(0 * time.Second),
 			UpstreamSyncRateLimit:       commoncfg.MustNewDuration(2 * time.Minute),
 		},
+		OIDC: toml.WebServerOIDC{
+			ProviderURL:    mustURL("https://idp.example.com/realms/chainlink"),
+			ClientID:       ptr("chainlink-node"),
+			RedirectURL:    mustURL("https://my-chainlink-node.example.com:6688/oidc/callback"),
+			Scopes:         &[]string{"email", "groups"},
+			GroupsClaim:    ptr("roles"),
+			AdminUserGroup: ptr("NodeAdmins"),
+			EditUserGroup:  ptr("NodeEditors"),
+			RunUserGroup:   ptr("NodeRunners"),
+			ReadUserGroup:  ptr("NodeReadOnly"),
+			RequestTimeout: commoncfg.MustNewDuration(30 * time.Second),
+		},
 		RateLimit: toml.WebServerRateLimit{
 			Authenticated:         ptr[int64](42),
 			AuthenticatedPeriod:   commoncfg.MustNewDuration(time.Second),
@@ -376,6 +388,12 @@ func TestConfig_Marshal(t *testing.T) {
 			MaxSize:        ptr[utils.FileSize](100 * utils.MB),
 			DefaultTimeout: commoncfg.MustNewDuration(time.Minute),
 		},
+		CircuitBreaker: toml.JobPipelineCircuitBreaker{
+			Enabled:          ptr(true),
+			FailureThreshold: ptr[uint32](3),
+			FailureWindow:    commoncfg.MustNewDuration(2 * time.Minute),
+			OpenTimeout:      commoncfg.MustNewDuration(time.Minute),
+		},
 	}
 	full.FluxMonitor = toml.FluxMonitor{
 		DefaultTransactionQueueDepth: ptr[uint32](100),
@@ -551,6 +569,7 @@ func TestConfig_Marshal(t *testing.T) {
 				LogPollInterval:           &minute,
 				LogKeepBlocksDepth:        ptr[uint32](100000),
 				LogPrunePageSize:          ptr[uint32](0),
+				LogArchiveDir:             ptr("/var/lib/chainlink/log-archive"),
 				BackupLogPollerBlockDelay: ptr[uint64](532),
 				MinContractPayment:        commonassets.NewLinkFromJuels(math.MaxInt64),
 				MinIncomingConfirmations:  ptr[uint32](13),
@@ -604,6 +623,12 @@ func TestConfig_Marshal(t *testing.T) {
 						Fatal:                             ptr[string]("(: |^)fatal"),
 						ServiceUnavailable:                ptr[string]("(: |^)service unavailable"),
 					},
+					Reads: evmcfg.NodePoolReads{
+						Mode:       ptr("Quorum"),
+						Methods:    []string{"CallContract", "BlockByNumber"},
+						FanOut:     ptr[uint32](3),
+						HedgeDelay: &second,
+					},
 				},
 				OCR: evmcfg.OCR{
 					ContractConfirmations:              ptr[uint16](11),
@@ -846,6 +871,18 @@ UserAPITokenDuration = '240h0m0s'
 UpstreamSyncInterval = '0s'
 UpstreamSyncRateLimit = '2m0s'
 
+[WebServer.OIDC]
+ProviderURL = 'https://idp.example.com/realms/chainlink'
+ClientID = 'chainlink-node'
+RedirectURL = 'https://my-chainlink-node.example.com:6688/oidc/callback'
+Scopes = ['email', 'groups']
+GroupsClaim = 'roles'
+AdminUserGroup = 'NodeAdmins'
+EditUserGroup = 'NodeEditors'
+RunUserGroup = 'NodeRunners'
+ReadUserGroup = 'NodeReadOnly'
+RequestTimeout = '30s'
+
 [WebServer.MFA]
 RPID = 'test-rpid'
 RPOrigin = 'test-rp-origin'
@@ -880,6 +917,12 @@ VerboseLogging = false
 [JobPipeline.HTTPRequest]
 DefaultTimeout = '1m0s'
 MaxSize = '100.00mb'
+
+[JobPipeline.CircuitBreaker]
+Enabled = true
+FailureThreshold = 3
+FailureWindow = '2m0s'
+OpenTimeout = '1m0s'
 `},
 		{"OCR", Config{Core: toml.Core{OCR: full.OCR}}, `[OCR]
 Enabled = true
@@ -977,6 +1020,7 @@ LogBackfillBatchSize = 17
 LogPollInterval = '1m0s'
 LogKeepBlocksDepth = 100000
 LogPrunePageSize = 0
+LogArchiveDir = '/var/lib/chainlink/log-archive'
 BackupLogPollerBlockDelay = 532
 MinIncomingConfirmations = 13
 MinContractPayment = '9.223372036854775807 link'
@@ -1072,6 +1116,12 @@ TransactionAlreadyMined = '(: |^)transaction already mined'
 Fatal = '(: |^)fatal'
 ServiceUnavailable = '(: |^)service unavailable'
 
+[EVM.NodePool.Reads]
+Mode = 'Quorum'
+Methods = ['CallContract', 'BlockByNumber']
+FanOut = 3
+HedgeDelay = '1s'
+
 [EVM.OCR]
 ContractConfirmations = 11
 ContractTransmitterTransmitTimeout = '1m0s'
@@ -1855,6 +1855,54 @@ func (_c *ORM_UpdateManager_Call) RunAndReturn(run func(context.Context, feeds.F
 	return _c
 }
 
+// UpdateManagerAutoApprovalPolicy provides a mock function with given fields: ctx, id, policy
+func (_m *ORM) UpdateManagerAutoApprovalPolicy(ctx context.Context, id int64, policy feeds.AutoApprovalPolicy) error {
+	ret := _m.Called(ctx, id, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateManagerAutoApprovalPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, feeds.AutoApprovalPolicy) error); ok {
+		r0 = rf(ctx, id, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_UpdateManagerAutoApprovalPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateManagerAutoApprovalPolicy'
+type ORM_UpdateManagerAutoApprovalPolicy_Call struct {
+	*mock.Call
+}
+
+// UpdateManagerAutoApprovalPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - policy feeds.AutoApprovalPolicy
+func (_e *ORM_Expecter) UpdateManagerAutoApprovalPolicy(ctx interface{}, id interface{}, policy interface{}) *ORM_UpdateManagerAutoApprovalPolicy_Call {
+	return &ORM_UpdateManagerAutoApprovalPolicy_Call{Call: _e.mock.On("UpdateManagerAutoApprovalPolicy", ctx, id, policy)}
+}
+
+func (_c *ORM_UpdateManagerAutoApprovalPolicy_Call) Run(run func(ctx context.Context, id int64, policy feeds.AutoApprovalPolicy)) *ORM_UpdateManagerAutoApprovalPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(feeds.AutoApprovalPolicy))
+	})
+	return _c
+}
+
+func (_c *ORM_UpdateManagerAutoApprovalPolicy_Call) Return(_a0 error) *ORM_UpdateManagerAutoApprovalPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_UpdateManagerAutoApprovalPolicy_Call) RunAndReturn(run func(context.Context, int64, feeds.AutoApprovalPolicy) error) *ORM_UpdateManagerAutoApprovalPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateSpecDefinition provides a mock function with given fields: ctx, id, spec
 func (_m *ORM) UpdateSpecDefinition(ctx context.Context, id int64, spec string) error {
 	ret := _m.Called(ctx, id, spec)
@@ -722,6 +722,24 @@ func (_m *Service) UpdateManager(ctx context.Context, mgr feeds.FeedsManager) er
 	return r0
 }
 
+// UpdateManagerAutoApprovalPolicy provides a mock function with given fields: ctx, id, policy
+func (_m *Service) UpdateManagerAutoApprovalPolicy(ctx context.Context, id int64, policy feeds.AutoApprovalPolicy) error {
+	ret := _m.Called(ctx, id, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateManagerAutoApprovalPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, feeds.AutoApprovalPolicy) error); ok {
+		r0 = rf(ctx, id, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateSpecDefinition provides a mock function with given fields: ctx, id, spec
 func (_m *Service) UpdateSpecDefinition(ctx context.Context, id int64, spec string) error {
 	ret := _m.Called(ctx, id, spec)
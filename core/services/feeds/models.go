@@ -89,6 +89,40 @@ func NewChainType(s string) (ChainType, error) {
 	}
 }
 
+// AutoApprovalPolicy defines which proposed specs of a feeds manager are
+// approved without the intervention of the node operator.
+type AutoApprovalPolicy string
+
+const (
+	// AutoApprovalPolicyNone requires the node operator to approve every
+	// proposed spec.
+	AutoApprovalPolicyNone AutoApprovalPolicy = "none"
+	// AutoApprovalPolicyObservationSource approves the proposed specs which
+	// only change the observationSource of the running spec, while using the
+	// same bridges.
+	AutoApprovalPolicyObservationSource AutoApprovalPolicy = "observation_source"
+)
+
+func NewAutoApprovalPolicy(s string) (AutoApprovalPolicy, error) {
+	switch AutoApprovalPolicy(s) {
+	case AutoApprovalPolicyNone, AutoApprovalPolicyObservationSource:
+		return AutoApprovalPolicy(s), nil
+	default:
+		return AutoApprovalPolicyNone, errors.Errorf("invalid auto approval policy: %s", s)
+	}
+}
+
+// Approves returns true if the policy approves a proposed spec which differs
+// from the running spec by diff.
+func (p AutoApprovalPolicy) Approves(diff *SpecDiff) bool {
+	switch p {
+	case AutoApprovalPolicyObservationSource:
+		return diff.OnlyObservationSourceChanged()
+	default:
+		return false
+	}
+}
+
 // FeedsManager defines a registered Feeds Manager Service and the connection
 // information.
 type FeedsManager struct {
@@ -96,6 +130,7 @@ type FeedsManager struct {
 	Name               string
 	URI                string
 	PublicKey          crypto.PublicKey
+	AutoApprovalPolicy AutoApprovalPolicy
 	IsConnectionActive bool
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
@@ -345,6 +345,39 @@ func Test_OCR2Config_Scan(t *testing.T) {
 	assert.Equal(t, want, actual)
 }
 
+func Test_NewAutoApprovalPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy, err := NewAutoApprovalPolicy("observation_source")
+	require.NoError(t, err)
+	assert.Equal(t, AutoApprovalPolicyObservationSource, policy)
+
+	_, err = NewAutoApprovalPolicy("all")
+	require.EqualError(t, err, "invalid auto approval policy: all")
+}
+
+func Test_AutoApprovalPolicy_Approves(t *testing.T) {
+	t.Parallel()
+
+	observationSourceChange := &SpecDiff{Changes: []SpecFieldChange{
+		{Path: "observationSource", Type: SpecChangeTypeModified},
+	}}
+	bridgeChange := &SpecDiff{
+		Changes:      []SpecFieldChange{{Path: "observationSource", Type: SpecChangeTypeModified}},
+		AddedBridges: []string{"bridge"},
+	}
+	otherChange := &SpecDiff{Changes: []SpecFieldChange{
+		{Path: "observationSource", Type: SpecChangeTypeModified},
+		{Path: "maxTaskDuration", Type: SpecChangeTypeAdded},
+	}}
+
+	assert.False(t, AutoApprovalPolicyNone.Approves(observationSourceChange))
+	assert.True(t, AutoApprovalPolicyObservationSource.Approves(observationSourceChange))
+	assert.False(t, AutoApprovalPolicyObservationSource.Approves(bridgeChange))
+	assert.False(t, AutoApprovalPolicyObservationSource.Approves(otherChange))
+	assert.False(t, AutoApprovalPolicyObservationSource.Approves(&SpecDiff{}))
+}
+
 func Test_JobProposal_CanEditDefinition(t *testing.T) {
 	t.Parallel()
 
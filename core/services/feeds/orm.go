@@ -22,6 +22,7 @@ type ORM interface {
 	ListManagers(ctx context.Context) (mgrs []FeedsManager, err error)
 	ListManagersByIDs(ctx context.Context, ids []int64) ([]FeedsManager, error)
 	UpdateManager(ctx context.Context, mgr FeedsManager) error
+	UpdateManagerAutoApprovalPolicy(ctx context.Context, id int64, policy AutoApprovalPolicy) error
 
 	CreateBatchChainConfig(ctx context.Context, cfgs []ChainConfig) ([]int64, error)
 	CreateChainConfig(ctx context.Context, cfg ChainConfig) (int64, error)
@@ -248,7 +249,7 @@ RETURNING id;
 // GetManager gets a feeds manager by id.
 func (o *orm) GetManager(ctx context.Context, id int64) (mgr *FeedsManager, err error) {
 	stmt := `
-SELECT id, name, uri, public_key, created_at, updated_at
+SELECT id, name, uri, public_key, auto_approval_policy, created_at, updated_at
 FROM feeds_managers
 WHERE id = $1
 `
@@ -261,7 +262,7 @@ WHERE id = $1
 // ListManager lists all feeds managers.
 func (o *orm) ListManagers(ctx context.Context) (mgrs []FeedsManager, err error) {
 	stmt := `
-SELECT id, name, uri, public_key, created_at, updated_at
+SELECT id, name, uri, public_key, auto_approval_policy, created_at, updated_at
 FROM feeds_managers;
 `
 
@@ -272,7 +273,7 @@ FROM feeds_managers;
 // ListManagersByIDs gets feeds managers by ids.
 func (o *orm) ListManagersByIDs(ctx context.Context, ids []int64) (managers []FeedsManager, err error) {
 	stmt := `
-SELECT id, name, uri, public_key, created_at, updated_at
+SELECT id, name, uri, public_key, auto_approval_policy, created_at, updated_at
 FROM feeds_managers
 WHERE id = ANY($1)
 ORDER BY created_at, id;`
@@ -305,6 +306,29 @@ WHERE id = $4;
 	return nil
 }
 
+// UpdateManagerAutoApprovalPolicy updates the auto approval policy of the
+// manager.
+func (o *orm) UpdateManagerAutoApprovalPolicy(ctx context.Context, id int64, policy AutoApprovalPolicy) error {
+	stmt := `
+UPDATE feeds_managers
+SET auto_approval_policy = $1, updated_at = NOW()
+WHERE id = $2;
+`
+
+	res, err := o.ds.ExecContext(ctx, stmt, policy, id)
+	if err != nil {
+		return errors.Wrap(err, "UpdateManagerAutoApprovalPolicy failed to update feeds_managers")
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "UpdateManagerAutoApprovalPolicy failed to get RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
 // CreateJobProposal creates a job proposal.
 func (o *orm) CreateJobProposal(ctx context.Context, jp *JobProposal) (id int64, err error) {
 	stmt := `
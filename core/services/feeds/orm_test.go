@@ -196,6 +196,30 @@ func Test_ORM_UpdateManager(t *testing.T) {
 	assert.Equal(t, updatedMgr.PublicKey, actual.PublicKey)
 }
 
+func Test_ORM_UpdateManagerAutoApprovalPolicy(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	var (
+		orm = setupORM(t)
+		id  = createFeedsManager(t, orm)
+	)
+
+	actual, err := orm.GetManager(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, feeds.AutoApprovalPolicyNone, actual.AutoApprovalPolicy)
+
+	err = orm.UpdateManagerAutoApprovalPolicy(ctx, id, feeds.AutoApprovalPolicyObservationSource)
+	require.NoError(t, err)
+
+	actual, err = orm.GetManager(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, feeds.AutoApprovalPolicyObservationSource, actual.AutoApprovalPolicy)
+
+	err = orm.UpdateManagerAutoApprovalPolicy(ctx, -1, feeds.AutoApprovalPolicyNone)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
 // Chain Config
 
 func Test_ORM_CreateChainConfig(t *testing.T) {
@@ -23,6 +23,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	pb "github.com/smartcontractkit/chainlink/v2/core/services/feeds/proto"
 	"github.com/smartcontractkit/chainlink/v2/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -86,6 +87,7 @@ type Service interface {
 	ListManagersByIDs(ctx context.Context, ids []int64) ([]FeedsManager, error)
 	RegisterManager(ctx context.Context, params RegisterManagerParams) (int64, error)
 	UpdateManager(ctx context.Context, mgr FeedsManager) error
+	UpdateManagerAutoApprovalPolicy(ctx context.Context, id int64, policy AutoApprovalPolicy) error
 
 	CreateChainConfig(ctx context.Context, cfg ChainConfig) (int64, error)
 	DeleteChainConfig(ctx context.Context, id int64) (int64, error)
@@ -131,6 +133,7 @@ type service struct {
 	connMgr             ConnectionsManager
 	legacyChains        legacyevm.LegacyChainContainer
 	lggr                logger.Logger
+	auditLogger         audit.AuditLogger
 	version             string
 	loopRegistrarConfig plugins.RegistrarConfig
 }
@@ -149,6 +152,7 @@ func NewService(
 	ocr2Cfg OCR2Config,
 	legacyChains legacyevm.LegacyChainContainer,
 	lggr logger.Logger,
+	auditLogger audit.AuditLogger,
 	version string,
 	rc plugins.RegistrarConfig,
 ) *service {
@@ -170,6 +174,7 @@ func NewService(
 		connMgr:             newConnectionsManager(lggr),
 		legacyChains:        legacyChains,
 		lggr:                lggr,
+		auditLogger:         auditLogger,
 		version:             version,
 		loopRegistrarConfig: rc,
 	}
@@ -282,6 +287,16 @@ func (s *service) UpdateManager(ctx context.Context, mgr FeedsManager) error {
 	return nil
 }
 
+// UpdateManagerAutoApprovalPolicy updates the policy which defines the
+// proposed specs of the manager which are approved automatically.
+func (s *service) UpdateManagerAutoApprovalPolicy(ctx context.Context, id int64, policy AutoApprovalPolicy) error {
+	if _, err := NewAutoApprovalPolicy(string(policy)); err != nil {
+		return err
+	}
+
+	return errors.Wrap(s.orm.UpdateManagerAutoApprovalPolicy(ctx, id, policy), "could not update manager auto approval policy")
+}
+
 // ListManagerServices lists all the manager services.
 func (s *service) ListManagers(ctx context.Context) ([]FeedsManager, error) {
 	managers, err := s.orm.ListManagers(ctx)
@@ -553,6 +568,9 @@ func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64,
 		}
 	}
 
+	// Only updates of the running job of a proposal are auto approved
+	var isRunningJobUpdate bool
+
 	// Validation for existing job proposals
 	if err == nil {
 		// Ensure that if the job proposal exists, that it belongs to the feeds
@@ -572,13 +590,15 @@ func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64,
 			// note: CLO auto-increments the version number on re-proposal, so this should never happen
 			return 0, errors.New("proposed job spec version already exists")
 		}
+
+		isRunningJobUpdate = existing.Status == JobProposalStatusApproved
 	}
 
 	logger := s.lggr.With(
 		"job_proposal_remote_uuid", args.RemoteUUID,
 	)
 
-	var id int64
+	var id, specID int64
 	err = s.orm.Transact(ctx, func(tx ORM) error {
 		var txerr error
 
@@ -598,7 +618,7 @@ func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64,
 		}
 
 		// Create the spec version
-		_, txerr = tx.CreateSpec(ctx, JobProposalSpec{
+		specID, txerr = tx.CreateSpec(ctx, JobProposalSpec{
 			Definition:    args.Spec,
 			Status:        SpecStatusPending,
 			Version:       args.Version,
@@ -627,6 +647,10 @@ func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64,
 	} else {
 		// Track the given job proposal request
 		promJobProposalRequest.Inc()
+
+		if isRunningJobUpdate {
+			s.autoApproveSpec(ctx, logger, args.FeedsManagerID, specID)
+		}
 	}
 
 	if err = s.observeJobProposalCounts(ctx); err != nil {
@@ -636,6 +660,46 @@ func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64,
 	return id, nil
 }
 
+// autoApproveSpec approves a proposed spec which updates a running job if the
+// auto approval policy of the feeds manager approves its diff with the running
+// spec. The spec is left pending for the node operator when it is not approved.
+func (s *service) autoApproveSpec(ctx context.Context, lggr logger.Logger, mgrID int64, specID int64) {
+	mgr, err := s.orm.GetManager(ctx, mgrID)
+	if err != nil {
+		lggr.Errorw("Failed to get feeds manager for auto approval", "err", err)
+		return
+	}
+
+	if mgr.AutoApprovalPolicy == AutoApprovalPolicyNone {
+		return
+	}
+
+	diff, err := s.getSpecDiff(ctx, specID)
+	if err != nil {
+		lggr.Errorw("Failed to diff spec for auto approval", "id", specID, "err", err)
+		return
+	}
+
+	if diff == nil || !mgr.AutoApprovalPolicy.Approves(diff) {
+		lggr.Infow("Spec not auto approved by policy", "id", specID, "policy", mgr.AutoApprovalPolicy)
+		return
+	}
+
+	// The running job is replaced by the job of the approved spec
+	if err = s.ApproveSpec(ctx, specID, true); err != nil {
+		lggr.Errorw("Failed to auto approve spec", "id", specID, "err", err)
+		return
+	}
+
+	s.auditLogger.Audit(audit.FeedsManSpecAutoApproved, map[string]interface{}{
+		"feedsManagerID": mgrID,
+		"specID":         specID,
+		"policy":         mgr.AutoApprovalPolicy,
+		"diff":           diff,
+	})
+	lggr.Infow("Successful spec auto approval", "id", specID, "policy", mgr.AutoApprovalPolicy)
+}
+
 func isWFSpec(lggr logger.Logger, spec string) bool {
 	jobType, err := job.ValidateSpec(spec)
 	if err != nil {
@@ -987,6 +1051,31 @@ func (s *service) GetSpec(ctx context.Context, id int64) (*JobProposalSpec, erro
 	return s.orm.GetSpec(ctx, id)
 }
 
+// getSpecDiff diffs the spec with the approved spec of its job proposal, which
+// is the spec of the running job. It returns nil if the job proposal has no
+// approved spec, or if the spec is the approved one.
+func (s *service) getSpecDiff(ctx context.Context, id int64) (*SpecDiff, error) {
+	spec, err := s.orm.GetSpec(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: job proposal spec")
+	}
+
+	approvedSpec, err := s.orm.GetApprovedSpec(ctx, spec.JobProposalID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, errors.Wrap(err, "orm: approved job proposal spec")
+	}
+
+	if approvedSpec.ID == spec.ID {
+		return nil, nil
+	}
+
+	return DiffSpecs(approvedSpec.Definition, spec.Definition)
+}
+
 // UpdateSpecDefinition updates the spec's TOML definition.
 func (s *service) UpdateSpecDefinition(ctx context.Context, id int64, defn string) error {
 	spec, err := s.orm.GetSpec(ctx, id)
@@ -1495,6 +1584,9 @@ func (ns NullService) SyncNodeInfo(ctx context.Context, id int64) error { return
 func (ns NullService) UpdateManager(ctx context.Context, mgr FeedsManager) error {
 	return ErrFeedsManagerDisabled
 }
+func (ns NullService) UpdateManagerAutoApprovalPolicy(ctx context.Context, id int64, policy AutoApprovalPolicy) error {
+	return ErrFeedsManagerDisabled
+}
 func (ns NullService) IsJobManaged(ctx context.Context, jobID int64) (bool, error) {
 	return false, nil
 }
@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"encoding/hex"
 	"fmt"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -29,6 +31,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
 	"github.com/smartcontractkit/chainlink/v2/core/services/feeds/mocks"
@@ -157,6 +160,27 @@ type TestService struct {
 	ocr1Keystore *ksmocks.OCR
 	ocr2Keystore *ksmocks.OCR2
 	legacyChains legacyevm.LegacyChainContainer
+	auditLogger  *auditLogRecorder
+}
+
+// auditLogRecorder records the audit log events of the service.
+type auditLogRecorder struct {
+	audit.AuditLogger
+
+	mu     sync.Mutex
+	events map[audit.EventID][]audit.Data
+}
+
+func (r *auditLogRecorder) Audit(eventID audit.EventID, data audit.Data) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events[eventID] = append(r.events[eventID], data)
+}
+
+func (r *auditLogRecorder) Events(eventID audit.EventID) []audit.Data {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.events[eventID]
 }
 
 func setupTestService(t *testing.T) *TestService {
@@ -194,7 +218,8 @@ func setupTestServiceCfg(t *testing.T, overrideCfg func(c *chainlink.Config, s *
 	keyStore.On("P2P").Return(p2pKeystore)
 	keyStore.On("OCR").Return(ocr1Keystore)
 	keyStore.On("OCR2").Return(ocr2Keystore)
-	svc := feeds.NewService(orm, jobORM, db, spawner, keyStore, gcfg, gcfg.Insecure(), gcfg.JobPipeline(), gcfg.OCR(), gcfg.OCR2(), legacyChains, lggr, "1.0.0", nil)
+	auditLogger := &auditLogRecorder{AuditLogger: audit.NoopLogger, events: make(map[audit.EventID][]audit.Data)}
+	svc := feeds.NewService(orm, jobORM, db, spawner, keyStore, gcfg, gcfg.Insecure(), gcfg.JobPipeline(), gcfg.OCR(), gcfg.OCR2(), legacyChains, lggr, auditLogger, "1.0.0", nil)
 	svc.SetConnectionsManager(connMgr)
 
 	return &TestService{
@@ -209,6 +234,7 @@ func setupTestServiceCfg(t *testing.T, overrideCfg func(c *chainlink.Config, s *
 		ocr1Keystore: ocr1Keystore,
 		ocr2Keystore: ocr2Keystore,
 		legacyChains: legacyChains,
+		auditLogger:  auditLogger,
 	}
 }
 
@@ -976,6 +1002,171 @@ targets:
 	}
 }
 
+func Test_Service_ProposeJob_AutoApproval(t *testing.T) {
+	t.Parallel()
+
+	var (
+		jpID          = int64(1)
+		specID        = int64(101)
+		remoteUUID    = uuid.New()
+		externalJobID = uuid.New()
+		runningDefn   = fmt.Sprintf(FluxMonitorTestSpecTemplate, externalJobID, externalJobID)
+		runningSpec   = &feeds.JobProposalSpec{
+			ID:            100,
+			Definition:    runningDefn,
+			Status:        feeds.SpecStatusApproved,
+			Version:       1,
+			JobProposalID: jpID,
+		}
+		jp = &feeds.JobProposal{
+			ID:             jpID,
+			FeedsManagerID: 1,
+			RemoteUUID:     remoteUUID,
+			Status:         feeds.JobProposalStatusApproved,
+			ExternalJobID:  uuid.NullUUID{UUID: externalJobID, Valid: true},
+		}
+		httpTimeout = *commonconfig.MustNewDuration(1 * time.Second)
+	)
+
+	// proposeUpdate mocks the proposal of a new version of the running spec.
+	proposeUpdate := func(svc *TestService, defn string, policy feeds.AutoApprovalPolicy) *feeds.JobProposalSpec {
+		spec := &feeds.JobProposalSpec{
+			ID:            specID,
+			Definition:    defn,
+			Status:        feeds.SpecStatusPending,
+			Version:       2,
+			JobProposalID: jpID,
+		}
+
+		svc.orm.On("GetJobProposalByRemoteUUID", mock.Anything, remoteUUID).Return(jp, nil)
+		svc.orm.On("ExistsSpecByJobProposalIDAndVersion", mock.Anything, jpID, spec.Version).Return(false, nil)
+		svc.orm.On("UpsertJobProposal", mock.Anything, mock.IsType(&feeds.JobProposal{})).Return(jpID, nil)
+		svc.orm.On("CreateSpec", mock.Anything, feeds.JobProposalSpec{
+			Definition:    defn,
+			Status:        feeds.SpecStatusPending,
+			Version:       spec.Version,
+			JobProposalID: jpID,
+		}).Return(specID, nil)
+		svc.orm.On("CountJobProposalsByStatus", mock.Anything).Return(&feeds.JobProposalCounts{}, nil)
+		transactCall := svc.orm.On("Transact", mock.Anything, mock.Anything)
+		transactCall.Run(func(args mock.Arguments) {
+			fn := args[1].(func(orm feeds.ORM) error)
+			transactCall.ReturnArguments = mock.Arguments{fn(svc.orm)}
+		})
+		svc.orm.On("GetManager", mock.Anything, jp.FeedsManagerID).Return(&feeds.FeedsManager{ID: jp.FeedsManagerID, AutoApprovalPolicy: policy}, nil)
+
+		return spec
+	}
+
+	testCases := []struct {
+		name         string
+		before       func(svc *TestService) string
+		wantApproved bool
+	}{
+		{
+			name:         "auto approves an update of the observationSource",
+			wantApproved: true,
+			before: func(svc *TestService) string {
+				defn := strings.Replace(runningDefn, "currentprice.json", "currentprice/USD.json", 1)
+				spec := proposeUpdate(svc, defn, feeds.AutoApprovalPolicyObservationSource)
+				svc.orm.On("GetSpec", mock.Anything, specID).Return(spec, nil)
+				svc.orm.On("GetApprovedSpec", mock.Anything, jpID).Return(runningSpec, nil)
+
+				// The running job is replaced by the job of the approved spec
+				svc.orm.On("GetJobProposal", mock.Anything, jpID).Return(jp, nil)
+				svc.connMgr.On("GetClient", jp.FeedsManagerID).Return(svc.fmsClient, nil)
+				svc.jobORM.On("AssertBridgesExist", mock.Anything, mock.IsType(pipeline.Pipeline{})).Return(nil)
+				svc.orm.On("WithDataSource", mock.Anything).Return(feeds.ORM(svc.orm))
+				svc.jobORM.On("WithDataSource", mock.Anything).Return(job.ORM(svc.jobORM))
+				svc.jobORM.On("FindJobByExternalJobID", mock.Anything, externalJobID).Return(job.Job{ID: 1, ExternalJobID: externalJobID}, nil)
+				svc.orm.On("CancelSpec", mock.Anything, runningSpec.ID).Return(nil)
+				svc.spawner.On("DeleteJob", mock.Anything, mock.Anything, int32(1)).Return(nil)
+				svc.spawner.On("CreateJob", mock.Anything, mock.Anything, mock.IsType(&job.Job{})).Return(nil)
+				svc.orm.On("ApproveSpec", mock.Anything, specID, externalJobID).Return(nil)
+				svc.fmsClient.On("ApprovedJob",
+					mock.MatchedBy(func(ctx context.Context) bool { return true }),
+					&proto.ApprovedJobRequest{
+						Uuid:    remoteUUID.String(),
+						Version: int64(spec.Version),
+					},
+				).Return(&proto.ApprovedJobResponse{}, nil)
+
+				return defn
+			},
+		},
+		{
+			name: "leaves other updates pending",
+			before: func(svc *TestService) string {
+				defn := strings.Replace(runningDefn, "threshold = 0.5", "threshold = 1.0", 1)
+				spec := proposeUpdate(svc, defn, feeds.AutoApprovalPolicyObservationSource)
+				svc.orm.On("GetSpec", mock.Anything, specID).Return(spec, nil)
+				svc.orm.On("GetApprovedSpec", mock.Anything, jpID).Return(runningSpec, nil)
+
+				return defn
+			},
+		},
+		{
+			name: "requires the approval of the node operator by default",
+			before: func(svc *TestService) string {
+				defn := strings.Replace(runningDefn, "currentprice.json", "currentprice/USD.json", 1)
+				proposeUpdate(svc, defn, feeds.AutoApprovalPolicyNone)
+
+				return defn
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := setupTestServiceCfg(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+				c.JobPipeline.HTTPRequest.DefaultTimeout = &httpTimeout
+			})
+			defn := tc.before(svc)
+
+			actual, err := svc.ProposeJob(testutils.Context(t), &feeds.ProposeJobArgs{
+				FeedsManagerID: jp.FeedsManagerID,
+				RemoteUUID:     remoteUUID,
+				Spec:           defn,
+				Version:        2,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, jpID, actual)
+
+			events := svc.auditLogger.Events(audit.FeedsManSpecAutoApproved)
+			if !tc.wantApproved {
+				assert.Empty(t, events)
+				return
+			}
+			require.Len(t, events, 1)
+			assert.Equal(t, jp.FeedsManagerID, events[0]["feedsManagerID"])
+			assert.Equal(t, specID, events[0]["specID"])
+			assert.Equal(t, feeds.AutoApprovalPolicyObservationSource, events[0]["policy"])
+			diff, ok := events[0]["diff"].(*feeds.SpecDiff)
+			require.True(t, ok)
+			assert.True(t, diff.OnlyObservationSourceChanged())
+		})
+	}
+}
+
+func Test_Service_UpdateManagerAutoApprovalPolicy(t *testing.T) {
+	t.Parallel()
+
+	svc := setupTestService(t)
+	ctx := testutils.Context(t)
+
+	svc.orm.On("UpdateManagerAutoApprovalPolicy", mock.Anything, int64(1), feeds.AutoApprovalPolicyObservationSource).Return(nil)
+
+	err := svc.UpdateManagerAutoApprovalPolicy(ctx, 1, feeds.AutoApprovalPolicyObservationSource)
+	require.NoError(t, err)
+
+	err = svc.UpdateManagerAutoApprovalPolicy(ctx, 1, "all")
+	require.EqualError(t, err, "invalid auto approval policy: all")
+}
+
 func Test_Service_DeleteJob(t *testing.T) {
 	t.Parallel()
 
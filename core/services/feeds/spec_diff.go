@@ -0,0 +1,200 @@
+package feeds
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// observationSourceField is the field of a job spec which holds its pipeline.
+const observationSourceField = "observationSource"
+
+// SpecChangeType is the type of change made to a field of a job spec.
+type SpecChangeType string
+
+const (
+	SpecChangeTypeAdded    SpecChangeType = "added"
+	SpecChangeTypeRemoved  SpecChangeType = "removed"
+	SpecChangeTypeModified SpecChangeType = "modified"
+)
+
+// SpecFieldChange is a change made to a field of a job spec. The fields of
+// tables are identified by their dotted path, such as `relayConfig.chainID`.
+type SpecFieldChange struct {
+	Path     string
+	Type     SpecChangeType
+	OldValue null.String
+	NewValue null.String
+}
+
+// SpecDiff is the structured difference between the running spec of a job
+// proposal and a proposed spec.
+type SpecDiff struct {
+	// Changes are the changed fields, sorted by path.
+	Changes []SpecFieldChange
+	// AddedBridges are the bridges which the proposed pipeline uses, but the
+	// running one does not.
+	AddedBridges []string
+	// RemovedBridges are the bridges which the running pipeline uses, but the
+	// proposed one does not.
+	RemovedBridges []string
+}
+
+// IsEmpty returns true if the specs are equivalent.
+func (d *SpecDiff) IsEmpty() bool {
+	return len(d.Changes) == 0
+}
+
+// OnlyObservationSourceChanged returns true if the observationSource is the
+// only changed field, and the pipelines use the same bridges.
+func (d *SpecDiff) OnlyObservationSourceChanged() bool {
+	return len(d.Changes) == 1 &&
+		d.Changes[0].Path == observationSourceField &&
+		d.Changes[0].Type == SpecChangeTypeModified &&
+		len(d.AddedBridges) == 0 &&
+		len(d.RemovedBridges) == 0
+}
+
+// DiffSpecs compares the fields of two job spec TOML definitions, and the
+// bridges used by their pipelines.
+func DiffSpecs(running, proposed string) (*SpecDiff, error) {
+	runningFields, err := flattenSpec(running)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse running spec")
+	}
+	proposedFields, err := flattenSpec(proposed)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse proposed spec")
+	}
+
+	diff := &SpecDiff{}
+	for path, oldValue := range runningFields {
+		newValue, ok := proposedFields[path]
+		if !ok {
+			diff.Changes = append(diff.Changes, SpecFieldChange{
+				Path:     path,
+				Type:     SpecChangeTypeRemoved,
+				OldValue: null.StringFrom(formatSpecValue(oldValue)),
+			})
+			continue
+		}
+
+		if !reflect.DeepEqual(oldValue, newValue) {
+			diff.Changes = append(diff.Changes, SpecFieldChange{
+				Path:     path,
+				Type:     SpecChangeTypeModified,
+				OldValue: null.StringFrom(formatSpecValue(oldValue)),
+				NewValue: null.StringFrom(formatSpecValue(newValue)),
+			})
+		}
+	}
+	for path, newValue := range proposedFields {
+		if _, ok := runningFields[path]; !ok {
+			diff.Changes = append(diff.Changes, SpecFieldChange{
+				Path:     path,
+				Type:     SpecChangeTypeAdded,
+				NewValue: null.StringFrom(formatSpecValue(newValue)),
+			})
+		}
+	}
+	sort.Slice(diff.Changes, func(i, j int) bool {
+		return diff.Changes[i].Path < diff.Changes[j].Path
+	})
+
+	runningBridges, err := pipelineBridges(runningFields[observationSourceField])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the observationSource of the running spec")
+	}
+	proposedBridges, err := pipelineBridges(proposedFields[observationSourceField])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the observationSource of the proposed spec")
+	}
+	diff.AddedBridges = bridgesDifference(proposedBridges, runningBridges)
+	diff.RemovedBridges = bridgesDifference(runningBridges, proposedBridges)
+
+	return diff, nil
+}
+
+// flattenSpec parses a TOML definition into a map of the dotted paths of its
+// fields to their values. Arrays, including arrays of tables, are kept whole.
+func flattenSpec(defn string) (map[string]interface{}, error) {
+	var tree map[string]interface{}
+	if err := toml.Unmarshal([]byte(defn), &tree); err != nil {
+		return nil, err
+	}
+
+	fields := map[string]interface{}{}
+	var flatten func(prefix string, table map[string]interface{})
+	flatten = func(prefix string, table map[string]interface{}) {
+		for k, v := range table {
+			path := k
+			if prefix != "" {
+				path = prefix + "." + k
+			}
+			if subTable, ok := v.(map[string]interface{}); ok {
+				flatten(path, subTable)
+				continue
+			}
+			fields[path] = v
+		}
+	}
+	flatten("", tree)
+
+	return fields, nil
+}
+
+// formatSpecValue formats the value of a field for display. Strings are kept
+// as is, so that multi-line values such as pipelines remain readable.
+func formatSpecValue(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+// pipelineBridges returns the set of bridges used by a pipeline. A missing
+// observationSource uses no bridges.
+func pipelineBridges(observationSource interface{}) (map[string]struct{}, error) {
+	bridges := map[string]struct{}{}
+	source, ok := observationSource.(string)
+	if !ok || source == "" {
+		return bridges, nil
+	}
+
+	p, err := pipeline.Parse(source)
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range p.Tasks {
+		if task.Type() == pipeline.TaskTypeBridge {
+			bridges[task.(*pipeline.BridgeTask).Name] = struct{}{}
+		}
+	}
+
+	return bridges, nil
+}
+
+// bridgesDifference returns the sorted names of the bridges of a which are not
+// in b.
+func bridgesDifference(a, b map[string]struct{}) []string {
+	var names []string
+	for name := range a {
+		if _, ok := b[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,119 @@
+package feeds_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
+)
+
+func Test_DiffSpecs(t *testing.T) {
+	t.Parallel()
+
+	running := `
+type = "fluxmonitor"
+schemaVersion = 1
+name = "example flux monitor spec"
+contractAddress = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+threshold = 0.5
+drumbeatEnabled = false
+observationSource = """
+ds1 [type=bridge name="bridge-a"];
+ds2 [type=bridge name="bridge-b"];
+ds1 -> ds2;
+"""
+
+[relayConfig]
+chainID = 1337
+`
+
+	t.Run("equivalent specs", func(t *testing.T) {
+		diff, err := feeds.DiffSpecs(running, running)
+		require.NoError(t, err)
+		assert.True(t, diff.IsEmpty())
+		assert.False(t, diff.OnlyObservationSourceChanged())
+	})
+
+	t.Run("only the observationSource changed", func(t *testing.T) {
+		proposed := `
+type = "fluxmonitor"
+schemaVersion = 1
+name = "example flux monitor spec"
+contractAddress = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+threshold = 0.5
+drumbeatEnabled = false
+observationSource = """
+ds1 [type=bridge name="bridge-b"];
+ds2 [type=bridge name="bridge-a" timeout="10s"];
+ds1 -> ds2;
+"""
+
+[relayConfig]
+chainID = 1337
+`
+		diff, err := feeds.DiffSpecs(running, proposed)
+		require.NoError(t, err)
+		require.Len(t, diff.Changes, 1)
+		assert.Equal(t, "observationSource", diff.Changes[0].Path)
+		assert.Empty(t, diff.AddedBridges)
+		assert.Empty(t, diff.RemovedBridges)
+		assert.True(t, diff.OnlyObservationSourceChanged())
+	})
+
+	t.Run("fields and bridges changed", func(t *testing.T) {
+		r := `
+name = "spec"
+threshold = 0.5
+drumbeatEnabled = false
+observationSource = """
+ds1 [type=bridge name="bridge-a"];
+ds2 [type=bridge name="bridge-b"];
+ds1 -> ds2;
+"""
+
+[relayConfig]
+chainID = 1337
+`
+		p := `
+name = "spec"
+threshold = 1.0
+observationSource = """
+ds1 [type=bridge name="bridge-a"];
+ds2 [type=bridge name="bridge-c"];
+ds1 -> ds2;
+"""
+maxTaskDuration = "10s"
+
+[relayConfig]
+chainID = 1
+`
+		diff, err := feeds.DiffSpecs(r, p)
+		require.NoError(t, err)
+		assert.Equal(t, []feeds.SpecFieldChange{
+			{Path: "drumbeatEnabled", Type: feeds.SpecChangeTypeRemoved, OldValue: null.StringFrom("false")},
+			{Path: "maxTaskDuration", Type: feeds.SpecChangeTypeAdded, NewValue: null.StringFrom("10s")},
+			{
+				Path:     "observationSource",
+				Type:     feeds.SpecChangeTypeModified,
+				OldValue: null.StringFrom("ds1 [type=bridge name=\"bridge-a\"];\nds2 [type=bridge name=\"bridge-b\"];\nds1 -> ds2;\n"),
+				NewValue: null.StringFrom("ds1 [type=bridge name=\"bridge-a\"];\nds2 [type=bridge name=\"bridge-c\"];\nds1 -> ds2;\n"),
+			},
+			{Path: "relayConfig.chainID", Type: feeds.SpecChangeTypeModified, OldValue: null.StringFrom("1337"), NewValue: null.StringFrom("1")},
+			{Path: "threshold", Type: feeds.SpecChangeTypeModified, OldValue: null.StringFrom("0.5"), NewValue: null.StringFrom("1")},
+		}, diff.Changes)
+		assert.Equal(t, []string{"bridge-c"}, diff.AddedBridges)
+		assert.Equal(t, []string{"bridge-b"}, diff.RemovedBridges)
+		assert.False(t, diff.OnlyObservationSourceChanged())
+	})
+
+	t.Run("invalid specs", func(t *testing.T) {
+		_, err := feeds.DiffSpecs(running, "name = ")
+		require.ErrorContains(t, err, "failed to parse proposed spec")
+
+		_, err = feeds.DiffSpecs(running, `observationSource = "ds1 [type=bridge"`)
+		require.ErrorContains(t, err, "failed to parse the observationSource of the proposed spec")
+	})
+}
@@ -46,6 +46,7 @@ type functionsConnectorHandler struct {
 	listener                   FunctionsListener
 	offchainTransmitter        OffchainTransmitter
 	allowedHeartbeatInitiators map[string]struct{}
+	allowedSecretsAdmins       map[string]struct{}
 	heartbeatRequests          map[RequestID]*HeartbeatResponse
 	requestTimeoutSec          uint32
 	orderedRequests            []RequestID
@@ -82,6 +83,10 @@ func NewFunctionsConnectorHandler(pluginConfig *config.PluginConfig, signerKey *
 	for _, initiator := range pluginConfig.AllowedHeartbeatInitiators {
 		allowedHeartbeatInitiators[strings.ToLower(initiator)] = struct{}{}
 	}
+	allowedSecretsAdmins := make(map[string]struct{})
+	for _, admin := range pluginConfig.AllowedSecretsAdmins {
+		allowedSecretsAdmins[strings.ToLower(admin)] = struct{}{}
+	}
 	return &functionsConnectorHandler{
 		nodeAddress:                pluginConfig.GatewayConnectorConfig.NodeAddress,
 		signerKey:                  signerKey,
@@ -93,6 +98,7 @@ func NewFunctionsConnectorHandler(pluginConfig *config.PluginConfig, signerKey *
 		listener:                   listener,
 		offchainTransmitter:        offchainTransmitter,
 		allowedHeartbeatInitiators: allowedHeartbeatInitiators,
+		allowedSecretsAdmins:       allowedSecretsAdmins,
 		heartbeatRequests:          make(map[RequestID]*HeartbeatResponse),
 		requestTimeoutSec:          pluginConfig.RequestTimeoutSec,
 		chStop:                     make(services.StopChan),
@@ -124,7 +130,9 @@ func (h *functionsConnectorHandler) HandleGatewayMessage(ctx context.Context, ga
 	switch body.Method {
 	case functions.MethodSecretsList:
 		h.handleSecretsList(ctx, gatewayId, body, fromAddr)
-	case functions.MethodSecretsSet:
+	case functions.MethodSecretsListRange:
+		h.handleSecretsListRange(ctx, gatewayId, body)
+	case functions.MethodSecretsSet, functions.MethodSecretsSetBatch:
 		if balance, err := h.subscriptions.GetMaxUserBalance(fromAddr); err != nil || balance.Cmp(h.minimumBalance.ToInt()) < 0 {
 			h.lggr.Errorw("user subscription has insufficient balance", "id", gatewayId, "address", fromAddr, "balance", balance, "minBalance", h.minimumBalance)
 			response := functions.ResponseBase{
@@ -134,7 +142,11 @@ func (h *functionsConnectorHandler) HandleGatewayMessage(ctx context.Context, ga
 			h.sendResponseAndLog(ctx, gatewayId, body, response)
 			return
 		}
-		h.handleSecretsSet(ctx, gatewayId, body, fromAddr)
+		if body.Method == functions.MethodSecretsSetBatch {
+			h.handleSecretsSetBatch(ctx, gatewayId, body, fromAddr)
+		} else {
+			h.handleSecretsSet(ctx, gatewayId, body, fromAddr)
+		}
 	case functions.MethodHeartbeat:
 		h.handleHeartbeat(ctx, gatewayId, body, fromAddr)
 	default:
@@ -185,6 +197,51 @@ func (h *functionsConnectorHandler) handleSecretsList(ctx context.Context, gatew
 	h.sendResponseAndLog(ctx, gatewayId, body, response)
 }
 
+func (h *functionsConnectorHandler) handleSecretsListRange(ctx context.Context, gatewayId string, body *api.MessageBody) {
+	var response functions.SecretsListRangeResponse
+	if _, ok := h.allowedSecretsAdmins[strings.ToLower(body.Sender)]; !ok {
+		h.lggr.Errorw("sender not allowed to list secrets of other users", "id", gatewayId, "sender", body.Sender)
+		response.ErrorMessage = "sender not allowed to list secrets of other users"
+		h.sendResponseAndLog(ctx, gatewayId, body, response)
+		return
+	}
+	var request functions.SecretsListRangeRequest
+	err := json.Unmarshal(body.Payload, &request)
+	if err != nil {
+		response.ErrorMessage = fmt.Sprintf("Bad request to list secrets: %v", err)
+		h.sendResponseAndLog(ctx, gatewayId, body, response)
+		return
+	}
+	addressRange, err := s4.NewAddressPrefixRange(request.AddressPrefix)
+	if err != nil {
+		response.ErrorMessage = fmt.Sprintf("Bad request to list secrets: %v", err)
+		h.sendResponseAndLog(ctx, gatewayId, body, response)
+		return
+	}
+	snapshot, err := h.storage.ListRange(ctx, s4.ListFilter{
+		AddressRange: addressRange,
+		MinVersion:   request.MinVersion,
+		MaxVersion:   request.MaxVersion,
+	})
+	if err == nil {
+		response.Success = true
+		response.Rows = make([]functions.SecretsListRangeRow, len(snapshot))
+		for i, row := range snapshot {
+			response.Rows[i] = functions.SecretsListRangeRow{
+				Address:     ethCommon.BigToAddress(row.Address.ToInt()).Hex(),
+				SlotID:      row.SlotId,
+				Version:     row.Version,
+				Expiration:  row.Expiration,
+				Confirmed:   row.Confirmed,
+				PayloadSize: row.PayloadSize,
+			}
+		}
+	} else {
+		response.ErrorMessage = fmt.Sprintf("Failed to list secrets: %v", err)
+	}
+	h.sendResponseAndLog(ctx, gatewayId, body, response)
+}
+
 func (h *functionsConnectorHandler) handleSecretsSet(ctx context.Context, gatewayId string, body *api.MessageBody, fromAddr ethCommon.Address) {
 	var request functions.SecretsSetRequest
 	var response functions.SecretsSetResponse
@@ -213,6 +270,36 @@ func (h *functionsConnectorHandler) handleSecretsSet(ctx context.Context, gatewa
 	h.sendResponseAndLog(ctx, gatewayId, body, response)
 }
 
+func (h *functionsConnectorHandler) handleSecretsSetBatch(ctx context.Context, gatewayId string, body *api.MessageBody, fromAddr ethCommon.Address) {
+	var request functions.SecretsSetBatchRequest
+	var response functions.SecretsSetResponse
+	err := json.Unmarshal(body.Payload, &request)
+	if err == nil {
+		entries := make([]*s4.BatchEntry, len(request.Entries))
+		for i, entry := range request.Entries {
+			entries[i] = &s4.BatchEntry{
+				SlotId:  entry.SlotID,
+				Version: entry.Version,
+				Record: s4.Record{
+					Expiration: entry.Expiration,
+					Payload:    entry.Payload,
+				},
+			}
+		}
+		h.lggr.Debugw("handling a secrets_set_batch request", "address", fromAddr, "nEntries", len(entries))
+		err = h.storage.PutBatch(ctx, fromAddr, entries, request.Signature)
+		if err == nil {
+			response.Success = true
+			promStorageUserUpdatesCount.WithLabelValues().Add(float64(len(entries)))
+		} else {
+			response.ErrorMessage = fmt.Sprintf("Failed to set secrets: %v", err)
+		}
+	} else {
+		response.ErrorMessage = fmt.Sprintf("Bad request to set secrets: %v", err)
+	}
+	h.sendResponseAndLog(ctx, gatewayId, body, response)
+}
+
 func (h *functionsConnectorHandler) handleHeartbeat(ctx context.Context, gatewayId string, requestBody *api.MessageBody, fromAddr ethCommon.Address) {
 	var request *OffchainRequest
 	err := json.Unmarshal(requestBody.Payload, &request)
@@ -14,6 +14,7 @@ import (
 	"github.com/onsi/gomega"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/functions"
@@ -86,6 +87,7 @@ func TestFunctionsConnectorHandler(t *testing.T) {
 		MinimumSubscriptionBalance: *assets.NewLinkFromJuels(100),
 		RequestTimeoutSec:          1_000,
 		AllowedHeartbeatInitiators: []string{crypto.PubkeyToAddress(privateKey.PublicKey).Hex()},
+		AllowedSecretsAdmins:       []string{addr.Hex()},
 	}
 	handler, err := functions.NewFunctionsConnectorHandler(config, privateKey, storage, allowlist, rateLimiter, subscriptions, listener, offchainTransmitter, logger)
 	require.NoError(t, err)
@@ -152,6 +154,75 @@ func TestFunctionsConnectorHandler(t *testing.T) {
 			})
 		})
 
+		t.Run("secrets_list_range", func(t *testing.T) {
+			msg := api.Message{
+				Body: api.MessageBody{
+					DonId:     "fun4",
+					MessageId: "1",
+					Method:    "secrets_list_range",
+					Sender:    addr.Hex(),
+					Payload:   json.RawMessage(`{"address_prefix":"EjQ=","min_version":2}`),
+				},
+			}
+			require.NoError(t, msg.Sign(privateKey))
+
+			ctx := testutils.Context(t)
+			addressRange, err := s4.NewAddressPrefixRange([]byte{0x12, 0x34})
+			require.NoError(t, err)
+			owner := geth_common.HexToAddress("0x1234000000000000000000000000000000000001")
+			snapshot := []*s4.SnapshotRow{
+				{Address: ubig.New(owner.Big()), SlotId: 1, Version: 3, Expiration: 4, Confirmed: true, PayloadSize: 5},
+			}
+			storage.On("ListRange", ctx, s4.ListFilter{AddressRange: addressRange, MinVersion: 2}).Return(snapshot, nil).Once()
+			allowlist.On("Allow", addr).Return(true).Once()
+			connector.On("SendToGateway", ctx, "gw1", mock.Anything).Run(func(args mock.Arguments) {
+				msg, ok := args[2].(*api.Message)
+				require.True(t, ok)
+				require.Equal(t, `{"success":true,"rows":[{"address":"`+owner.Hex()+`","slot_id":1,"version":3,"expiration":4,"confirmed":true,"payload_size":5}]}`, string(msg.Body.Payload))
+			}).Return(nil).Once()
+
+			handler.HandleGatewayMessage(ctx, "gw1", &msg)
+
+			t.Run("orm error", func(t *testing.T) {
+				storage.On("ListRange", ctx, mock.Anything).Return(nil, errors.New("boom")).Once()
+				allowlist.On("Allow", addr).Return(true).Once()
+				connector.On("SendToGateway", ctx, "gw1", mock.Anything).Run(func(args mock.Arguments) {
+					msg, ok := args[2].(*api.Message)
+					require.True(t, ok)
+					require.Equal(t, `{"success":false,"error_message":"Failed to list secrets: boom"}`, string(msg.Body.Payload))
+				}).Return(nil).Once()
+
+				handler.HandleGatewayMessage(ctx, "gw1", &msg)
+			})
+
+			t.Run("not an admin", func(t *testing.T) {
+				user := testutils.NewAddress()
+				userMsg := msg
+				userMsg.Body.Sender = user.Hex()
+				allowlist.On("Allow", user).Return(true).Once()
+				connector.On("SendToGateway", ctx, "gw1", mock.Anything).Run(func(args mock.Arguments) {
+					msg, ok := args[2].(*api.Message)
+					require.True(t, ok)
+					require.Equal(t, `{"success":false,"error_message":"sender not allowed to list secrets of other users"}`, string(msg.Body.Payload))
+				}).Return(nil).Once()
+
+				handler.HandleGatewayMessage(ctx, "gw1", &userMsg)
+			})
+
+			t.Run("invalid prefix", func(t *testing.T) {
+				badMsg := msg
+				badMsg.Body.Payload = json.RawMessage(`{"address_prefix":"` + base64.StdEncoding.EncodeToString(make([]byte, 21)) + `"}`)
+				allowlist.On("Allow", addr).Return(true).Once()
+				connector.On("SendToGateway", ctx, "gw1", mock.Anything).Run(func(args mock.Arguments) {
+					msg, ok := args[2].(*api.Message)
+					require.True(t, ok)
+					require.Equal(t, `{"success":false,"error_message":"Bad request to list secrets: invalid address prefix"}`, string(msg.Body.Payload))
+				}).Return(nil).Once()
+
+				handler.HandleGatewayMessage(ctx, "gw1", &badMsg)
+			})
+		})
+
 		t.Run("secrets_set", func(t *testing.T) {
 			ctx := testutils.Context(t)
 			key := s4.Key{
@@ -244,6 +315,67 @@ func TestFunctionsConnectorHandler(t *testing.T) {
 			})
 		})
 
+		t.Run("secrets_set_batch", func(t *testing.T) {
+			ctx := testutils.Context(t)
+			entries := []*s4.BatchEntry{
+				{SlotId: 1, Version: 4, Record: s4.Record{Expiration: 5, Payload: []byte("foo")}},
+				{SlotId: 2, Version: 4, Record: s4.Record{Expiration: 5, Payload: []byte("bar")}},
+			}
+			signature, err := s4.NewBatchEnvelope(addr, entries).Sign(privateKey)
+			require.NoError(t, err)
+			signatureB64 := base64.StdEncoding.EncodeToString(signature)
+
+			msg := api.Message{
+				Body: api.MessageBody{
+					DonId:     "fun4",
+					MessageId: "1",
+					Method:    "secrets_set_batch",
+					Sender:    addr.Hex(),
+					Payload: json.RawMessage(`{"entries":[` +
+						`{"slot_id":1,"version":4,"expiration":5,"payload":"Zm9v"},` +
+						`{"slot_id":2,"version":4,"expiration":5,"payload":"YmFy"}` +
+						`],"signature":"` + signatureB64 + `"}`),
+				},
+			}
+			require.NoError(t, msg.Sign(privateKey))
+
+			storage.On("PutBatch", ctx, addr, entries, signature).Return(nil).Once()
+			allowlist.On("Allow", addr).Return(true).Once()
+			subscriptions.On("GetMaxUserBalance", mock.Anything).Return(big.NewInt(100), nil).Once()
+			connector.On("SendToGateway", ctx, "gw1", mock.Anything).Run(func(args mock.Arguments) {
+				msg, ok := args[2].(*api.Message)
+				require.True(t, ok)
+				require.Equal(t, `{"success":true}`, string(msg.Body.Payload))
+			}).Return(nil).Once()
+
+			handler.HandleGatewayMessage(ctx, "gw1", &msg)
+
+			t.Run("storage error", func(t *testing.T) {
+				storage.On("PutBatch", ctx, mock.Anything, mock.Anything, mock.Anything).Return(s4.ErrBatchTooBig).Once()
+				allowlist.On("Allow", addr).Return(true).Once()
+				subscriptions.On("GetMaxUserBalance", mock.Anything).Return(big.NewInt(100), nil).Once()
+				connector.On("SendToGateway", ctx, "gw1", mock.Anything).Run(func(args mock.Arguments) {
+					msg, ok := args[2].(*api.Message)
+					require.True(t, ok)
+					require.Equal(t, `{"success":false,"error_message":"Failed to set secrets: batch is too big"}`, string(msg.Body.Payload))
+				}).Return(nil).Once()
+
+				handler.HandleGatewayMessage(ctx, "gw1", &msg)
+			})
+
+			t.Run("insufficient balance", func(t *testing.T) {
+				allowlist.On("Allow", addr).Return(true).Once()
+				subscriptions.On("GetMaxUserBalance", mock.Anything).Return(big.NewInt(0), nil).Once()
+				connector.On("SendToGateway", ctx, "gw1", mock.Anything).Run(func(args mock.Arguments) {
+					msg, ok := args[2].(*api.Message)
+					require.True(t, ok)
+					require.Equal(t, `{"success":false,"error_message":"user subscription has insufficient balance"}`, string(msg.Body.Payload))
+				}).Return(nil).Once()
+
+				handler.HandleGatewayMessage(ctx, "gw1", &msg)
+			})
+		})
+
 		t.Run("unsupported method", func(t *testing.T) {
 			msg := api.Message{
 				Body: api.MessageBody{
@@ -10,6 +10,7 @@ const (
 	RequestTimeoutError
 	NodeReponseEncodingError
 	FatalError
+	QuotaExceededError
 )
 
 func (e ErrorCode) String() string {
@@ -28,6 +29,8 @@ func (e ErrorCode) String() string {
 		return "NodeReponseEncodingError"
 	case FatalError:
 		return "FatalError"
+	case QuotaExceededError:
+		return "QuotaExceededError"
 	default:
 		return "UnknownError"
 	}
@@ -43,6 +46,7 @@ func ToJsonRPCErrorCode(errorCode ErrorCode) int {
 		RequestTimeoutError:      -32000, // Server Error
 		NodeReponseEncodingError: -32603, // Internal Error
 		FatalError:               -32000, // Server Error
+		QuotaExceededError:       -32005, // Limit Exceeded (EIP-1474)
 	}
 
 	code, ok := gatewayErrorToJsonRPCError[errorCode]
@@ -62,6 +66,7 @@ func ToHttpErrorCode(errorCode ErrorCode) int {
 		RequestTimeoutError:      504, // Gateway Timeout
 		NodeReponseEncodingError: 500, // Internal Server Error
 		FatalError:               500, // Internal Server Error
+		QuotaExceededError:       429, // Too Many Requests
 	}
 
 	code, ok := gatewayErrorToHttpError[errorCode]
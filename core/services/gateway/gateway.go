@@ -3,6 +3,7 @@ package gateway
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -28,9 +29,12 @@ var promRequest = promauto.NewCounterVec(prometheus.CounterOpts{
 	Help: "Metric to track received requests and response codes",
 }, []string{"response_code"})
 
+// progressBufferSize is the number of progress messages buffered for each streaming user request.
+const progressBufferSize = 16
+
 type Gateway interface {
 	job.ServiceCtx
-	gw_net.HTTPRequestHandler
+	gw_net.HTTPStreamingRequestHandler
 
 	GetUserPort() int
 	GetNodePort() int
@@ -128,6 +132,15 @@ func (g *gateway) Close() error {
 
 // Called by the server
 func (g *gateway) ProcessRequest(ctx context.Context, rawRequest []byte) (rawResponse []byte, httpStatusCode int) {
+	return g.processRequest(ctx, rawRequest, nil)
+}
+
+// Called by the server for users who requested a streaming response
+func (g *gateway) ProcessStreamingRequest(ctx context.Context, rawRequest []byte, sendProgress func(rawProgress []byte)) (rawResponse []byte, httpStatusCode int) {
+	return g.processRequest(ctx, rawRequest, sendProgress)
+}
+
+func (g *gateway) processRequest(ctx context.Context, rawRequest []byte, sendProgress func(rawProgress []byte)) (rawResponse []byte, httpStatusCode int) {
 	// decode
 	msg, err := g.codec.DecodeRequest(rawRequest)
 	if err != nil {
@@ -145,18 +158,39 @@ func (g *gateway) ProcessRequest(ctx context.Context, rawRequest []byte) (rawRes
 		return newError(g.codec, msg.Body.MessageId, api.UnsupportedDONIdError, "unsupported DON ID")
 	}
 	// send to the handler
-	responseCh := make(chan handlers.UserCallbackPayload, 1)
+	// without room for progress messages, handlers only send the final response
+	responseChSize := 1
+	if sendProgress != nil {
+		responseChSize = progressBufferSize + 1
+	}
+	responseCh := make(chan handlers.UserCallbackPayload, responseChSize)
 	err = handler.HandleUserMessage(ctx, msg, responseCh)
 	if err != nil {
+		var userErr *handlers.UserError
+		if errors.As(err, &userErr) {
+			return newErrorWithData(g.codec, msg.Body.MessageId, userErr.ErrCode, userErr.ErrMsg, userErr.ErrData)
+		}
 		return newError(g.codec, msg.Body.MessageId, api.HandlerError, err.Error())
 	}
 	// await response
 	var response handlers.UserCallbackPayload
-	select {
-	case <-ctx.Done():
-		return newError(g.codec, msg.Body.MessageId, api.RequestTimeoutError, "handler timeout")
-	case response = <-responseCh:
-		break
+	for {
+		select {
+		case <-ctx.Done():
+			return newError(g.codec, msg.Body.MessageId, api.RequestTimeoutError, "handler timeout")
+		case response = <-responseCh:
+		}
+		if !response.Progress {
+			break
+		}
+		if sendProgress != nil {
+			rawProgress, err := g.codec.EncodeResponse(response.Msg)
+			if err != nil {
+				g.lggr.Debugw("failed to encode progress message", "messageId", msg.Body.MessageId, "err", err)
+				continue
+			}
+			sendProgress(rawProgress)
+		}
 	}
 	if response.ErrCode != api.NoError {
 		return newError(g.codec, msg.Body.MessageId, response.ErrCode, response.ErrMsg)
@@ -171,7 +205,11 @@ func (g *gateway) ProcessRequest(ctx context.Context, rawRequest []byte) (rawRes
 }
 
 func newError(codec api.Codec, id string, errCode api.ErrorCode, errMsg string) ([]byte, int) {
-	rawResponse, err := codec.EncodeNewErrorResponse(id, api.ToJsonRPCErrorCode(errCode), errMsg, nil)
+	return newErrorWithData(codec, id, errCode, errMsg, nil)
+}
+
+func newErrorWithData(codec api.Codec, id string, errCode api.ErrorCode, errMsg string, errData []byte) ([]byte, int) {
+	rawResponse, err := codec.EncodeNewErrorResponse(id, api.ToJsonRPCErrorCode(errCode), errMsg, errData)
 	if err != nil {
 		// we're not even able to encode a valid JSON response
 		promRequest.WithLabelValues(api.FatalError.String()).Inc()
@@ -220,6 +220,38 @@ func TestGateway_ProcessRequest_HandlerResponse(t *testing.T) {
 	require.Equal(t, 200, statusCode)
 }
 
+func TestGateway_ProcessStreamingRequest_HandlerProgress(t *testing.T) {
+	t.Parallel()
+
+	gw, handler := newGatewayWithMockHandler(t)
+	handler.On("HandleUserMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		msg := args.Get(1).(*api.Message)
+		callbackCh := args.Get(2).(chan<- handlers.UserCallbackPayload)
+		msg.Signature = ""
+		for i := 0; i < 2; i++ {
+			progress := *msg
+			progress.Body.Payload = []byte(fmt.Sprintf(`{"progress":%d}`, i))
+			callbackCh <- handlers.UserCallbackPayload{Msg: &progress, ErrCode: api.NoError, Progress: true}
+		}
+		msg.Body.Payload = []byte(`{"result":"OK"}`)
+		callbackCh <- handlers.UserCallbackPayload{Msg: msg, ErrCode: api.NoError, ErrMsg: ""}
+	})
+
+	req := newSignedRequest(t, "abcd", "request", "testDON", []byte{})
+	var progress []string
+	response, statusCode := gw.ProcessStreamingRequest(testutils.Context(t), req, func(rawProgress []byte) {
+		progress = append(progress, string(rawProgress))
+	})
+	require.Len(t, progress, 2)
+	for i, rawProgress := range progress {
+		requireJsonRPCResult(t, []byte(rawProgress), "abcd",
+			fmt.Sprintf(`{"signature":"","body":{"message_id":"abcd","method":"request","don_id":"testDON","receiver":"","payload":{"progress":%d}}}`, i))
+	}
+	requireJsonRPCResult(t, response, "abcd",
+		`{"signature":"","body":{"message_id":"abcd","method":"request","don_id":"testDON","receiver":"","payload":{"result":"OK"}}}`)
+	require.Equal(t, 200, statusCode)
+}
+
 func TestGateway_ProcessRequest_HandlerTimeout(t *testing.T) {
 	t.Parallel()
 
@@ -245,3 +277,19 @@ func TestGateway_ProcessRequest_HandlerError(t *testing.T) {
 	requireJsonRPCError(t, response, "abcd", -32600, "failure")
 	require.Equal(t, 400, statusCode)
 }
+
+func TestGateway_ProcessRequest_HandlerUserError(t *testing.T) {
+	t.Parallel()
+
+	gw, handler := newGatewayWithMockHandler(t)
+	handler.On("HandleUserMessage", mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("wrapped: %w", &handlers.UserError{
+		ErrCode: api.QuotaExceededError,
+		ErrMsg:  "quota exceeded",
+		ErrData: []byte(`{"requests_used":10}`),
+	}))
+
+	req := newSignedRequest(t, "abcd", "request", "testDON", []byte{})
+	response, statusCode := gw.ProcessRequest(testutils.Context(t), req)
+	require.Equal(t, `{"jsonrpc":"2.0","id":"abcd","error":{"code":-32005,"message":"quota exceeded","data":{"requests_used":10}}}`, string(response))
+	require.Equal(t, 429, statusCode)
+}
@@ -9,12 +9,14 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/forwarding"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/functions"
 )
 
 const (
-	FunctionsHandlerType HandlerType = "functions"
-	DummyHandlerType     HandlerType = "dummy"
+	FunctionsHandlerType  HandlerType = "functions"
+	ForwardingHandlerType HandlerType = "forwarding"
+	DummyHandlerType      HandlerType = "dummy"
 )
 
 type handlerFactory struct {
@@ -33,6 +35,8 @@ func (hf *handlerFactory) NewHandler(handlerType HandlerType, handlerConfig json
 	switch handlerType {
 	case FunctionsHandlerType:
 		return functions.NewFunctionsHandlerFromConfig(handlerConfig, donConfig, don, hf.legacyChains, hf.ds, hf.lggr)
+	case ForwardingHandlerType:
+		return forwarding.NewForwardingHandlerFromConfig(handlerConfig, donConfig, don, hf.lggr)
 	case DummyHandlerType:
 		return handlers.NewDummyHandler(donConfig, don, hf.lggr)
 	default:
@@ -0,0 +1,143 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+)
+
+var (
+	ErrQuotaExceeded = errors.New("quota exceeded")
+
+	promQuotaRequestsUsed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gateway_user_quota_requests_used",
+		Help: "Metric to track the number of requests counted against the daily quotas of users",
+	}, []string{"don_id"})
+
+	promQuotaBytesUsed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gateway_user_quota_bytes_used",
+		Help: "Metric to track the payload bytes counted against the daily quotas of users",
+	}, []string{"don_id"})
+
+	promQuotaRefunded = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gateway_user_quota_refunded",
+		Help: "Metric to track requests refunded to the quotas of their users because they could not be processed",
+	}, []string{"don_id"})
+
+	promQuotaExceeded = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gateway_user_quota_exceeded",
+		Help: "Metric to track requests rejected because the quota of their user was exceeded",
+	}, []string{"don_id"})
+)
+
+// Daily quotas of each user. Not specifying a limit, or setting it to 0, disables it.
+type QuotaConfig struct {
+	RequestsPerDay int64 `json:"requestsPerDay"`
+	BytesPerDay    int64 `json:"bytesPerDay"`
+}
+
+// QuotaStatus is sent to users in the data of the JSON-RPC error when their quota is exceeded.
+type QuotaStatus struct {
+	RequestsUsed  int64 `json:"requests_used"`
+	RequestsLimit int64 `json:"requests_limit"`
+	BytesUsed     int64 `json:"bytes_used"`
+	BytesLimit    int64 `json:"bytes_limit"`
+	// Unix timestamp in seconds at which the quota is reset
+	ResetAt int64 `json:"reset_at"`
+}
+
+// Quotas enforces the daily quotas of the users of a DON. Usage is stored in the database,
+// so that quotas are shared by gateway replicas and persisted across restarts.
+// Days start at midnight UTC.
+type Quotas struct {
+	config    QuotaConfig
+	orm       QuotaORM
+	donID     string
+	clock     clockwork.Clock
+	lggr      logger.Logger
+	mu        sync.Mutex
+	purgedDay time.Time
+}
+
+func NewQuotas(config QuotaConfig, orm QuotaORM, donID string, clock clockwork.Clock, lggr logger.Logger) (*Quotas, error) {
+	if config.RequestsPerDay < 0 || config.BytesPerDay < 0 {
+		return nil, errors.New("quota values must not be negative")
+	}
+	if orm == nil {
+		return nil, errors.New("quotas require a database")
+	}
+	return &Quotas{
+		config: config,
+		orm:    orm,
+		donID:  donID,
+		clock:  clock,
+		lggr:   lggr,
+	}, nil
+}
+
+// Consume records a request of sender with a payload of the given size. It returns a *handlers.UserError
+// carrying the QuotaStatus of sender if the request exceeds its quota.
+func (q *Quotas) Consume(ctx context.Context, sender string, bytes int) error {
+	day := q.clock.Now().UTC().Truncate(24 * time.Hour)
+	q.purgeBefore(ctx, day)
+
+	usage, ok, err := q.orm.ConsumeQuota(ctx, q.donID, sender, day, int64(bytes), q.config)
+	if err != nil {
+		return err
+	}
+	if ok {
+		promQuotaRequestsUsed.WithLabelValues(q.donID).Inc()
+		promQuotaBytesUsed.WithLabelValues(q.donID).Add(float64(bytes))
+		return nil
+	}
+
+	promQuotaExceeded.WithLabelValues(q.donID).Inc()
+	status, err := json.Marshal(QuotaStatus{
+		RequestsUsed:  usage.Requests,
+		RequestsLimit: q.config.RequestsPerDay,
+		BytesUsed:     usage.Bytes,
+		BytesLimit:    q.config.BytesPerDay,
+		ResetAt:       day.Add(24 * time.Hour).Unix(),
+	})
+	if err != nil {
+		return err
+	}
+	return &handlers.UserError{ErrCode: api.QuotaExceededError, ErrMsg: ErrQuotaExceeded.Error(), ErrData: status}
+}
+
+// Refund gives back a request of sender consumed by Consume, when the request could not be processed.
+// A refund racing with the change of day is applied to the new day.
+func (q *Quotas) Refund(ctx context.Context, sender string, bytes int) error {
+	day := q.clock.Now().UTC().Truncate(24 * time.Hour)
+	if err := q.orm.RefundQuota(ctx, q.donID, sender, day, int64(bytes)); err != nil {
+		return err
+	}
+	promQuotaRefunded.WithLabelValues(q.donID).Inc()
+	return nil
+}
+
+// purgeBefore deletes the usage of past days, once per day. The usage of the previous day is kept
+// for requests racing with the change of day.
+func (q *Quotas) purgeBefore(ctx context.Context, day time.Time) {
+	q.mu.Lock()
+	if !q.purgedDay.Before(day) {
+		q.mu.Unlock()
+		return
+	}
+	q.purgedDay = day
+	q.mu.Unlock()
+
+	if err := q.orm.PurgeQuotaUsage(ctx, day.Add(-24*time.Hour)); err != nil {
+		q.lggr.Warnw("failed to purge the quota usage of past days", "err", err)
+	}
+}
@@ -0,0 +1,99 @@
+package common
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// QuotaUsage is the usage of a sender on a given day.
+type QuotaUsage struct {
+	Requests int64 `db:"requests"`
+	Bytes    int64 `db:"bytes"`
+}
+
+// QuotaORM stores the daily usage of senders in the database, so that it is
+// shared by all the gateways of a DON and persisted across restarts.
+type QuotaORM interface {
+	// ConsumeQuota atomically adds a request of the given size to the usage of
+	// sender on day, unless it would exceed the limits of cfg. It returns the
+	// usage after the request, and whether the request was within the limits.
+	ConsumeQuota(ctx context.Context, donID string, sender string, day time.Time, bytes int64, cfg QuotaConfig) (QuotaUsage, bool, error)
+	// RefundQuota removes a request of the given size from the usage of sender
+	// on day. The usage never goes below zero.
+	RefundQuota(ctx context.Context, donID string, sender string, day time.Time, bytes int64) error
+	// PurgeQuotaUsage deletes the usage of the days before the given day.
+	PurgeQuotaUsage(ctx context.Context, before time.Time) error
+}
+
+type quotaORM struct {
+	ds sqlutil.DataSource
+}
+
+var _ QuotaORM = (*quotaORM)(nil)
+
+func NewQuotaORM(ds sqlutil.DataSource) (QuotaORM, error) {
+	if ds == nil {
+		return nil, errors.New("invalid parameters provided to create a gateway quota ORM")
+	}
+	return &quotaORM{ds: ds}, nil
+}
+
+func (o *quotaORM) ConsumeQuota(ctx context.Context, donID string, sender string, day time.Time, bytes int64, cfg QuotaConfig) (usage QuotaUsage, ok bool, err error) {
+	if cfg.BytesPerDay > 0 && bytes > cfg.BytesPerDay {
+		usage, err = o.getQuotaUsage(ctx, donID, sender, day)
+		return usage, false, err
+	}
+	// A zero limit is unlimited. The limits are only checked on conflict, as a
+	// single request is always within them.
+	stmt := `
+		INSERT INTO gateway_user_quotas AS q (don_id, sender, day, requests, bytes, updated_at)
+		VALUES ($1, $2, $3, 1, $4, NOW())
+		ON CONFLICT (don_id, sender, day) DO UPDATE SET
+			requests = q.requests + 1,
+			bytes = q.bytes + EXCLUDED.bytes,
+			updated_at = NOW()
+		WHERE ($5::bigint = 0 OR q.requests + 1 <= $5::bigint) AND ($6::bigint = 0 OR q.bytes + EXCLUDED.bytes <= $6::bigint)
+		RETURNING requests, bytes;
+	`
+	err = o.ds.GetContext(ctx, &usage, stmt, donID, sender, day, bytes, cfg.RequestsPerDay, cfg.BytesPerDay)
+	if errors.Is(err, sql.ErrNoRows) {
+		usage, err = o.getQuotaUsage(ctx, donID, sender, day)
+		return usage, false, err
+	}
+	if err != nil {
+		return usage, false, err
+	}
+	return usage, true, nil
+}
+
+func (o *quotaORM) getQuotaUsage(ctx context.Context, donID string, sender string, day time.Time) (usage QuotaUsage, err error) {
+	stmt := `
+		SELECT requests, bytes
+		FROM gateway_user_quotas
+		WHERE don_id = $1 AND sender = $2 AND day = $3;
+	`
+	err = o.ds.GetContext(ctx, &usage, stmt, donID, sender, day)
+	if errors.Is(err, sql.ErrNoRows) {
+		return QuotaUsage{}, nil
+	}
+	return usage, err
+}
+
+func (o *quotaORM) RefundQuota(ctx context.Context, donID string, sender string, day time.Time, bytes int64) error {
+	stmt := `
+		UPDATE gateway_user_quotas
+		SET requests = GREATEST(requests - 1, 0), bytes = GREATEST(bytes - $4, 0), updated_at = NOW()
+		WHERE don_id = $1 AND sender = $2 AND day = $3;
+	`
+	_, err := o.ds.ExecContext(ctx, stmt, donID, sender, day, bytes)
+	return err
+}
+
+func (o *quotaORM) PurgeQuotaUsage(ctx context.Context, before time.Time) error {
+	_, err := o.ds.ExecContext(ctx, `DELETE FROM gateway_user_quotas WHERE day < $1;`, before)
+	return err
+}
@@ -0,0 +1,114 @@
+package common_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
+)
+
+func requireQuotaExceeded(t *testing.T, err error, expected common.QuotaStatus) {
+	var userErr *handlers.UserError
+	require.True(t, errors.As(err, &userErr))
+	require.Equal(t, api.QuotaExceededError, userErr.ErrCode)
+	require.Equal(t, common.ErrQuotaExceeded.Error(), userErr.ErrMsg)
+	var status common.QuotaStatus
+	require.NoError(t, json.Unmarshal(userErr.ErrData, &status))
+	require.Equal(t, expected, status)
+}
+
+func TestQuotas_Consume(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	orm, err := common.NewQuotaORM(pgtest.NewSqlxDB(t))
+	require.NoError(t, err)
+	start := time.Date(2024, 5, 1, 23, 0, 0, 0, time.UTC)
+	resetAt := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC).Unix()
+	clock := clockwork.NewFakeClockAt(start)
+	config := common.QuotaConfig{RequestsPerDay: 3, BytesPerDay: 100}
+
+	// two gateway replicas sharing the database
+	replica1, err := common.NewQuotas(config, orm, "don1", clock, logger.TestLogger(t))
+	require.NoError(t, err)
+	replica2, err := common.NewQuotas(config, orm, "don1", clock, logger.TestLogger(t))
+	require.NoError(t, err)
+
+	t.Run("shares the quotas between replicas", func(t *testing.T) {
+		require.NoError(t, replica1.Consume(ctx, "user1", 10))
+		require.NoError(t, replica2.Consume(ctx, "user1", 10))
+		require.NoError(t, replica1.Consume(ctx, "user1", 10))
+		requireQuotaExceeded(t, replica2.Consume(ctx, "user1", 10), common.QuotaStatus{
+			RequestsUsed: 3, RequestsLimit: 3, BytesUsed: 30, BytesLimit: 100, ResetAt: resetAt,
+		})
+		// other users and DONs have their own quotas
+		require.NoError(t, replica1.Consume(ctx, "user2", 10))
+		otherDON, err := common.NewQuotas(config, orm, "don2", clock, logger.TestLogger(t))
+		require.NoError(t, err)
+		require.NoError(t, otherDON.Consume(ctx, "user1", 10))
+	})
+
+	t.Run("limits bytes", func(t *testing.T) {
+		requireQuotaExceeded(t, replica1.Consume(ctx, "user3", 101), common.QuotaStatus{
+			RequestsUsed: 0, RequestsLimit: 3, BytesUsed: 0, BytesLimit: 100, ResetAt: resetAt,
+		})
+		require.NoError(t, replica1.Consume(ctx, "user3", 60))
+		requireQuotaExceeded(t, replica1.Consume(ctx, "user3", 41), common.QuotaStatus{
+			RequestsUsed: 1, RequestsLimit: 3, BytesUsed: 60, BytesLimit: 100, ResetAt: resetAt,
+		})
+		require.NoError(t, replica1.Consume(ctx, "user3", 40))
+	})
+
+	t.Run("refunds requests", func(t *testing.T) {
+		require.NoError(t, replica1.Consume(ctx, "user4", 50))
+		require.NoError(t, replica1.Consume(ctx, "user4", 50))
+		require.NoError(t, replica2.Refund(ctx, "user4", 50))
+		require.NoError(t, replica1.Consume(ctx, "user4", 50))
+		requireQuotaExceeded(t, replica1.Consume(ctx, "user4", 1), common.QuotaStatus{
+			RequestsUsed: 2, RequestsLimit: 3, BytesUsed: 100, BytesLimit: 100, ResetAt: resetAt,
+		})
+		// refunds never make the usage negative
+		require.NoError(t, replica1.Refund(ctx, "user5", 10))
+		require.NoError(t, replica1.Refund(ctx, "user4", 1000))
+		require.NoError(t, replica1.Refund(ctx, "user4", 1000))
+		require.NoError(t, replica1.Refund(ctx, "user4", 1000))
+		requireQuotaExceeded(t, replica1.Consume(ctx, "user4", 101), common.QuotaStatus{
+			RequestsUsed: 0, RequestsLimit: 3, BytesUsed: 0, BytesLimit: 100, ResetAt: resetAt,
+		})
+	})
+
+	t.Run("resets the quotas every day", func(t *testing.T) {
+		clock.Advance(time.Hour)
+		require.NoError(t, replica1.Consume(ctx, "user1", 10))
+		require.NoError(t, replica1.Consume(ctx, "user3", 100))
+	})
+
+	t.Run("unlimited quotas", func(t *testing.T) {
+		unlimited, err := common.NewQuotas(common.QuotaConfig{}, orm, "don3", clock, logger.TestLogger(t))
+		require.NoError(t, err)
+		for i := 0; i < 10; i++ {
+			require.NoError(t, unlimited.Consume(ctx, "user1", 1000))
+		}
+	})
+}
+
+func TestQuotas_InvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	orm, err := common.NewQuotaORM(pgtest.NewSqlxDB(t))
+	require.NoError(t, err)
+	_, err = common.NewQuotas(common.QuotaConfig{RequestsPerDay: -1}, orm, "don1", clockwork.NewFakeClock(), logger.TestLogger(t))
+	require.Error(t, err)
+	_, err = common.NewQuotas(common.QuotaConfig{RequestsPerDay: 1}, nil, "don1", clockwork.NewFakeClock(), logger.TestLogger(t))
+	require.Error(t, err)
+}
@@ -21,6 +21,8 @@ type RequestCache[T any] interface {
 
 // If aggregated != nil then the aggregated response is ready and the entry will be deleted from RequestCache.
 // Otherwise, state will be updated to newState and the entry will remain in cache, awaiting more responses from nodes.
+// Progress messages (aggregated.Progress == true) are sent to the user if there is room for them in the callback channel,
+// and also keep the entry in cache.
 type ResponseProcessor[T any] func(response *api.Message, state *T) (aggregated *handlers.UserCallbackPayload, newState *T, err error)
 
 type requestCache[T any] struct {
@@ -39,7 +41,9 @@ type pendingRequest[T any] struct {
 	callbackCh   chan<- handlers.UserCallbackPayload
 	responseData *T
 	timeoutTimer *time.Timer
-	mu           sync.Mutex
+	// done is set once the final response was sent and callbackCh closed
+	done bool
+	mu   sync.Mutex
 }
 
 func NewRequestCache[T any](timeout time.Duration, maxCacheSize uint32) RequestCache[T] {
@@ -93,6 +97,10 @@ func (c *requestCache[T]) ProcessResponse(response *api.Message, process Respons
 	if newResponseData != nil {
 		entry.responseData = newResponseData
 	}
+	if err == nil && aggregated != nil && aggregated.Progress {
+		entry.sendProgress(*aggregated)
+		aggregated = nil
+	}
 	entry.mu.Unlock()
 	if err != nil {
 		return err
@@ -103,6 +111,18 @@ func (c *requestCache[T]) ProcessResponse(response *api.Message, process Respons
 	return nil
 }
 
+// sendProgress sends a progress message without blocking, leaving room for the final response in callbackCh.
+// Must be called under the entry lock.
+func (r *pendingRequest[T]) sendProgress(progress handlers.UserCallbackPayload) {
+	if r.done || len(r.callbackCh) >= cap(r.callbackCh)-1 {
+		return
+	}
+	select {
+	case r.callbackCh <- progress:
+	default:
+	}
+}
+
 func (c *requestCache[T]) deleteAndSendOnce(key globalId, callbackResponse handlers.UserCallbackPayload) {
 	c.mu.Lock()
 	entry, deleted := c.cache[key]
@@ -110,6 +130,9 @@ func (c *requestCache[T]) deleteAndSendOnce(key globalId, callbackResponse handl
 	c.mu.Unlock()
 	if deleted {
 		entry.timeoutTimer.Stop()
+		entry.mu.Lock()
+		entry.done = true
+		entry.mu.Unlock()
 		entry.callbackCh <- callbackResponse
 		close(entry.callbackCh)
 	}
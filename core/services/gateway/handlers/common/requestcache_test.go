@@ -38,6 +38,33 @@ func TestRequestCache_Simple(t *testing.T) {
 	require.Equal(t, "aa", finalResp.Msg.Body.MessageId)
 }
 
+func TestRequestCache_Progress(t *testing.T) {
+	t.Parallel()
+
+	cache := common.NewRequestCache[requestState](time.Hour, 1000)
+	callbackCh := make(chan handlers.UserCallbackPayload, 3)
+
+	req := &api.Message{Body: api.MessageBody{MessageId: "aa", Sender: "0x1234"}}
+	require.NoError(t, cache.NewRequest(req, callbackCh, &requestState{}))
+
+	nodeResp := &api.Message{Body: api.MessageBody{MessageId: "aa", Receiver: "0x1234"}}
+	for i := 0; i < 5; i++ {
+		require.NoError(t, cache.ProcessResponse(nodeResp, func(response *api.Message, responseData *requestState) (aggregated *handlers.UserCallbackPayload, newResponseData *requestState, err error) {
+			responseData.counter++
+			// progress for the first four responses, ready after the fifth one
+			return &handlers.UserCallbackPayload{Msg: response, Progress: responseData.counter < 5}, responseData, nil
+		}))
+	}
+
+	// progress messages which don't leave room for the final response are dropped
+	for i := 0; i < 2; i++ {
+		require.True(t, (<-callbackCh).Progress)
+	}
+	require.False(t, (<-callbackCh).Progress)
+	_, open := <-callbackCh
+	require.False(t, open)
+}
+
 func TestRequestCache_MultiResponse(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,136 @@
+package forwarding
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/shopspring/decimal"
+	"github.com/tidwall/gjson"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+)
+
+// aggregateFunc is called with the responses received so far from the n nodes
+// of a DON tolerating f faulty nodes. It returns the aggregated payload once
+// the responses are sufficient, a nil payload if more responses are needed, or
+// an error once the responses can't be aggregated anymore.
+type aggregateFunc func(responses []*api.Message, n int, f int) (json.RawMessage, error)
+
+func newAggregateFunc(cfg MethodConfig) (aggregateFunc, error) {
+	switch cfg.Aggregation {
+	case AggregationIdentical:
+		return aggregateIdentical, nil
+	case AggregationMedian:
+		return func(responses []*api.Message, n int, f int) (json.RawMessage, error) {
+			return aggregateMedian(responses, n, f, cfg.Field)
+		}, nil
+	case AggregationQuorum:
+		return aggregateQuorum, nil
+	default:
+		return nil, fmt.Errorf("unsupported aggregation %q for method %s", cfg.Aggregation, cfg.Name)
+	}
+}
+
+func aggregateIdentical(responses []*api.Message, n int, f int) (json.RawMessage, error) {
+	counts := make(map[string]int)
+	// the payload of the first response is returned, as formatted by its node
+	firstPayloads := make(map[string]json.RawMessage)
+	maxCount := 0
+	for _, response := range responses {
+		key, err := canonicalJSON(response.Body.Payload)
+		if err != nil {
+			// invalid payloads never match
+			continue
+		}
+		if _, ok := firstPayloads[key]; !ok {
+			firstPayloads[key] = response.Body.Payload
+		}
+		counts[key]++
+		if counts[key] >= f+1 {
+			return firstPayloads[key], nil
+		}
+		if counts[key] > maxCount {
+			maxCount = counts[key]
+		}
+	}
+	if maxCount+n-len(responses) < f+1 {
+		return nil, errors.New("nodes responded with different payloads")
+	}
+	return nil, nil
+}
+
+type medianValue struct {
+	value   decimal.Decimal
+	payload json.RawMessage
+}
+
+func aggregateMedian(responses []*api.Message, n int, f int, field string) (json.RawMessage, error) {
+	required := 2*f + 1
+	if required > n {
+		required = n
+	}
+	var values []medianValue
+	for _, response := range responses {
+		value, err := numericValue(response.Body.Payload, field)
+		if err != nil {
+			continue
+		}
+		values = append(values, medianValue{value, response.Body.Payload})
+	}
+	if len(values) >= required {
+		sort.SliceStable(values, func(i, j int) bool {
+			return values[i].value.LessThan(values[j].value)
+		})
+		return values[len(values)/2].payload, nil
+	}
+	if len(values)+n-len(responses) < required {
+		return nil, errors.New("not enough nodes responded with a numeric value")
+	}
+	return nil, nil
+}
+
+func aggregateQuorum(responses []*api.Message, n int, f int) (json.RawMessage, error) {
+	if len(responses) < f+1 {
+		return nil, nil
+	}
+	return json.Marshal(CombinedResponse{NodeResponses: responses})
+}
+
+// canonicalJSON re-encodes a JSON payload, so that payloads which only differ
+// by formatting or key order are identical.
+func canonicalJSON(payload json.RawMessage) (string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return "", err
+	}
+	canonical, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(canonical), nil
+}
+
+// numericValue extracts the number, or numeric string, at field of a JSON
+// payload.
+func numericValue(payload json.RawMessage, field string) (decimal.Decimal, error) {
+	if !gjson.ValidBytes(payload) {
+		return decimal.Decimal{}, errors.New("invalid JSON payload")
+	}
+	result := gjson.ParseBytes(payload)
+	if field != "" {
+		result = result.Get(field)
+	}
+	switch result.Type {
+	case gjson.Number:
+		return decimal.NewFromString(result.Raw)
+	case gjson.String:
+		return decimal.NewFromString(result.Str)
+	default:
+		return decimal.Decimal{}, fmt.Errorf("value at %q is not a number", field)
+	}
+}
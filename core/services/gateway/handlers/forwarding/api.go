@@ -0,0 +1,55 @@
+package forwarding
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	hc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
+)
+
+type AggregationType string
+
+const (
+	// AggregationIdentical returns the payload once F+1 nodes responded with
+	// identical payloads.
+	AggregationIdentical AggregationType = "identical"
+	// AggregationMedian returns the payload holding the median value once 2F+1
+	// nodes responded with a numeric value, so that the median is bounded by
+	// the values of honest nodes.
+	AggregationMedian AggregationType = "median"
+	// AggregationQuorum returns all the signed node responses once F+1 nodes
+	// responded. It's up to the user to validate them.
+	AggregationQuorum AggregationType = "quorum"
+)
+
+type HandlerConfig struct {
+	// Methods are the user methods forwarded to the DON
+	Methods []MethodConfig `json:"methods"`
+	// Not specifying AllowedSenders allows all senders
+	AllowedSenders []string `json:"allowedSenders"`
+	// Not specifying RateLimiter config disables rate limiting
+	UserRateLimiter      *hc.RateLimiterConfig `json:"userRateLimiter"`
+	NodeRateLimiter      *hc.RateLimiterConfig `json:"nodeRateLimiter"`
+	MaxPendingRequests   uint32                `json:"maxPendingRequests"`
+	RequestTimeoutMillis int64                 `json:"requestTimeoutMillis"`
+}
+
+type MethodConfig struct {
+	Name        string          `json:"name"`
+	Aggregation AggregationType `json:"aggregation"`
+	// Field is the path of the value of the node payloads used by the median
+	// aggregation, in gjson syntax. Not specifying Field uses the whole
+	// payload.
+	Field string `json:"field"`
+}
+
+// Gateway -> User response of the quorum aggregation, which combines the
+// responses from several nodes
+type CombinedResponse struct {
+	NodeResponses []*api.Message `json:"node_responses"`
+}
+
+// Gateway -> User progress message, sent to users who requested a streaming response
+// until the node responses are aggregated
+type ProgressResponse struct {
+	NodeResponses int `json:"node_responses"`
+	Nodes         int `json:"nodes"`
+}
@@ -0,0 +1,236 @@
+package forwarding
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	hc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
+)
+
+var (
+	ErrNotAllowlisted    = errors.New("sender not allowlisted")
+	ErrRateLimited       = errors.New("rate-limited")
+	ErrUnsupportedMethod = errors.New("unsupported method")
+
+	promHandlerError = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gateway_forwarding_handler_error",
+		Help: "Metric to track forwarding handler errors",
+	}, []string{"don_id", "error"})
+
+	promAggregationSuccess = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gateway_forwarding_aggregation_success",
+		Help: "Metric to track successful aggregations of node responses",
+	}, []string{"don_id", "method"})
+
+	promAggregationFailure = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gateway_forwarding_aggregation_failure",
+		Help: "Metric to track failed aggregations of node responses",
+	}, []string{"don_id", "method"})
+)
+
+// forwardingHandler forwards the signed requests of users to all the nodes of
+// a DON, and responds with the aggregate of the node responses. The methods it
+// forwards and how their responses are aggregated are configured, so that
+// DON-backed APIs don't need a handler of their own.
+type forwardingHandler struct {
+	services.StateMachine
+
+	handlerConfig   HandlerConfig
+	donConfig       *config.DONConfig
+	don             handlers.DON
+	methods         map[string]aggregateFunc
+	allowedSenders  map[string]struct{}
+	pendingRequests hc.RequestCache[PendingRequest]
+	userRateLimiter *hc.RateLimiter
+	nodeRateLimiter *hc.RateLimiter
+	lggr            logger.Logger
+}
+
+type PendingRequest struct {
+	request   *api.Message
+	aggregate aggregateFunc
+	responses map[string]*api.Message
+	// received holds the responses in the order they were received
+	received []*api.Message
+}
+
+var _ handlers.Handler = (*forwardingHandler)(nil)
+
+func NewForwardingHandlerFromConfig(handlerConfig json.RawMessage, donConfig *config.DONConfig, don handlers.DON, lggr logger.Logger) (handlers.Handler, error) {
+	var cfg HandlerConfig
+	err := json.Unmarshal(handlerConfig, &cfg)
+	if err != nil {
+		return nil, err
+	}
+	lggr = lggr.Named("ForwardingHandler:" + donConfig.DonId)
+	var userRateLimiter, nodeRateLimiter *hc.RateLimiter
+	if cfg.UserRateLimiter != nil {
+		userRateLimiter, err = hc.NewRateLimiter(*cfg.UserRateLimiter)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cfg.NodeRateLimiter != nil {
+		nodeRateLimiter, err = hc.NewRateLimiter(*cfg.NodeRateLimiter)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pendingRequestsCache := hc.NewRequestCache[PendingRequest](time.Millisecond*time.Duration(cfg.RequestTimeoutMillis), cfg.MaxPendingRequests)
+	return NewForwardingHandler(cfg, donConfig, don, pendingRequestsCache, userRateLimiter, nodeRateLimiter, lggr)
+}
+
+func NewForwardingHandler(
+	cfg HandlerConfig,
+	donConfig *config.DONConfig,
+	don handlers.DON,
+	pendingRequestsCache hc.RequestCache[PendingRequest],
+	userRateLimiter *hc.RateLimiter,
+	nodeRateLimiter *hc.RateLimiter,
+	lggr logger.Logger) (handlers.Handler, error) {
+	if len(cfg.Methods) == 0 {
+		return nil, errors.New("no methods configured")
+	}
+	methods := make(map[string]aggregateFunc)
+	for _, method := range cfg.Methods {
+		if method.Name == "" {
+			return nil, errors.New("method name is empty")
+		}
+		if _, exists := methods[method.Name]; exists {
+			return nil, fmt.Errorf("duplicate method %s", method.Name)
+		}
+		aggregate, err := newAggregateFunc(method)
+		if err != nil {
+			return nil, err
+		}
+		methods[method.Name] = aggregate
+	}
+	var allowedSenders map[string]struct{}
+	if len(cfg.AllowedSenders) > 0 {
+		allowedSenders = make(map[string]struct{})
+		for _, sender := range cfg.AllowedSenders {
+			allowedSenders[strings.ToLower(sender)] = struct{}{}
+		}
+	}
+	return &forwardingHandler{
+		handlerConfig:   cfg,
+		donConfig:       donConfig,
+		don:             don,
+		methods:         methods,
+		allowedSenders:  allowedSenders,
+		pendingRequests: pendingRequestsCache,
+		userRateLimiter: userRateLimiter,
+		nodeRateLimiter: nodeRateLimiter,
+		lggr:            lggr,
+	}, nil
+}
+
+func (h *forwardingHandler) HandleUserMessage(ctx context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload) error {
+	if h.allowedSenders != nil {
+		if _, ok := h.allowedSenders[strings.ToLower(msg.Body.Sender)]; !ok {
+			h.lggr.Debugw("received a message from a non-allowlisted address", "sender", msg.Body.Sender)
+			promHandlerError.WithLabelValues(h.donConfig.DonId, ErrNotAllowlisted.Error()).Inc()
+			return ErrNotAllowlisted
+		}
+	}
+	if h.userRateLimiter != nil && !h.userRateLimiter.Allow(msg.Body.Sender) {
+		h.lggr.Debugw("rate-limited", "sender", msg.Body.Sender)
+		promHandlerError.WithLabelValues(h.donConfig.DonId, ErrRateLimited.Error()).Inc()
+		return ErrRateLimited
+	}
+	aggregate, ok := h.methods[msg.Body.Method]
+	if !ok {
+		h.lggr.Debugw("unsupported method", "method", msg.Body.Method)
+		promHandlerError.WithLabelValues(h.donConfig.DonId, ErrUnsupportedMethod.Error()).Inc()
+		return ErrUnsupportedMethod
+	}
+
+	h.lggr.Debugw("HandleUserMessage: processing message", "sender", msg.Body.Sender, "messageId", msg.Body.MessageId)
+	err := h.pendingRequests.NewRequest(msg, callbackCh, &PendingRequest{request: msg, aggregate: aggregate, responses: make(map[string]*api.Message)})
+	if err != nil {
+		h.lggr.Warnw("HandleUserMessage: error adding new request", "sender", msg.Body.Sender, "err", err)
+		promHandlerError.WithLabelValues(h.donConfig.DonId, err.Error()).Inc()
+		return err
+	}
+	// The signed message of the user is sent as is, so that nodes can
+	// authenticate the user themselves.
+	for _, member := range h.donConfig.Members {
+		err := h.don.SendToNode(ctx, member.Address, msg)
+		if err != nil {
+			h.lggr.Debugw("HandleUserMessage: failed to send to a node", "node", member.Address, "err", err)
+		}
+	}
+	return nil
+}
+
+func (h *forwardingHandler) HandleNodeMessage(ctx context.Context, msg *api.Message, nodeAddr string) error {
+	h.lggr.Debugw("HandleNodeMessage: processing message", "nodeAddr", nodeAddr, "receiver", msg.Body.Receiver, "id", msg.Body.MessageId)
+	if h.nodeRateLimiter != nil && !h.nodeRateLimiter.Allow(nodeAddr) {
+		h.lggr.Debugw("rate-limited", "sender", nodeAddr)
+		return ErrRateLimited
+	}
+	if _, ok := h.methods[msg.Body.Method]; !ok {
+		h.lggr.Debugw("unsupported method", "method", msg.Body.Method)
+		return ErrUnsupportedMethod
+	}
+	return h.pendingRequests.ProcessResponse(msg, h.processResponse)
+}
+
+// Conforms to ResponseProcessor[*PendingRequest]
+func (h *forwardingHandler) processResponse(response *api.Message, responseData *PendingRequest) (*handlers.UserCallbackPayload, *PendingRequest, error) {
+	if _, exists := responseData.responses[response.Body.Sender]; exists {
+		return nil, nil, errors.New("duplicate response")
+	}
+	if response.Body.Method != responseData.request.Body.Method {
+		return nil, responseData, errors.New("invalid method")
+	}
+	responseData.responses[response.Body.Sender] = response
+	responseData.received = append(responseData.received, response)
+
+	payload, err := responseData.aggregate(responseData.received, len(h.donConfig.Members), h.donConfig.F)
+	userResponse := *responseData.request
+	userResponse.Body.Receiver = responseData.request.Body.Sender
+	if err != nil {
+		h.lggr.Debugw("failed to aggregate node responses", "messageId", responseData.request.Body.MessageId, "err", err)
+		promAggregationFailure.WithLabelValues(h.donConfig.DonId, responseData.request.Body.Method).Inc()
+		userResponse.Body.Payload = nil
+		return &handlers.UserCallbackPayload{Msg: &userResponse, ErrCode: api.HandlerError, ErrMsg: err.Error()}, nil, nil
+	}
+	if payload == nil {
+		// not ready to be processed yet, users who requested a streaming response are notified of the progress
+		progress, err := json.Marshal(ProgressResponse{NodeResponses: len(responseData.received), Nodes: len(h.donConfig.Members)})
+		if err != nil {
+			return nil, responseData, nil
+		}
+		userResponse.Body.Payload = progress
+		return &handlers.UserCallbackPayload{Msg: &userResponse, ErrCode: api.NoError, ErrMsg: "", Progress: true}, responseData, nil
+	}
+	promAggregationSuccess.WithLabelValues(h.donConfig.DonId, responseData.request.Body.Method).Inc()
+	userResponse.Body.Payload = payload
+	return &handlers.UserCallbackPayload{Msg: &userResponse, ErrCode: api.NoError, ErrMsg: ""}, nil, nil
+}
+
+func (h *forwardingHandler) Start(context.Context) error {
+	return h.StartOnce("ForwardingHandler", func() error {
+		h.lggr.Info("starting ForwardingHandler")
+		return nil
+	})
+}
+
+func (h *forwardingHandler) Close() error {
+	return h.StopOnce("ForwardingHandler", func() error {
+		return nil
+	})
+}
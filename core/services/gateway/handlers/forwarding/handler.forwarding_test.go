@@ -0,0 +1,269 @@
+package forwarding_test
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	gc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/common"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers"
+	hc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/forwarding"
+	handlers_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/mocks"
+)
+
+var testMethods = []forwarding.MethodConfig{
+	{Name: "get_balance", Aggregation: forwarding.AggregationIdentical},
+	{Name: "get_price", Aggregation: forwarding.AggregationMedian, Field: "result.price"},
+	{Name: "get_report", Aggregation: forwarding.AggregationQuorum},
+}
+
+func newForwardingHandlerForATestDON(t *testing.T, nodes []gc.TestNode, allowedSenders []string) (handlers.Handler, *handlers_mocks.DON) {
+	cfg := forwarding.HandlerConfig{Methods: testMethods, AllowedSenders: allowedSenders}
+	donConfig := &config.DONConfig{
+		DonId:   "don_id",
+		Members: []config.NodeConfig{},
+		F:       1,
+	}
+
+	for id, n := range nodes {
+		donConfig.Members = append(donConfig.Members, config.NodeConfig{
+			Name:    fmt.Sprintf("node_%d", id),
+			Address: n.Address,
+		})
+	}
+
+	don := handlers_mocks.NewDON(t)
+	userRateLimiter, err := hc.NewRateLimiter(hc.RateLimiterConfig{GlobalRPS: 100.0, GlobalBurst: 100, PerSenderRPS: 100.0, PerSenderBurst: 100})
+	require.NoError(t, err)
+	nodeRateLimiter, err := hc.NewRateLimiter(hc.RateLimiterConfig{GlobalRPS: 100.0, GlobalBurst: 100, PerSenderRPS: 100.0, PerSenderBurst: 100})
+	require.NoError(t, err)
+	pendingRequestsCache := hc.NewRequestCache[forwarding.PendingRequest](time.Hour*24, 1000)
+	handler, err := forwarding.NewForwardingHandler(cfg, donConfig, don, pendingRequestsCache, userRateLimiter, nodeRateLimiter, logger.TestLogger(t))
+	require.NoError(t, err)
+	return handler, don
+}
+
+func newSignedMessage(t *testing.T, id string, method string, donId string, privateKey *ecdsa.PrivateKey) api.Message {
+	msg := api.Message{
+		Body: api.MessageBody{
+			MessageId: id,
+			Method:    method,
+			DonId:     donId,
+			Payload:   []byte(`{"account":"0x1234"}`),
+		},
+	}
+	require.NoError(t, msg.Sign(privateKey))
+	return msg
+}
+
+func sendNodeReponses(t *testing.T, handler handlers.Handler, userRequestMsg api.Message, nodes []gc.TestNode, payloads []string) {
+	for id, payload := range payloads {
+		nodeResponseMsg := userRequestMsg
+		nodeResponseMsg.Body.Receiver = userRequestMsg.Body.Sender
+		nodeResponseMsg.Body.Payload = []byte(payload)
+		require.NoError(t, nodeResponseMsg.Sign(nodes[id].PrivateKey))
+		_ = handler.HandleNodeMessage(testutils.Context(t), &nodeResponseMsg, nodes[id].Address)
+	}
+}
+
+// handleUserMessage sends a request of the user to the handler, followed by the responses of the nodes, and returns the response to the user.
+func handleUserMessage(t *testing.T, method string, nodePayloads []string) (api.Message, handlers.UserCallbackPayload) {
+	nodes, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+	handler, don := newForwardingHandlerForATestDON(t, nodes, nil)
+	userRequestMsg := newSignedMessage(t, "1234", method, "don_id", user.PrivateKey)
+
+	callbackCh := make(chan handlers.UserCallbackPayload, 1)
+	for _, node := range nodes {
+		don.On("SendToNode", mock.Anything, node.Address, &userRequestMsg).Return(nil).Once()
+	}
+	require.NoError(t, handler.HandleUserMessage(testutils.Context(t), &userRequestMsg, callbackCh))
+	sendNodeReponses(t, handler, userRequestMsg, nodes, nodePayloads)
+
+	select {
+	case response := <-callbackCh:
+		require.Equal(t, userRequestMsg.Body.MessageId, response.Msg.Body.MessageId)
+		require.Equal(t, userRequestMsg.Body.Sender, response.Msg.Body.Receiver)
+		return userRequestMsg, response
+	default:
+		t.Fatal("no response to the user")
+		return userRequestMsg, handlers.UserCallbackPayload{}
+	}
+}
+
+func TestForwardingHandler_NewForwardingHandlerFromConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		handlerConfig string
+		expectedError string
+	}{
+		{"no methods", `{}`, "no methods configured"},
+		{"empty method name", `{"methods":[{"aggregation":"identical"}]}`, "method name is empty"},
+		{"duplicate method", `{"methods":[{"name":"a","aggregation":"identical"},{"name":"a","aggregation":"quorum"}]}`, "duplicate method a"},
+		{"unsupported aggregation", `{"methods":[{"name":"a","aggregation":"mean"}]}`, `unsupported aggregation "mean" for method a`},
+		{"valid", `{"methods":[{"name":"a","aggregation":"median","field":"price"}],"userRateLimiter":{"globalRPS":10,"globalBurst":10,"perSenderRPS":1,"perSenderBurst":1}}`, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler, err := forwarding.NewForwardingHandlerFromConfig(json.RawMessage(test.handlerConfig), &config.DONConfig{}, nil, logger.TestLogger(t))
+			if test.expectedError != "" {
+				require.ErrorContains(t, err, test.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			servicetest.Run(t, handler)
+		})
+	}
+}
+
+func TestForwardingHandler_HandleUserMessage_Identical(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		nodePayloads    []string
+		expectedErrCode api.ErrorCode
+		expectedPayload string
+	}{
+		{"first two identical", []string{`{"balance":10,"unit":"wei"}`, `{ "unit": "wei", "balance": 10 }`, `{"balance":11}`}, api.NoError, `{"balance":10,"unit":"wei"}`},
+		{"last two identical", []string{`{"balance":11}`, `invalid`, `{"balance":10}`, `{"balance":10}`}, api.NoError, `{"balance":10}`},
+		{"all different", []string{`{"balance":10}`, `{"balance":11}`, `{"balance":12}`, `{"balance":13}`}, api.HandlerError, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, response := handleUserMessage(t, "get_balance", test.nodePayloads)
+			require.Equal(t, test.expectedErrCode, response.ErrCode)
+			if test.expectedErrCode == api.NoError {
+				require.Equal(t, test.expectedPayload, string(response.Msg.Body.Payload))
+			} else {
+				require.Equal(t, "nodes responded with different payloads", response.ErrMsg)
+			}
+		})
+	}
+}
+
+func TestForwardingHandler_HandleUserMessage_Median(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		nodePayloads    []string
+		expectedErrCode api.ErrorCode
+		expectedPayload string
+	}{
+		{"numbers", []string{`{"result":{"price":12}}`, `{"result":{"price":10.5}}`, `{"result":{"price":11}}`}, api.NoError, `{"result":{"price":11}}`},
+		{"numeric strings and an outlier", []string{`{"result":{"price":"1000000"}}`, `{"result":{"price":"10"}}`, `{"result":{}}`, `{"result":{"price":"11"}}`}, api.NoError, `{"result":{"price":"11"}}`},
+		{"not enough values", []string{`{"result":{"price":"NaN"}}`, `{"result":{"price":true}}`}, api.HandlerError, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, response := handleUserMessage(t, "get_price", test.nodePayloads)
+			require.Equal(t, test.expectedErrCode, response.ErrCode)
+			if test.expectedErrCode == api.NoError {
+				require.Equal(t, test.expectedPayload, string(response.Msg.Body.Payload))
+			} else {
+				require.Equal(t, "not enough nodes responded with a numeric value", response.ErrMsg)
+			}
+		})
+	}
+}
+
+func TestForwardingHandler_HandleUserMessage_Quorum(t *testing.T) {
+	t.Parallel()
+
+	userRequestMsg, response := handleUserMessage(t, "get_report", []string{`{"report":"0x01"}`, `{"report":"0x02"}`})
+	require.Equal(t, api.NoError, response.ErrCode)
+	var payload forwarding.CombinedResponse
+	require.NoError(t, json.Unmarshal(response.Msg.Body.Payload, &payload))
+	require.Len(t, payload.NodeResponses, 2)
+	for _, nodeResponse := range payload.NodeResponses {
+		// the signed responses can be validated by the user
+		require.NoError(t, nodeResponse.Validate())
+		require.Equal(t, userRequestMsg.Body.MessageId, nodeResponse.Body.MessageId)
+	}
+}
+
+func TestForwardingHandler_HandleUserMessage_Progress(t *testing.T) {
+	t.Parallel()
+
+	nodes, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+	handler, don := newForwardingHandlerForATestDON(t, nodes, nil)
+	userRequestMsg := newSignedMessage(t, "1234", "get_balance", "don_id", user.PrivateKey)
+
+	// room for progress messages in the channel of a streaming request
+	callbackCh := make(chan handlers.UserCallbackPayload, 10)
+	don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	require.NoError(t, handler.HandleUserMessage(testutils.Context(t), &userRequestMsg, callbackCh))
+	sendNodeReponses(t, handler, userRequestMsg, nodes, []string{`{"balance":10}`, `{"balance":11}`, `{"balance":10}`})
+
+	for i := 1; i <= 2; i++ {
+		response := <-callbackCh
+		require.True(t, response.Progress)
+		var progress forwarding.ProgressResponse
+		require.NoError(t, json.Unmarshal(response.Msg.Body.Payload, &progress))
+		require.Equal(t, forwarding.ProgressResponse{NodeResponses: i, Nodes: 4}, progress)
+	}
+	response := <-callbackCh
+	require.False(t, response.Progress)
+	require.Equal(t, api.NoError, response.ErrCode)
+	require.Equal(t, `{"balance":10}`, string(response.Msg.Body.Payload))
+	_, open := <-callbackCh
+	require.False(t, open)
+}
+
+func TestForwardingHandler_HandleUserMessage_DuplicateResponses(t *testing.T) {
+	t.Parallel()
+
+	nodes, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+	handler, don := newForwardingHandlerForATestDON(t, nodes, nil)
+	userRequestMsg := newSignedMessage(t, "1234", "get_balance", "don_id", user.PrivateKey)
+
+	callbackCh := make(chan handlers.UserCallbackPayload, 1)
+	don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	require.NoError(t, handler.HandleUserMessage(testutils.Context(t), &userRequestMsg, callbackCh))
+
+	nodeResponseMsg := userRequestMsg
+	nodeResponseMsg.Body.Receiver = userRequestMsg.Body.Sender
+	nodeResponseMsg.Body.Payload = []byte(`{"balance":10}`)
+	require.NoError(t, nodeResponseMsg.Sign(nodes[0].PrivateKey))
+	require.NoError(t, handler.HandleNodeMessage(testutils.Context(t), &nodeResponseMsg, nodes[0].Address))
+	require.ErrorContains(t, handler.HandleNodeMessage(testutils.Context(t), &nodeResponseMsg, nodes[0].Address), "duplicate response")
+	require.Empty(t, callbackCh)
+}
+
+func TestForwardingHandler_HandleUserMessage_NotAllowlisted(t *testing.T) {
+	t.Parallel()
+
+	nodes, users := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 2)
+	handler, _ := newForwardingHandlerForATestDON(t, nodes, []string{users[0].Address})
+	userRequestMsg := newSignedMessage(t, "1234", "get_balance", "don_id", users[1].PrivateKey)
+
+	err := handler.HandleUserMessage(testutils.Context(t), &userRequestMsg, make(chan handlers.UserCallbackPayload))
+	require.ErrorIs(t, err, forwarding.ErrNotAllowlisted)
+}
+
+func TestForwardingHandler_HandleUserMessage_InvalidMethod(t *testing.T) {
+	t.Parallel()
+
+	nodes, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+	handler, _ := newForwardingHandlerForATestDON(t, nodes, nil)
+	userRequestMsg := newSignedMessage(t, "1234", "get_private_key", "don_id", user.PrivateKey)
+
+	err := handler.HandleUserMessage(testutils.Context(t), &userRequestMsg, make(chan handlers.UserCallbackPayload))
+	require.ErrorIs(t, err, forwarding.ErrUnsupportedMethod)
+}
@@ -3,9 +3,11 @@ package functions
 import "github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
 
 const (
-	MethodSecretsSet  = "secrets_set"
-	MethodSecretsList = "secrets_list"
-	MethodHeartbeat   = "heartbeat"
+	MethodSecretsSet       = "secrets_set"
+	MethodSecretsSetBatch  = "secrets_set_batch"
+	MethodSecretsList      = "secrets_list"
+	MethodSecretsListRange = "secrets_list_range"
+	MethodHeartbeat        = "heartbeat"
 )
 
 type SecretsSetRequest struct {
@@ -16,8 +18,29 @@ type SecretsSetRequest struct {
 	Signature  []byte `json:"signature"`
 }
 
+// SecretsSetBatchRequest sets several slots at once, with a single signature of their s4.BatchEnvelope.
+type SecretsSetBatchRequest struct {
+	Entries   []SecretsSetBatchEntry `json:"entries"`
+	Signature []byte                 `json:"signature"`
+}
+
+type SecretsSetBatchEntry struct {
+	SlotID     uint   `json:"slot_id"`
+	Version    uint64 `json:"version"`
+	Expiration int64  `json:"expiration"`
+	Payload    []byte `json:"payload"`
+}
+
 // SecretsListRequest has empty payload
 
+// SecretsListRangeRequest lists the secrets of all addresses starting with AddressPrefix,
+// with a version between MinVersion and MaxVersion (inclusive, zero MaxVersion means no maximum).
+type SecretsListRangeRequest struct {
+	AddressPrefix []byte `json:"address_prefix"`
+	MinVersion    uint64 `json:"min_version"`
+	MaxVersion    uint64 `json:"max_version"`
+}
+
 type ResponseBase struct {
 	Success      bool   `json:"success"`
 	ErrorMessage string `json:"error_message,omitempty"`
@@ -38,6 +61,20 @@ type SecretsListRow struct {
 	Expiration int64  `json:"expiration"`
 }
 
+type SecretsListRangeResponse struct {
+	ResponseBase
+	Rows []SecretsListRangeRow `json:"rows,omitempty"`
+}
+
+type SecretsListRangeRow struct {
+	Address     string `json:"address"`
+	SlotID      uint   `json:"slot_id"`
+	Version     uint64 `json:"version"`
+	Expiration  int64  `json:"expiration"`
+	Confirmed   bool   `json:"confirmed"`
+	PayloadSize uint64 `json:"payload_size"`
+}
+
 // Gateway -> User response, which combines responses from several nodes
 type CombinedResponse struct {
 	ResponseBase
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/jonboulle/clockwork"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/multierr"
@@ -71,6 +72,10 @@ type FunctionsHandlerConfig struct {
 	MaxPendingRequests         uint32                `json:"maxPendingRequests"`
 	RequestTimeoutMillis       int64                 `json:"requestTimeoutMillis"`
 	AllowedHeartbeatInitiators []string              `json:"allowedHeartbeatInitiators"`
+	// Only AllowedSecretsAdmins can list the secrets of all users (secrets_list_range)
+	AllowedSecretsAdmins []string `json:"allowedSecretsAdmins"`
+	// Not specifying UserQuota config disables daily quotas
+	UserQuota *hc.QuotaConfig `json:"userQuota"`
 }
 
 type functionsHandler struct {
@@ -85,6 +90,7 @@ type functionsHandler struct {
 	minimumBalance             *assets.Link
 	userRateLimiter            *hc.RateLimiter
 	nodeRateLimiter            *hc.RateLimiter
+	quotas                     *hc.Quotas
 	allowedHeartbeatInitiators map[string]struct{}
 	chStop                     services.StopChan
 	lggr                       logger.Logger
@@ -135,6 +141,20 @@ func NewFunctionsHandlerFromConfig(handlerConfig json.RawMessage, donConfig *con
 			return nil, err
 		}
 	}
+	var quotas *hc.Quotas
+	if cfg.UserQuota != nil {
+		var quotaORM hc.QuotaORM
+		if ds != nil {
+			quotaORM, err = hc.NewQuotaORM(ds)
+			if err != nil {
+				return nil, err
+			}
+		}
+		quotas, err = hc.NewQuotas(*cfg.UserQuota, quotaORM, donConfig.DonId, clockwork.NewRealClock(), lggr)
+		if err != nil {
+			return nil, err
+		}
+	}
 	var subscriptions fsub.OnchainSubscriptions
 	if cfg.OnchainSubscriptions != nil {
 		chain, err2 := legacyChains.Get(cfg.ChainID)
@@ -157,7 +177,7 @@ func NewFunctionsHandlerFromConfig(handlerConfig json.RawMessage, donConfig *con
 		allowedHeartbeatInitiators[strings.ToLower(initiator)] = struct{}{}
 	}
 	pendingRequestsCache := hc.NewRequestCache[PendingRequest](time.Millisecond*time.Duration(cfg.RequestTimeoutMillis), cfg.MaxPendingRequests)
-	return NewFunctionsHandler(cfg, donConfig, don, pendingRequestsCache, allowlist, subscriptions, cfg.MinimumSubscriptionBalance, userRateLimiter, nodeRateLimiter, allowedHeartbeatInitiators, lggr), nil
+	return NewFunctionsHandler(cfg, donConfig, don, pendingRequestsCache, allowlist, subscriptions, cfg.MinimumSubscriptionBalance, userRateLimiter, nodeRateLimiter, quotas, allowedHeartbeatInitiators, lggr), nil
 }
 
 func NewFunctionsHandler(
@@ -170,6 +190,7 @@ func NewFunctionsHandler(
 	minimumBalance *assets.Link,
 	userRateLimiter *hc.RateLimiter,
 	nodeRateLimiter *hc.RateLimiter,
+	quotas *hc.Quotas,
 	allowedHeartbeatInitiators map[string]struct{},
 	lggr logger.Logger) handlers.Handler {
 	return &functionsHandler{
@@ -182,6 +203,7 @@ func NewFunctionsHandler(
 		minimumBalance:             minimumBalance,
 		userRateLimiter:            userRateLimiter,
 		nodeRateLimiter:            nodeRateLimiter,
+		quotas:                     quotas,
 		allowedHeartbeatInitiators: allowedHeartbeatInitiators,
 		chStop:                     make(services.StopChan),
 		lggr:                       lggr,
@@ -200,7 +222,7 @@ func (h *functionsHandler) HandleUserMessage(ctx context.Context, msg *api.Messa
 		promHandlerError.WithLabelValues(h.donConfig.DonId, ErrRateLimited.Error()).Inc()
 		return ErrRateLimited
 	}
-	if msg.Body.Method == MethodSecretsSet && h.subscriptions != nil && h.minimumBalance != nil {
+	if (msg.Body.Method == MethodSecretsSet || msg.Body.Method == MethodSecretsSetBatch) && h.subscriptions != nil && h.minimumBalance != nil {
 		balance, err := h.subscriptions.GetMaxUserBalance(sender)
 		if err != nil {
 			h.lggr.Debugw("error getting max user balance", "sender", msg.Body.Sender, "err", err)
@@ -214,7 +236,14 @@ func (h *functionsHandler) HandleUserMessage(ctx context.Context, msg *api.Messa
 		}
 	}
 	switch msg.Body.Method {
-	case MethodSecretsSet, MethodSecretsList:
+	case MethodSecretsSet, MethodSecretsSetBatch, MethodSecretsList:
+		return h.handleRequest(ctx, msg, callbackCh)
+	case MethodSecretsListRange:
+		if !h.isSecretsAdmin(msg.Body.Sender) {
+			h.lggr.Debugw("received secrets_list_range request from a non-admin sender", "sender", msg.Body.Sender)
+			promHandlerError.WithLabelValues(h.donConfig.DonId, ErrNotAllowlisted.Error()).Inc()
+			return ErrUnsupportedMethod
+		}
 		return h.handleRequest(ctx, msg, callbackCh)
 	case MethodHeartbeat:
 		if _, ok := h.allowedHeartbeatInitiators[msg.Body.Sender]; !ok {
@@ -230,12 +259,33 @@ func (h *functionsHandler) HandleUserMessage(ctx context.Context, msg *api.Messa
 	}
 }
 
+func (h *functionsHandler) isSecretsAdmin(sender string) bool {
+	for _, admin := range h.handlerConfig.AllowedSecretsAdmins {
+		if strings.EqualFold(admin, sender) {
+			return true
+		}
+	}
+	return false
+}
+
 func (h *functionsHandler) handleRequest(ctx context.Context, msg *api.Message, callbackCh chan<- handlers.UserCallbackPayload) error {
 	h.lggr.Debugw("handleRequest: processing message", "sender", msg.Body.Sender, "messageId", msg.Body.MessageId)
+	if h.quotas != nil {
+		if err := h.quotas.Consume(ctx, msg.Body.Sender, len(msg.Body.Payload)); err != nil {
+			h.lggr.Debugw("handleRequest: quota not consumed", "sender", msg.Body.Sender, "err", err)
+			return err
+		}
+	}
 	err := h.pendingRequests.NewRequest(msg, callbackCh, &PendingRequest{request: msg, responses: make(map[string]*api.Message)})
 	if err != nil {
 		h.lggr.Warnw("handleRequest: error adding new request", "sender", msg.Body.Sender, "err", err)
 		promHandlerError.WithLabelValues(h.donConfig.DonId, err.Error()).Inc()
+		if h.quotas != nil {
+			// The request is not sent to the nodes, so it must not count against the quota of the user
+			if refundErr := h.quotas.Refund(ctx, msg.Body.Sender, len(msg.Body.Payload)); refundErr != nil {
+				h.lggr.Warnw("handleRequest: failed to refund quota", "sender", msg.Body.Sender, "err", refundErr)
+			}
+		}
 		return err
 	}
 	// Send to all nodes.
@@ -255,7 +305,7 @@ func (h *functionsHandler) HandleNodeMessage(ctx context.Context, msg *api.Messa
 		return errors.New("rate-limited")
 	}
 	switch msg.Body.Method {
-	case MethodSecretsSet, MethodSecretsList:
+	case MethodSecretsSet, MethodSecretsSetBatch, MethodSecretsList, MethodSecretsListRange:
 		return h.pendingRequests.ProcessResponse(msg, h.processSecretsResponse)
 	case MethodHeartbeat:
 		return h.pendingRequests.ProcessResponse(msg, h.processHeartbeatResponse)
@@ -9,12 +9,14 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
 	gc "github.com/smartcontractkit/chainlink/v2/core/services/gateway/common"
@@ -27,8 +29,10 @@ import (
 	handlers_mocks "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/mocks"
 )
 
-func newFunctionsHandlerForATestDON(t *testing.T, nodes []gc.TestNode, requestTimeout time.Duration, heartbeatSender string) (handlers.Handler, *handlers_mocks.DON, *allowlist_mocks.OnchainAllowlist, *subscriptions_mocks.OnchainSubscriptions) {
-	cfg := functions.FunctionsHandlerConfig{}
+func newFunctionsHandlerForATestDON(t *testing.T, nodes []gc.TestNode, requestTimeout time.Duration, privilegedSender string) (handlers.Handler, *handlers_mocks.DON, *allowlist_mocks.OnchainAllowlist, *subscriptions_mocks.OnchainSubscriptions) {
+	cfg := functions.FunctionsHandlerConfig{
+		AllowedSecretsAdmins: []string{privilegedSender},
+	}
 	donConfig := &config.DONConfig{
 		Members: []config.NodeConfig{},
 		F:       1,
@@ -50,8 +54,8 @@ func newFunctionsHandlerForATestDON(t *testing.T, nodes []gc.TestNode, requestTi
 	nodeRateLimiter, err := hc.NewRateLimiter(hc.RateLimiterConfig{GlobalRPS: 100.0, GlobalBurst: 100, PerSenderRPS: 100.0, PerSenderBurst: 100})
 	require.NoError(t, err)
 	pendingRequestsCache := hc.NewRequestCache[functions.PendingRequest](requestTimeout, 1000)
-	allowedHeartbeatInititors := map[string]struct{}{heartbeatSender: {}}
-	handler := functions.NewFunctionsHandler(cfg, donConfig, don, pendingRequestsCache, allowlist, subscriptions, minBalance, userRateLimiter, nodeRateLimiter, allowedHeartbeatInititors, logger.TestLogger(t))
+	allowedHeartbeatInititors := map[string]struct{}{privilegedSender: {}}
+	handler := functions.NewFunctionsHandler(cfg, donConfig, don, pendingRequestsCache, allowlist, subscriptions, minBalance, userRateLimiter, nodeRateLimiter, nil, allowedHeartbeatInititors, logger.TestLogger(t))
 	return handler, don, allowlist, subscriptions
 }
 
@@ -189,6 +193,46 @@ func TestFunctionsHandler_HandleUserMessage_Heartbeat(t *testing.T) {
 	}
 }
 
+func TestFunctionsHandler_HandleUserMessage_SecretsListRange(t *testing.T) {
+	t.Parallel()
+
+	t.Run("admin", func(t *testing.T) {
+		nodes, admin := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+		handler, don, allowlist, _ := newFunctionsHandlerForATestDON(t, nodes, time.Hour*24, admin.Address)
+		userRequestMsg := newSignedMessage(t, "1234", "secrets_list_range", "don_id", admin.PrivateKey)
+
+		callbachCh := make(chan handlers.UserCallbackPayload)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			// wait on a response from Gateway to the admin
+			response := <-callbachCh
+			require.Equal(t, api.NoError, response.ErrCode)
+			require.Equal(t, userRequestMsg.Body.MessageId, response.Msg.Body.MessageId)
+			var payload functions.CombinedResponse
+			require.NoError(t, json.Unmarshal(response.Msg.Body.Payload, &payload))
+			require.True(t, payload.Success)
+			require.Equal(t, 2, len(payload.NodeResponses))
+		}()
+
+		allowlist.On("Allow", common.HexToAddress(admin.Address)).Return(true, nil)
+		don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		require.NoError(t, handler.HandleUserMessage(testutils.Context(t), &userRequestMsg, callbachCh))
+		sendNodeReponses(t, handler, userRequestMsg, nodes, []bool{true, false, true, true})
+		<-done
+	})
+
+	t.Run("not an admin", func(t *testing.T) {
+		nodes, admin, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0], gc.NewTestNodes(t, 1)[0]
+		handler, _, allowlist, _ := newFunctionsHandlerForATestDON(t, nodes, time.Hour*24, admin.Address)
+		userRequestMsg := newSignedMessage(t, "1234", "secrets_list_range", "don_id", user.PrivateKey)
+
+		allowlist.On("Allow", common.HexToAddress(user.Address)).Return(true, nil)
+		err := handler.HandleUserMessage(testutils.Context(t), &userRequestMsg, make(chan handlers.UserCallbackPayload))
+		require.ErrorIs(t, err, functions.ErrUnsupportedMethod)
+	})
+}
+
 func TestFunctionsHandler_HandleUserMessage_InvalidMethod(t *testing.T) {
 	t.Parallel()
 
@@ -224,3 +268,38 @@ func TestFunctionsHandler_HandleUserMessage_Timeout(t *testing.T) {
 	require.NoError(t, handler.HandleUserMessage(testutils.Context(t), &userRequestMsg, callbachCh))
 	<-done
 }
+
+func TestFunctionsHandler_HandleUserMessage_QuotaRefund(t *testing.T) {
+	t.Parallel()
+
+	nodes, user := gc.NewTestNodes(t, 4), gc.NewTestNodes(t, 1)[0]
+	donConfig := &config.DONConfig{DonId: "don_id", F: 1}
+	for id, n := range nodes {
+		donConfig.Members = append(donConfig.Members, config.NodeConfig{Name: fmt.Sprintf("node_%d", id), Address: n.Address})
+	}
+	don := handlers_mocks.NewDON(t)
+	allowlist := allowlist_mocks.NewOnchainAllowlist(t)
+	quotaORM, err := hc.NewQuotaORM(pgtest.NewSqlxDB(t))
+	require.NoError(t, err)
+	quotas, err := hc.NewQuotas(hc.QuotaConfig{RequestsPerDay: 2}, quotaORM, donConfig.DonId, clockwork.NewFakeClock(), logger.TestLogger(t))
+	require.NoError(t, err)
+	pendingRequestsCache := hc.NewRequestCache[functions.PendingRequest](time.Hour, 1000)
+	handler := functions.NewFunctionsHandler(functions.FunctionsHandlerConfig{}, donConfig, don, pendingRequestsCache, allowlist, nil, nil, nil, nil, quotas, nil, logger.TestLogger(t))
+
+	allowlist.On("Allow", common.HexToAddress(user.Address)).Return(true, nil)
+	don.On("SendToNode", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	ctx := testutils.Context(t)
+
+	msg1 := newSignedMessage(t, "1", "secrets_list", donConfig.DonId, user.PrivateKey)
+	require.NoError(t, handler.HandleUserMessage(ctx, &msg1, make(chan handlers.UserCallbackPayload, 1)))
+	// a duplicate request is not sent to the nodes, and does not count against the quota
+	require.Error(t, handler.HandleUserMessage(ctx, &msg1, make(chan handlers.UserCallbackPayload, 1)))
+	msg2 := newSignedMessage(t, "2", "secrets_list", donConfig.DonId, user.PrivateKey)
+	require.NoError(t, handler.HandleUserMessage(ctx, &msg2, make(chan handlers.UserCallbackPayload, 1)))
+
+	msg3 := newSignedMessage(t, "3", "secrets_list", donConfig.DonId, user.PrivateKey)
+	err = handler.HandleUserMessage(ctx, &msg3, make(chan handlers.UserCallbackPayload, 1))
+	var userErr *handlers.UserError
+	require.ErrorAs(t, err, &userErr)
+	require.Equal(t, api.QuotaExceededError, userErr.ErrCode)
+}
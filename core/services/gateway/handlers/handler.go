@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
@@ -11,11 +12,27 @@ import (
 //go:generate mockery --quiet --name DON --output ./mocks/ --case=underscore
 
 // UserCallbackPayload is a response to user request sent to HandleUserMessage().
-// Each message needs to receive at most one response on the provided channel.
+// Each message needs to receive at most one final response on the provided channel.
+// It can be preceded by progress messages, which are only delivered to users who requested
+// a streaming response. Progress messages must be sent without blocking, and while leaving
+// room in the channel for the final response, as users who can't keep up drop them.
 type UserCallbackPayload struct {
-	Msg     *api.Message
+	Msg      *api.Message
+	ErrCode  api.ErrorCode
+	ErrMsg   string
+	Progress bool
+}
+
+// UserError is an error returned by HandleUserMessage(), which is sent to the user
+// with its own error code and data instead of a generic HandlerError.
+type UserError struct {
 	ErrCode api.ErrorCode
 	ErrMsg  string
+	ErrData json.RawMessage
+}
+
+func (e *UserError) Error() string {
+	return e.ErrMsg
 }
 
 // Handler implements service-specific logic for managing messages from users and nodes.
@@ -6,6 +6,7 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -29,6 +30,17 @@ type HTTPRequestHandler interface {
 	ProcessRequest(ctx context.Context, rawRequest []byte) (rawResponse []byte, httpStatusCode int)
 }
 
+// HTTPStreamingRequestHandler is implemented by handlers able to send progress messages before their final response.
+// They are streamed as Server-Sent Events to users who send requests with an "Accept: text/event-stream" header.
+//
+//go:generate mockery --quiet --name HTTPStreamingRequestHandler --output ./mocks/ --case=underscore
+type HTTPStreamingRequestHandler interface {
+	HTTPRequestHandler
+
+	// sendProgress is called synchronously, before ProcessStreamingRequest returns.
+	ProcessStreamingRequest(ctx context.Context, rawRequest []byte, sendProgress func(rawProgress []byte)) (rawResponse []byte, httpStatusCode int)
+}
+
 type HTTPServerConfig struct {
 	Host                 string
 	Port                 uint16
@@ -57,6 +69,10 @@ type httpServer struct {
 const (
 	HealthCheckPath     = "/health"
 	HealthCheckResponse = "OK"
+
+	EventStreamContentType = "text/event-stream"
+	ProgressEvent          = "progress"
+	ResultEvent            = "result"
 )
 
 func NewHttpServer(config *HTTPServerConfig, lggr logger.Logger) HttpServer {
@@ -104,6 +120,10 @@ func (s *httpServer) handleRequest(w http.ResponseWriter, r *http.Request) {
 		requestCtx, cancel = context.WithTimeout(requestCtx, time.Duration(s.config.RequestTimeoutMillis)*time.Millisecond)
 		defer cancel()
 	}
+	if streamingHandler, ok := s.handler.(HTTPStreamingRequestHandler); ok && acceptsEventStream(r) {
+		s.handleStreamingRequest(requestCtx, w, streamingHandler, rawMessage)
+		return
+	}
 	rawResponse, httpStatusCode := s.handler.ProcessRequest(requestCtx, rawMessage)
 
 	w.Header().Set("Content-Type", s.config.ContentTypeHeader)
@@ -114,6 +134,49 @@ func (s *httpServer) handleRequest(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// handleStreamingRequest sends progress messages as "progress" events, followed by the final response as a "result" event.
+// The status code of the final response is only used if no progress message was sent before it.
+func (s *httpServer) handleStreamingRequest(ctx context.Context, w http.ResponseWriter, handler HTTPStreamingRequestHandler, rawMessage []byte) {
+	rc := http.NewResponseController(w)
+	if s.config.RequestTimeoutMillis > 0 {
+		// streaming responses are written for as long as the request is processed
+		deadline := time.Now().Add(time.Duration(s.config.RequestTimeoutMillis+s.config.WriteTimeoutMillis) * time.Millisecond)
+		if err := rc.SetWriteDeadline(deadline); err != nil {
+			s.lggr.Debugw("error when extending the write deadline of a streaming response", "err", err)
+		}
+	}
+	w.Header().Set("Content-Type", EventStreamContentType)
+	w.Header().Set("Cache-Control", "no-cache")
+
+	headerWritten := false
+	writeEvent := func(event string, data []byte, httpStatusCode int) {
+		if !headerWritten {
+			w.WriteHeader(httpStatusCode)
+			headerWritten = true
+		}
+		if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+			s.lggr.Debugw("error when writing event", "event", event, "err", err)
+			return
+		}
+		if err := rc.Flush(); err != nil {
+			s.lggr.Debugw("error when flushing event", "event", event, "err", err)
+		}
+	}
+	rawResponse, httpStatusCode := handler.ProcessStreamingRequest(ctx, rawMessage, func(rawProgress []byte) {
+		writeEvent(ProgressEvent, rawProgress, http.StatusOK)
+	})
+	writeEvent(ResultEvent, rawResponse, httpStatusCode)
+}
+
+func acceptsEventStream(r *http.Request) bool {
+	for _, accept := range r.Header.Values("Accept") {
+		if strings.Contains(accept, EventStreamContentType) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *httpServer) SetHTTPRequestHandler(handler HTTPRequestHandler) {
 	s.handler = handler
 }
@@ -23,6 +23,12 @@ const (
 )
 
 func startNewServer(t *testing.T, maxRequestBytes int64, readTimeoutMillis uint32) (server network.HttpServer, handler *mocks.HTTPRequestHandler, url string) {
+	handler = mocks.NewHTTPRequestHandler(t)
+	server, url = startNewServerWithHandler(t, handler, maxRequestBytes, readTimeoutMillis)
+	return
+}
+
+func startNewServerWithHandler(t *testing.T, handler network.HTTPRequestHandler, maxRequestBytes int64, readTimeoutMillis uint32) (server network.HttpServer, url string) {
 	config := &network.HTTPServerConfig{
 		Host:                 HTTPTestHost,
 		Port:                 0,
@@ -35,7 +41,6 @@ func startNewServer(t *testing.T, maxRequestBytes int64, readTimeoutMillis uint3
 		MaxRequestBytes:      maxRequestBytes,
 	}
 
-	handler = mocks.NewHTTPRequestHandler(t)
 	server = network.NewHttpServer(config, logger.TestLogger(t))
 	server.SetHTTPRequestHandler(handler)
 	err := server.Start(testutils.Context(t))
@@ -47,8 +52,15 @@ func startNewServer(t *testing.T, maxRequestBytes int64, readTimeoutMillis uint3
 }
 
 func sendRequest(t *testing.T, url string, body []byte) *http.Response {
+	return sendRequestWithHeaders(t, url, body, nil)
+}
+
+func sendRequestWithHeaders(t *testing.T, url string, body []byte, headers map[string]string) *http.Response {
 	req, err := http.NewRequestWithContext(testutils.Context(t), "POST", url, bytes.NewBuffer(body))
 	require.NoError(t, err)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	require.NoError(t, err)
@@ -87,3 +99,51 @@ func TestHTTPServer_HandleHealthCheck(t *testing.T) {
 	require.Equal(t, http.StatusOK, resp.StatusCode)
 	require.Equal(t, []byte(network.HealthCheckResponse), respBytes)
 }
+
+func TestHTTPServer_HandleRequest_Streaming(t *testing.T) {
+	handler := mocks.NewHTTPStreamingRequestHandler(t)
+	server, url := startNewServerWithHandler(t, handler, 100_000, 100_000)
+	defer server.Close()
+
+	handler.On("ProcessStreamingRequest", mock.Anything, []byte("0123456789"), mock.Anything).Return([]byte("response"), 400).Run(func(args mock.Arguments) {
+		sendProgress := args.Get(2).(func([]byte))
+		sendProgress([]byte("progress1"))
+		sendProgress([]byte("progress2"))
+	})
+
+	resp := sendRequestWithHeaders(t, url, []byte("0123456789"), map[string]string{"Accept": network.EventStreamContentType})
+	respBytes, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	// the status code is sent with the first progress message
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, network.EventStreamContentType, resp.Header.Get("Content-Type"))
+	require.Equal(t, "event: progress\ndata: progress1\n\nevent: progress\ndata: progress2\n\nevent: result\ndata: response\n\n", string(respBytes))
+}
+
+func TestHTTPServer_HandleRequest_StreamingWithoutProgress(t *testing.T) {
+	handler := mocks.NewHTTPStreamingRequestHandler(t)
+	server, url := startNewServerWithHandler(t, handler, 100_000, 100_000)
+	defer server.Close()
+
+	handler.On("ProcessStreamingRequest", mock.Anything, mock.Anything, mock.Anything).Return([]byte("response"), 400)
+
+	resp := sendRequestWithHeaders(t, url, []byte("0123456789"), map[string]string{"Accept": network.EventStreamContentType})
+	respBytes, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	require.Equal(t, "event: result\ndata: response\n\n", string(respBytes))
+}
+
+func TestHTTPServer_HandleRequest_StreamingNotRequested(t *testing.T) {
+	handler := mocks.NewHTTPStreamingRequestHandler(t)
+	server, url := startNewServerWithHandler(t, handler, 100_000, 100_000)
+	defer server.Close()
+
+	handler.On("ProcessRequest", mock.Anything, mock.Anything).Return([]byte("response"), 200)
+
+	resp := sendRequest(t, url, []byte("0123456789"))
+	respBytes, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, []byte("response"), respBytes)
+}
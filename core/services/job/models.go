@@ -199,6 +199,25 @@ func (j Job) ExternalIDEncodeBytesToTopic() common.Hash {
 	return ExternalJobIDEncodeBytesToTopic(j.ExternalJobID)
 }
 
+// SimulationSpec returns a copy of the pipeline spec of the job, populated with
+// the job fields the runs depend on like the spawner does for active jobs.
+func (j Job) SimulationSpec() (pipeline.Spec, error) {
+	if j.PipelineSpec == nil {
+		return pipeline.Spec{}, ErrNoPipelineSpec
+	}
+
+	spec := *j.PipelineSpec
+	spec.JobName = j.Name.ValueOrZero()
+	spec.JobID = j.ID
+	spec.JobType = string(j.Type)
+	spec.ForwardingAllowed = j.ForwardingAllowed
+	if j.GasLimit.Valid {
+		spec.GasLimit = &j.GasLimit.Uint32
+	}
+	spec.Pipeline = nil
+	return spec, nil
+}
+
 // SetID takes the id as a string and attempts to convert it to an int32. If
 // it succeeds, it will set it as the id on the job
 func (j *Job) SetID(value string) error {
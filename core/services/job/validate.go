@@ -5,6 +5,8 @@ import (
 
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 )
 
 var (
@@ -73,3 +75,27 @@ func ValidateSpec(ts string) (Type, error) {
 
 	return jb.Type, nil
 }
+
+// ValidatedSimulationSpec parses the job spec ts into a job whose pipeline runs can be simulated without creating it.
+func ValidatedSimulationSpec(ts string) (Job, error) {
+	var jb Job
+	if _, err := ValidateSpec(ts); err != nil {
+		return jb, err
+	}
+	tree, err := toml.Load(ts)
+	if err != nil {
+		return jb, errors.Wrap(err, "toml error on load")
+	}
+	if err = tree.Unmarshal(&jb); err != nil {
+		return jb, errors.Wrap(err, "toml unmarshal error on spec")
+	}
+	if jb.Pipeline.Source == "" {
+		return jb, ErrNoPipelineSpec
+	}
+
+	jb.PipelineSpec = &pipeline.Spec{
+		DotDagSource:    jb.Pipeline.Source,
+		MaxTaskDuration: jb.MaxTaskDuration,
+	}
+	return jb, nil
+}
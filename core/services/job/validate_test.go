@@ -2,6 +2,7 @@ package job
 
 import (
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -112,3 +113,32 @@ ds [type=http]
 		})
 	}
 }
+
+func TestValidatedSimulationSpec(t *testing.T) {
+	jb, err := ValidatedSimulationSpec(`
+type="webhook"
+schemaVersion=1
+name="simulated"
+gasLimit=100000
+maxTaskDuration="10s"
+observationSource="""
+ds [type=http method=GET url="https://example.invalid"]
+"""
+`)
+	require.NoError(t, err)
+
+	spec, err := jb.SimulationSpec()
+	require.NoError(t, err)
+	require.Contains(t, spec.DotDagSource, "ds [type=http")
+	require.Equal(t, "simulated", spec.JobName)
+	require.Equal(t, string(Webhook), spec.JobType)
+	require.Equal(t, uint32(100000), *spec.GasLimit)
+	require.Equal(t, 10*time.Second, spec.MaxTaskDuration.Duration())
+	require.Nil(t, spec.Pipeline)
+
+	_, err = ValidatedSimulationSpec(`
+type="bootstrap"
+schemaVersion=1
+`)
+	require.True(t, errors.Is(errors.Cause(err), ErrNoPipelineSpec))
+}
@@ -0,0 +1,340 @@
+package keystore
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"time"
+
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+)
+
+const (
+	backupKeyType = "KeystoreBackup"
+	// BackupVersion is the version of the keystore backups created by Backup.
+	BackupVersion = 1
+)
+
+// ErrRestoreConflicts is returned by Restore when keys or eth key states of the backup conflict with the ones of the
+// keystore. Nothing is restored then.
+var ErrRestoreConflicts = errors.New("backup conflicts with the keystore")
+
+// EncryptedKeystoreBackup is a backup of all the keys of the keystore, and of the states of the eth keys.
+type EncryptedKeystoreBackup struct {
+	KeyType   string                  `json:"keyType"`
+	Version   int                     `json:"version"`
+	CreatedAt time.Time               `json:"createdAt"`
+	Crypto    gethkeystore.CryptoJSON `json:"crypto"`
+}
+
+// keystoreBackup is the encrypted content of a backup.
+type keystoreBackup struct {
+	// Keys are the raw keys of each type, as encrypted in the key ring
+	Keys         rawLegacyKeys       `json:"keys"`
+	EthKeyStates []backupEthKeyState `json:"ethKeyStates"`
+}
+
+type backupEthKeyState struct {
+	Address    common.Address `json:"address"`
+	EVMChainID ubig.Big       `json:"evmChainID"`
+	Disabled   bool           `json:"disabled"`
+}
+
+// RestoreStatus is the outcome of restoring a key or an eth key state.
+type RestoreStatus string
+
+const (
+	// RestoreStatusAdded is for the keys and states which are not in the keystore, and are added to it.
+	RestoreStatusAdded RestoreStatus = "added"
+	// RestoreStatusExists is for the keys and states which are already in the keystore.
+	RestoreStatusExists RestoreStatus = "exists"
+	// RestoreStatusConflict is for the keys and states which differ from the ones with the same ID in the keystore.
+	RestoreStatusConflict RestoreStatus = "conflict"
+)
+
+// RestoredKey reports the restore of a key. Legacy keys, of types the node no longer supports, have no ID.
+type RestoredKey struct {
+	Type   string        `json:"type"`
+	ID     string        `json:"id,omitempty"`
+	Legacy bool          `json:"legacy,omitempty"`
+	Status RestoreStatus `json:"status"`
+}
+
+// RestoredEthKeyState reports the restore of the state of an eth key on a chain.
+type RestoredEthKeyState struct {
+	Address    common.Address `json:"address"`
+	EVMChainID ubig.Big       `json:"evmChainID"`
+	Disabled   bool           `json:"disabled"`
+	Status     RestoreStatus  `json:"status"`
+}
+
+// RestoreReport reports the keys and eth key states of a backup, and how they are restored.
+type RestoreReport struct {
+	Version      int                   `json:"version"`
+	CreatedAt    time.Time             `json:"createdAt"`
+	DryRun       bool                  `json:"dryRun"`
+	Keys         []RestoredKey         `json:"keys"`
+	EthKeyStates []RestoredEthKeyState `json:"ethKeyStates"`
+}
+
+// HasConflicts returns true if a key or an eth key state of the backup conflicts with the keystore.
+func (r RestoreReport) HasConflicts() bool {
+	for _, k := range r.Keys {
+		if k.Status == RestoreStatusConflict {
+			return true
+		}
+	}
+	for _, s := range r.EthKeyStates {
+		if s.Status == RestoreStatusConflict {
+			return true
+		}
+	}
+	return false
+}
+
+// Backup returns a backup of all the keys of the keystore, including the legacy ones, and of the states of the eth
+// keys, encrypted with password.
+func (ks *master) Backup(_ context.Context, password string) ([]byte, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+	if ks.isLocked() {
+		return nil, ErrLocked
+	}
+
+	keys, err := ks.keyRing.allRawKeys()
+	if err != nil {
+		return nil, err
+	}
+	backup := keystoreBackup{Keys: keys, EthKeyStates: []backupEthKeyState{}}
+	for _, state := range ks.keyStates.All {
+		backup.EthKeyStates = append(backup.EthKeyStates, backupEthKeyState{
+			Address:    state.Address.Address(),
+			EVMChainID: state.EVMChainID,
+			Disabled:   state.Disabled,
+		})
+	}
+	plaintext, err := json.Marshal(backup)
+	if err != nil {
+		return nil, err
+	}
+
+	cryptoJSON, err := gethkeystore.EncryptDataV3(plaintext, []byte(adulteratedBackupPassword(password)), ks.scryptParams.N, ks.scryptParams.P)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encrypt keystore backup")
+	}
+	return json.Marshal(EncryptedKeystoreBackup{
+		KeyType:   backupKeyType,
+		Version:   BackupVersion,
+		CreatedAt: time.Now().UTC(),
+		Crypto:    cryptoJSON,
+	})
+}
+
+// addedKey is a key of a backup which is not in the keystore.
+type addedKey struct {
+	fieldName string
+	id, key   reflect.Value
+}
+
+// Restore adds the keys and eth key states of a backup encrypted with password to the keystore. The keys and states
+// which are already in the keystore are left untouched. If any of them conflicts with the keystore, nothing is
+// restored and ErrRestoreConflicts is returned along with the report. A dry run only reports what would be restored.
+func (ks *master) Restore(ctx context.Context, backupJSON []byte, password string, dryRun bool) (RestoreReport, error) {
+	var encrypted EncryptedKeystoreBackup
+	if err := json.Unmarshal(backupJSON, &encrypted); err != nil {
+		return RestoreReport{}, errors.Wrap(err, "invalid keystore backup")
+	}
+	if encrypted.KeyType != backupKeyType {
+		return RestoreReport{}, errors.Errorf("invalid keystore backup: expected key type %s, got %s", backupKeyType, encrypted.KeyType)
+	}
+	if encrypted.Version < 1 || encrypted.Version > BackupVersion {
+		return RestoreReport{}, errors.Errorf("unsupported keystore backup version %d, the latest supported version is %d", encrypted.Version, BackupVersion)
+	}
+	plaintext, err := gethkeystore.DecryptDataV3(encrypted.Crypto, adulteratedBackupPassword(password))
+	if err != nil {
+		return RestoreReport{}, errors.Wrap(err, "could not decrypt keystore backup")
+	}
+	var backup keystoreBackup
+	if err = json.Unmarshal(plaintext, &backup); err != nil {
+		return RestoreReport{}, errors.Wrap(err, "invalid keystore backup")
+	}
+	backupRing, err := backup.keyRing()
+	if err != nil {
+		return RestoreReport{}, errors.Wrap(err, "invalid keystore backup")
+	}
+
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return RestoreReport{}, ErrLocked
+	}
+
+	report := RestoreReport{
+		Version:      encrypted.Version,
+		CreatedAt:    encrypted.CreatedAt,
+		DryRun:       dryRun,
+		Keys:         []RestoredKey{},
+		EthKeyStates: []RestoredEthKeyState{},
+	}
+
+	var added []addedKey
+	current := reflect.ValueOf(ks.keyRing).Elem()
+	restored := reflect.ValueOf(backupRing).Elem()
+	for i := 0; i < restored.NumField(); i++ {
+		if restored.Field(i).Kind() != reflect.Map {
+			continue
+		}
+		fieldName := restored.Type().Field(i).Name
+		currentKeys := current.Field(i)
+		iter := restored.Field(i).MapRange()
+		for iter.Next() {
+			restoredKey := RestoredKey{Type: fieldName, ID: iter.Key().String(), Status: RestoreStatusAdded}
+			if currentKey := currentKeys.MapIndex(iter.Key()); currentKey.IsValid() {
+				equal, err2 := sameKeyMaterial(fieldName, iter.Key(), currentKey, iter.Value())
+				if err2 != nil {
+					return RestoreReport{}, err2
+				}
+				restoredKey.Status = RestoreStatusConflict
+				if equal {
+					restoredKey.Status = RestoreStatusExists
+				}
+			} else {
+				added = append(added, addedKey{fieldName: fieldName, id: iter.Key(), key: iter.Value()})
+			}
+			report.Keys = append(report.Keys, restoredKey)
+		}
+	}
+
+	legacyKeys := rawLegacyKeys{}
+	for name, values := range ks.keyRing.LegacyKeys.legacyRawKeys {
+		legacyKeys[name] = append([]string(nil), values...)
+	}
+	for name, values := range backupRing.LegacyKeys.legacyRawKeys {
+		for _, value := range values {
+			restoredKey := RestoredKey{Type: name, Legacy: true, Status: RestoreStatusExists}
+			if !legacyKeys.hasValueInField(name, value) {
+				restoredKey.Status = RestoreStatusAdded
+				legacyKeys[name] = append(legacyKeys[name], value)
+			}
+			report.Keys = append(report.Keys, restoredKey)
+		}
+	}
+
+	sort.SliceStable(report.Keys, func(i, j int) bool {
+		if report.Keys[i].Type != report.Keys[j].Type {
+			return report.Keys[i].Type < report.Keys[j].Type
+		}
+		return report.Keys[i].ID < report.Keys[j].ID
+	})
+
+	var addedStates []backupEthKeyState
+	for _, state := range backup.EthKeyStates {
+		restoredState := RestoredEthKeyState{Address: state.Address, EVMChainID: state.EVMChainID, Disabled: state.Disabled, Status: RestoreStatusAdded}
+		if _, found := backupRing.Eth[state.Address.Hex()]; !found {
+			// the state of a key which is not in the backup can't be restored
+			restoredState.Status = RestoreStatusConflict
+		} else if currentState := ks.keyStates.get(state.Address, state.EVMChainID.ToInt()); currentState != nil {
+			restoredState.Status = RestoreStatusExists
+			if currentState.Disabled != state.Disabled {
+				restoredState.Status = RestoreStatusConflict
+			}
+		} else {
+			addedStates = append(addedStates, state)
+		}
+		report.EthKeyStates = append(report.EthKeyStates, restoredState)
+	}
+
+	if report.HasConflicts() {
+		if dryRun {
+			return report, nil
+		}
+		return report, ErrRestoreConflicts
+	}
+	if dryRun || (len(added) == 0 && len(addedStates) == 0 && legacyKeys.len() == ks.keyRing.LegacyKeys.legacyRawKeys.len()) {
+		return report, nil
+	}
+
+	for _, k := range added {
+		current.FieldByName(k.fieldName).SetMapIndex(k.id, k.key)
+	}
+	previousLegacyKeys := ks.keyRing.LegacyKeys.legacyRawKeys
+	ks.keyRing.LegacyKeys.legacyRawKeys = legacyKeys
+
+	var insertedStates []*ethkey.State
+	err = ks.save(ctx, func(tx sqlutil.DataSource) error {
+		for _, s := range addedStates {
+			state := new(ethkey.State)
+			sql := `INSERT INTO evm.key_states (address, disabled, evm_chain_id, created_at, updated_at)
+			VALUES ($1, $2, $3, NOW(), NOW())
+			RETURNING *;`
+			if err2 := tx.GetContext(ctx, state, sql, s.Address, s.Disabled, s.EVMChainID.String()); err2 != nil {
+				return errors.Wrap(err2, "failed to insert key_state")
+			}
+			insertedStates = append(insertedStates, state)
+		}
+		return nil
+	})
+	if err != nil {
+		// if save fails, remove the restored keys from the keyRing
+		for _, k := range added {
+			current.FieldByName(k.fieldName).SetMapIndex(k.id, reflect.Value{})
+		}
+		ks.keyRing.LegacyKeys.legacyRawKeys = previousLegacyKeys
+		return RestoreReport{}, err
+	}
+	for _, state := range insertedStates {
+		ks.keyStates.add(state)
+	}
+	ks.eth.notify()
+	return report, nil
+}
+
+// keyRing returns the key ring of the raw keys of the backup.
+func (b keystoreBackup) keyRing() (*keyRing, error) {
+	marshalledRawKeyRingJson, err := json.Marshal(b.Keys)
+	if err != nil {
+		return nil, err
+	}
+	var rawKeys rawKeyRing
+	if err = json.Unmarshal(marshalledRawKeyRingJson, &rawKeys); err != nil {
+		return nil, err
+	}
+	ring, err := rawKeys.keys()
+	if err != nil {
+		return nil, err
+	}
+	if err = ring.LegacyKeys.StoreUnsupported(marshalledRawKeyRingJson, ring); err != nil {
+		return nil, err
+	}
+	return ring, nil
+}
+
+// sameKeyMaterial returns true if the keys with the same ID of a key ring field have the same raw key.
+func sameKeyMaterial(fieldName string, id, key, otherKey reflect.Value) (bool, error) {
+	raw := func(key reflect.Value) ([]byte, error) {
+		kr := newKeyRing()
+		reflect.ValueOf(kr).Elem().FieldByName(fieldName).SetMapIndex(id, key)
+		return json.Marshal(kr.raw())
+	}
+	keyRaw, err := raw(key)
+	if err != nil {
+		return false, err
+	}
+	otherKeyRaw, err := raw(otherKey)
+	if err != nil {
+		return false, err
+	}
+	return string(keyRaw) == string(otherKeyRaw), nil
+}
+
+// adulteration prevents the password from getting used in the wrong place
+func adulteratedBackupPassword(password string) string {
+	return "keystore-backup-" + password
+}
@@ -0,0 +1,125 @@
+package keystore_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
+)
+
+func TestMasterKeystore_BackupRestore(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	keyStore := keystore.ExposedNewMaster(t, pgtest.NewSqlxDB(t))
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	const backupPassword = "b4ckup-p4ssw0rd"
+	otherChainID := big.NewInt(1337)
+
+	ethKey, _ := cltest.MustInsertRandomKey(t, keyStore.Eth())
+	require.NoError(t, keyStore.Eth().Add(ctx, ethKey.Address, otherChainID))
+	require.NoError(t, keyStore.Eth().Disable(ctx, ethKey.Address, otherChainID))
+	p2pKey, err := keyStore.P2P().Create(ctx)
+	require.NoError(t, err)
+	ocr2Key, err := keyStore.OCR2().Create(ctx, chaintype.EVM)
+	require.NoError(t, err)
+	vrfKey, err := keyStore.VRF().Create(ctx)
+	require.NoError(t, err)
+
+	backup, err := keyStore.Backup(ctx, backupPassword)
+	require.NoError(t, err)
+
+	requireReport := func(t *testing.T, report keystore.RestoreReport, status keystore.RestoreStatus) {
+		assert.Equal(t, keystore.BackupVersion, report.Version)
+		require.Len(t, report.Keys, 4)
+		for _, k := range report.Keys {
+			assert.Equal(t, status, k.Status, k.Type)
+		}
+		require.Len(t, report.EthKeyStates, 2)
+		for _, s := range report.EthKeyStates {
+			assert.Equal(t, ethKey.Address, s.Address)
+			assert.Equal(t, s.EVMChainID.ToInt().Cmp(otherChainID) == 0, s.Disabled)
+			assert.Equal(t, status, s.Status)
+		}
+	}
+
+	t.Run("rejects invalid backups", func(t *testing.T) {
+		_, err := keyStore.Restore(ctx, backup, "wrong password", false)
+		require.ErrorContains(t, err, "could not decrypt keystore backup")
+		_, err = keyStore.Restore(ctx, []byte(`{"keyType":"KeystoreBackup","version":2}`), backupPassword, false)
+		require.ErrorContains(t, err, "unsupported keystore backup version 2")
+		_, err = keyStore.Restore(ctx, []byte(`{"keyType":"P2P"}`), backupPassword, false)
+		require.ErrorContains(t, err, "invalid keystore backup")
+	})
+
+	t.Run("leaves the keys of the keystore untouched", func(t *testing.T) {
+		report, err := keyStore.Restore(ctx, backup, backupPassword, false)
+		require.NoError(t, err)
+		requireReport(t, report, keystore.RestoreStatusExists)
+	})
+
+	restoreDB := pgtest.NewSqlxDB(t)
+	restored := keystore.ExposedNewMaster(t, restoreDB)
+	require.NoError(t, restored.Unlock(ctx, cltest.Password))
+
+	t.Run("dry run", func(t *testing.T) {
+		report, err := restored.Restore(ctx, backup, backupPassword, true)
+		require.NoError(t, err)
+		assert.True(t, report.DryRun)
+		requireReport(t, report, keystore.RestoreStatusAdded)
+
+		keys, err := restored.Eth().GetAll(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("restores all the keys and eth key states", func(t *testing.T) {
+		report, err := restored.Restore(ctx, backup, backupPassword, false)
+		require.NoError(t, err)
+		assert.False(t, report.DryRun)
+		requireReport(t, report, keystore.RestoreStatusAdded)
+
+		// the restored keys are saved in the key ring
+		reloaded := keystore.ExposedNewMaster(t, restoreDB)
+		require.NoError(t, reloaded.Unlock(ctx, cltest.Password))
+		gotEthKey, err := reloaded.Eth().Get(ctx, ethKey.ID())
+		require.NoError(t, err)
+		assert.Equal(t, ethKey, gotEthKey)
+		state, err := reloaded.Eth().GetState(ctx, ethKey.ID(), otherChainID)
+		require.NoError(t, err)
+		assert.True(t, state.Disabled)
+		require.NoError(t, reloaded.Eth().CheckEnabled(ctx, ethKey.Address, &cltest.FixtureChainID))
+		gotP2PKey, err := reloaded.P2P().Get(p2pKey.PeerID())
+		require.NoError(t, err)
+		assert.Equal(t, p2pKey.Raw(), gotP2PKey.Raw())
+		gotOCR2Key, err := reloaded.OCR2().Get(ocr2Key.ID())
+		require.NoError(t, err)
+		assert.Equal(t, ocr2Key.Raw(), gotOCR2Key.Raw())
+		gotVRFKey, err := reloaded.VRF().Get(vrfKey.ID())
+		require.NoError(t, err)
+		assert.Equal(t, vrfKey.Raw(), gotVRFKey.Raw())
+	})
+
+	t.Run("reports conflicts", func(t *testing.T) {
+		require.NoError(t, restored.Eth().Enable(ctx, ethKey.Address, otherChainID))
+
+		report, err := restored.Restore(ctx, backup, backupPassword, true)
+		require.NoError(t, err)
+		assert.True(t, report.HasConflicts())
+
+		report, err = restored.Restore(ctx, backup, backupPassword, false)
+		require.ErrorIs(t, err, keystore.ErrRestoreConflicts)
+		for _, s := range report.EthKeyStates {
+			if s.EVMChainID.ToInt().Cmp(otherChainID) == 0 {
+				assert.Equal(t, keystore.RestoreStatusConflict, s.Status)
+			}
+		}
+	})
+}
@@ -27,6 +27,7 @@ type Eth interface {
 	Create(ctx context.Context, chainIDs ...*big.Int) (ethkey.KeyV2, error)
 	Delete(ctx context.Context, id string) (ethkey.KeyV2, error)
 	Import(ctx context.Context, keyJSON []byte, password string, chainIDs ...*big.Int) (ethkey.KeyV2, error)
+	ImportRemote(ctx context.Context, address common.Address, signerURL string, chainIDs ...*big.Int) (ethkey.KeyV2, error)
 	Export(ctx context.Context, id string, password string) ([]byte, error)
 
 	Enable(ctx context.Context, address common.Address, chainID *big.Int) error
@@ -58,6 +59,7 @@ type eth struct {
 	ds            sqlutil.DataSource
 	subscribers   [](chan struct{})
 	subscribersMu *sync.RWMutex
+	remoteSigner  *remoteEthTxSigner
 }
 
 var _ Eth = &eth{}
@@ -69,6 +71,7 @@ func newEthKeyStore(km *keyManager, orm keystateORM, ds sqlutil.DataSource) *eth
 		ds:            ds,
 		subscribers:   make([](chan struct{}), 0),
 		subscribersMu: new(sync.RWMutex),
+		remoteSigner:  newRemoteEthTxSigner(),
 	}
 }
 
@@ -170,6 +173,32 @@ func (ks *eth) Import(ctx context.Context, keyJSON []byte, password string, chai
 	return key, nil
 }
 
+// ImportRemote adds a key whose private key is held by the external signer at signerURL, and enables it for the given
+// chain IDs. Its transactions are signed by the signer with the eth_signTransaction JSON-RPC method.
+func (ks *eth) ImportRemote(ctx context.Context, address common.Address, signerURL string, chainIDs ...*big.Int) (ethkey.KeyV2, error) {
+	// The signer is checked before locking the key store, which can't be used while waiting for it
+	if err := ks.remoteSigner.checkAccount(ctx, signerURL, address); err != nil {
+		return ethkey.KeyV2{}, err
+	}
+
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ethkey.KeyV2{}, ErrLocked
+	}
+	key := ethkey.NewRemote(address, signerURL)
+	if _, found := ks.keyRing.Eth[key.ID()]; found {
+		return ethkey.KeyV2{}, ErrKeyExists
+	}
+	err := ks.add(ctx, key, chainIDs...)
+	if err != nil {
+		return ethkey.KeyV2{}, errors.Wrap(err, "unable to add remote eth key")
+	}
+	ks.notify()
+	ks.logger.Infow(fmt.Sprintf("Imported remote EVM key with ID %s", key.Address.Hex()), "address", key.Address.Hex(), "evmChainIDs", chainIDs)
+	return key, nil
+}
+
 func (ks *eth) Export(ctx context.Context, id string, password string) ([]byte, error) {
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
@@ -310,17 +339,29 @@ func (ks *eth) SubscribeToKeyChanges(ctx context.Context) (ch chan struct{}, uns
 }
 
 func (ks *eth) SignTx(ctx context.Context, address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	key, err := ks.getForSigning(address)
+	if err != nil {
+		return nil, err
+	}
+	// The key store is not locked while the external signer of a remote key signs the transaction
+	return ks.txSigner(key).SignTx(ctx, key, tx, chainID)
+}
+
+func (ks *eth) getForSigning(address common.Address) (ethkey.KeyV2, error) {
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
 	if ks.isLocked() {
-		return nil, ErrLocked
+		return ethkey.KeyV2{}, ErrLocked
 	}
-	key, err := ks.getByID(address.String())
-	if err != nil {
-		return nil, err
+	return ks.getByID(address.String())
+}
+
+// txSigner returns the signer of the transactions of key.
+func (ks *eth) txSigner(key ethkey.KeyV2) EthTxSigner {
+	if key.IsRemote() {
+		return ks.remoteSigner
 	}
-	signer := types.LatestSignerForChainID(chainID)
-	return types.SignTx(tx, signer, key.ToEcdsaPrivKey())
+	return localEthTxSigner{}
 }
 
 // EnabledKeysForChain returns all keys that are enabled for the given chain
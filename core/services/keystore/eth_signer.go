@@ -0,0 +1,224 @@
+package keystore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+)
+
+// EthTxSigner signs the transactions of eth keys. The key store signs the transactions of its local keys in memory,
+// and delegates the ones of its remote keys to their external signer.
+type EthTxSigner interface {
+	SignTx(ctx context.Context, key ethkey.KeyV2, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+}
+
+type localEthTxSigner struct{}
+
+var _ EthTxSigner = localEthTxSigner{}
+
+func (localEthTxSigner) SignTx(_ context.Context, key ethkey.KeyV2, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	signer := types.LatestSignerForChainID(chainID)
+	return types.SignTx(tx, signer, key.ToEcdsaPrivKey())
+}
+
+const (
+	// remoteSignerTimeout bounds the requests to the external signers of the remote keys.
+	remoteSignerTimeout = 30 * time.Second
+	// remoteSignerMaxResponseSize limits the size of the responses of the external signers.
+	remoteSignerMaxResponseSize = 1 << 20
+	// jsonRPCMethodNotFound is the JSON-RPC error code of the methods a server does not implement.
+	jsonRPCMethodNotFound = -32601
+)
+
+// remoteEthTxSigner signs transactions with the eth_signTransaction JSON-RPC method of an external signer, such as
+// web3signer or clef. The signed transactions are checked against the requested ones, so a misbehaving signer can't
+// make the node send a different transaction.
+type remoteEthTxSigner struct {
+	client *http.Client
+	nextID atomic.Int64
+}
+
+var _ EthTxSigner = (*remoteEthTxSigner)(nil)
+
+func newRemoteEthTxSigner() *remoteEthTxSigner {
+	return &remoteEthTxSigner{client: &http.Client{Timeout: remoteSignerTimeout}}
+}
+
+// remoteSignerTxArgs are the transaction arguments of eth_signTransaction.
+type remoteSignerTxArgs struct {
+	From                 common.Address    `json:"from"`
+	To                   *common.Address   `json:"to,omitempty"`
+	Gas                  hexutil.Uint64    `json:"gas"`
+	GasPrice             *hexutil.Big      `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big      `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big      `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big      `json:"value"`
+	Nonce                hexutil.Uint64    `json:"nonce"`
+	Data                 hexutil.Bytes     `json:"data"`
+	AccessList           *types.AccessList `json:"accessList,omitempty"`
+	ChainID              *hexutil.Big      `json:"chainId"`
+}
+
+func newRemoteSignerTxArgs(from common.Address, tx *types.Transaction, chainID *big.Int) (remoteSignerTxArgs, error) {
+	args := remoteSignerTxArgs{
+		From:    from,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	switch tx.Type() {
+	case types.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case types.AccessListTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	case types.DynamicFeeTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	default:
+		return remoteSignerTxArgs{}, errors.Errorf("transaction type %d is not supported by remote keys", tx.Type())
+	}
+	return args, nil
+}
+
+func (r *remoteEthTxSigner) SignTx(ctx context.Context, key ethkey.KeyV2, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	args, err := newRemoteSignerTxArgs(key.Address, tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	// web3signer returns the signed transaction, geth and clef return it in the raw field of an object
+	var result json.RawMessage
+	if err = r.call(ctx, key.SignerURL(), "eth_signTransaction", []interface{}{args}, &result); err != nil {
+		return nil, errors.Wrapf(err, "external signer failed to sign transaction of key %s", key.Address)
+	}
+	var raw hexutil.Bytes
+	if err = json.Unmarshal(result, &raw); err != nil {
+		var object struct {
+			Raw hexutil.Bytes `json:"raw"`
+		}
+		if err = json.Unmarshal(result, &object); err != nil {
+			return nil, errors.Wrap(err, "external signer returned an invalid signed transaction")
+		}
+		raw = object.Raw
+	}
+	signed := new(types.Transaction)
+	if err = signed.UnmarshalBinary(raw); err != nil {
+		return nil, errors.Wrap(err, "external signer returned an invalid signed transaction")
+	}
+
+	signer := types.LatestSignerForChainID(chainID)
+	if signed.Type() != tx.Type() || signer.Hash(signed) != signer.Hash(tx) {
+		return nil, errors.Errorf("external signer of key %s signed a different transaction than the requested one", key.Address)
+	}
+	sender, err := types.Sender(signer, signed)
+	if err != nil {
+		return nil, errors.Wrap(err, "external signer returned an invalid signature")
+	}
+	if sender != key.Address {
+		return nil, errors.Errorf("external signer signed transaction with %s instead of key %s", sender, key.Address)
+	}
+	return signed, nil
+}
+
+// checkAccount returns an error if the external signer at signerURL lists its accounts with eth_accounts, and address
+// is not one of them.
+func (r *remoteEthTxSigner) checkAccount(ctx context.Context, signerURL string, address common.Address) error {
+	u, err := url.Parse(signerURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("the URL of an external signer must be an http or https URL")
+	}
+
+	var accounts []common.Address
+	err = r.call(ctx, signerURL, "eth_accounts", []interface{}{}, &accounts)
+	var rpcErr *remoteSignerError
+	if errors.As(err, &rpcErr) && rpcErr.Code == jsonRPCMethodNotFound {
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "failed to list the accounts of the external signer")
+	}
+	if !slices.Contains(accounts, address) {
+		return errors.Errorf("external signer has no account %s", address)
+	}
+	return nil
+}
+
+type remoteSignerError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *remoteSignerError) Error() string {
+	return fmt.Sprintf("external signer error %d: %s", e.Code, e.Message)
+}
+
+func (r *remoteEthTxSigner) call(ctx context.Context, signerURL string, method string, params []interface{}, result interface{}) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      r.nextID.Add(1),
+		"method":  method,
+		"params":  params,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, signerURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		// The URL may contain credentials
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			return urlErr.Err
+		}
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, remoteSignerMaxResponseSize))
+	if err != nil {
+		return err
+	}
+
+	var rpcResp struct {
+		Result json.RawMessage    `json:"result"`
+		Error  *remoteSignerError `json:"error"`
+	}
+	if err = json.Unmarshal(respBody, &rpcResp); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return errors.Errorf("unexpected status code %d", resp.StatusCode)
+		}
+		return errors.Wrap(err, "invalid JSON-RPC response")
+	}
+	if rpcResp.Error != nil {
+		return rpcResp.Error
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return json.Unmarshal(rpcResp.Result, result)
+}
@@ -0,0 +1,239 @@
+package keystore_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+)
+
+// mockSigner is an external signer serving the eth_accounts and eth_signTransaction JSON-RPC methods of web3signer.
+type mockSigner struct {
+	*httptest.Server
+	key ethkey.KeyV2
+
+	mu sync.Mutex
+	// signingKey signs the transactions instead of key when set
+	signingKey *ethkey.KeyV2
+	// tamper modifies the transactions before they are signed when set
+	tamper func(*types.LegacyTx)
+	// noAccounts makes the signer not implement eth_accounts, like clef
+	noAccounts bool
+	// objectResult makes the signer return the signed transaction in the raw field of an object, like geth and clef
+	objectResult bool
+}
+
+func newMockSigner(t *testing.T) *mockSigner {
+	key, err := ethkey.NewV2()
+	require.NoError(t, err)
+	s := &mockSigner{key: key}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.Close)
+	return s
+}
+
+type mockSignerTxArgs struct {
+	From                 common.Address  `json:"from"`
+	To                   *common.Address `json:"to"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	GasPrice             *hexutil.Big    `json:"gasPrice"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	Value                *hexutil.Big    `json:"value"`
+	Nonce                hexutil.Uint64  `json:"nonce"`
+	Data                 hexutil.Bytes   `json:"data"`
+	ChainID              *hexutil.Big    `json:"chainId"`
+}
+
+func (s *mockSigner) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var req struct {
+		ID     int64             `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	respond := func(result interface{}, rpcErr map[string]interface{}) {
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		if rpcErr != nil {
+			resp["error"] = rpcErr
+		} else {
+			resp["result"] = result
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}
+
+	switch req.Method {
+	case "eth_accounts":
+		if s.noAccounts {
+			respond(nil, map[string]interface{}{"code": -32601, "message": "the method eth_accounts does not exist/is not available"})
+			return
+		}
+		respond([]common.Address{s.key.Address}, nil)
+	case "eth_signTransaction":
+		var args mockSignerTxArgs
+		if len(req.Params) != 1 || json.Unmarshal(req.Params[0], &args) != nil || args.From != s.key.Address {
+			respond(nil, map[string]interface{}{"code": -32602, "message": "invalid params"})
+			return
+		}
+		var txData types.TxData
+		if args.MaxFeePerGas != nil {
+			txData = &types.DynamicFeeTx{ChainID: args.ChainID.ToInt(), Nonce: uint64(args.Nonce), GasTipCap: args.MaxPriorityFeePerGas.ToInt(),
+				GasFeeCap: args.MaxFeePerGas.ToInt(), Gas: uint64(args.Gas), To: args.To, Value: args.Value.ToInt(), Data: args.Data}
+		} else {
+			legacyTx := &types.LegacyTx{Nonce: uint64(args.Nonce), GasPrice: args.GasPrice.ToInt(), Gas: uint64(args.Gas), To: args.To,
+				Value: args.Value.ToInt(), Data: args.Data}
+			if s.tamper != nil {
+				s.tamper(legacyTx)
+			}
+			txData = legacyTx
+		}
+		key := s.key
+		if s.signingKey != nil {
+			key = *s.signingKey
+		}
+		signed, err := types.SignNewTx(key.ToEcdsaPrivKey(), types.LatestSignerForChainID(args.ChainID.ToInt()), txData)
+		if err != nil {
+			respond(nil, map[string]interface{}{"code": -32000, "message": err.Error()})
+			return
+		}
+		raw, err := signed.MarshalBinary()
+		if err != nil {
+			respond(nil, map[string]interface{}{"code": -32000, "message": err.Error()})
+			return
+		}
+		if s.objectResult {
+			respond(map[string]interface{}{"raw": hexutil.Bytes(raw), "tx": signed}, nil)
+			return
+		}
+		respond(hexutil.Bytes(raw), nil)
+	default:
+		respond(nil, map[string]interface{}{"code": -32601, "message": "method not found"})
+	}
+}
+
+func Test_EthKeyStore_RemoteKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := keystore.ExposedNewMaster(t, db)
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	ethKeyStore := keyStore.Eth()
+	signer := newMockSigner(t)
+	chainID := &cltest.FixtureChainID
+
+	t.Run("rejects an invalid signer", func(t *testing.T) {
+		_, err := ethKeyStore.ImportRemote(ctx, signer.key.Address, "ftp://localhost", chainID)
+		require.ErrorContains(t, err, "must be an http or https URL")
+
+		_, err = ethKeyStore.ImportRemote(ctx, testutils.NewAddress(), signer.URL, chainID)
+		require.ErrorContains(t, err, "external signer has no account")
+	})
+
+	key, err := ethKeyStore.ImportRemote(ctx, signer.key.Address, signer.URL, chainID)
+	require.NoError(t, err)
+	assert.True(t, key.IsRemote())
+	assert.Equal(t, signer.key.Address, key.Address)
+
+	t.Run("behaves like a local key", func(t *testing.T) {
+		_, err := ethKeyStore.ImportRemote(ctx, signer.key.Address, signer.URL, chainID)
+		require.ErrorIs(t, err, keystore.ErrKeyExists)
+
+		keys, err := ethKeyStore.EnabledKeysForChain(ctx, chainID)
+		require.NoError(t, err)
+		require.Equal(t, []ethkey.KeyV2{key}, keys)
+		address, err := ethKeyStore.GetRoundRobinAddress(ctx, chainID)
+		require.NoError(t, err)
+		assert.Equal(t, key.Address, address)
+
+		_, err = ethKeyStore.Export(ctx, key.ID(), cltest.Password)
+		require.ErrorIs(t, err, ethkey.ErrRemoteKey)
+	})
+
+	t.Run("signs transactions with the external signer", func(t *testing.T) {
+		to := testutils.NewAddress()
+		legacyTx := cltest.NewLegacyTransaction(3, to, big.NewInt(53), 21000, big.NewInt(1000000000), []byte{1, 2, 3, 4})
+		dynamicFeeTx := types.NewTx(&types.DynamicFeeTx{ChainID: chainID, Nonce: 4, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(2000000000),
+			Gas: 21000, To: &to, Value: big.NewInt(53), Data: []byte{1, 2, 3, 4}})
+
+		for _, objectResult := range []bool{false, true} {
+			signer.mu.Lock()
+			signer.objectResult = objectResult
+			signer.mu.Unlock()
+			for _, tx := range []*types.Transaction{legacyTx, dynamicFeeTx} {
+				signed, err := ethKeyStore.SignTx(ctx, key.Address, tx, chainID)
+				require.NoError(t, err)
+				sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+				require.NoError(t, err)
+				assert.Equal(t, key.Address, sender)
+				assert.Equal(t, tx.Type(), signed.Type())
+				assert.Equal(t, tx.Nonce(), signed.Nonce())
+			}
+		}
+	})
+
+	t.Run("rejects transactions the external signer modified", func(t *testing.T) {
+		tx := cltest.NewLegacyTransaction(5, testutils.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), nil)
+
+		signer.mu.Lock()
+		signer.tamper = func(tx *types.LegacyTx) { tx.To = &common.Address{} }
+		signer.mu.Unlock()
+		_, err := ethKeyStore.SignTx(ctx, key.Address, tx, chainID)
+		require.ErrorContains(t, err, "signed a different transaction than the requested one")
+
+		otherKey, err := ethkey.NewV2()
+		require.NoError(t, err)
+		signer.mu.Lock()
+		signer.tamper = nil
+		signer.signingKey = &otherKey
+		signer.mu.Unlock()
+		_, err = ethKeyStore.SignTx(ctx, key.Address, tx, chainID)
+		require.ErrorContains(t, err, "instead of key")
+
+		signer.mu.Lock()
+		signer.signingKey = nil
+		signer.mu.Unlock()
+	})
+
+	t.Run("is persisted in the key ring", func(t *testing.T) {
+		reloaded := keystore.ExposedNewMaster(t, db)
+		require.NoError(t, reloaded.Unlock(ctx, cltest.Password))
+		reloadedKey, err := reloaded.Eth().Get(ctx, key.ID())
+		require.NoError(t, err)
+		assert.Equal(t, key, reloadedKey)
+		assert.Equal(t, signer.URL, reloadedKey.SignerURL())
+	})
+
+	t.Run("accepts signers which don't list their accounts", func(t *testing.T) {
+		clef := newMockSigner(t)
+		clef.noAccounts = true
+		_, err := ethKeyStore.ImportRemote(ctx, clef.key.Address, clef.URL, chainID)
+		require.NoError(t, err)
+	})
+
+	t.Run("fails when the signer is unreachable", func(t *testing.T) {
+		signer.Close()
+		_, err := ethKeyStore.SignTx(ctx, key.Address, cltest.NewLegacyTransaction(6, testutils.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), nil), chainID)
+		require.ErrorContains(t, err, "external signer failed to sign transaction")
+	})
+}
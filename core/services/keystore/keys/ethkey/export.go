@@ -15,7 +15,13 @@ type EncryptedEthKeyExport struct {
 	Crypto  keystore.CryptoJSON `json:"crypto"`
 }
 
+// ErrRemoteKey is returned by the operations which require the private key of a remote key.
+var ErrRemoteKey = errors.New("the private key of a remote eth key is held by its external signer")
+
 func (key KeyV2) ToEncryptedJSON(password string, scryptParams utils.ScryptParams) (export []byte, err error) {
+	if key.IsRemote() {
+		return nil, ErrRemoteKey
+	}
 	// DEV: uuid is derived directly from the address, since it is not stored internally
 	id, err := uuid.FromBytes(key.Address.Bytes()[:16])
 	if err != nil {
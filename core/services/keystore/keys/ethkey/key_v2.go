@@ -40,12 +40,32 @@ func (raw Raw) GoString() string {
 	return raw.String()
 }
 
+// RemoteRaw is the serialization of a remote key: its address, followed by the URL of its signer.
+type RemoteRaw []byte
+
+func (raw RemoteRaw) Key() KeyV2 {
+	if len(raw) < common.AddressLength {
+		return KeyV2{}
+	}
+	return NewRemote(common.BytesToAddress(raw[:common.AddressLength]), string(raw[common.AddressLength:]))
+}
+
+func (raw RemoteRaw) String() string {
+	return "<Eth Remote Key>"
+}
+
+func (raw RemoteRaw) GoString() string {
+	return raw.String()
+}
+
 var _ fmt.GoStringer = &KeyV2{}
 
 type KeyV2 struct {
 	Address      common.Address
 	EIP55Address types.EIP55Address
 	privateKey   *ecdsa.PrivateKey
+	// signerURL is the URL of the external signer holding the private key of a remote key.
+	signerURL string
 }
 
 func NewV2() (KeyV2, error) {
@@ -66,19 +86,55 @@ func FromPrivateKey(privKey *ecdsa.PrivateKey) (key KeyV2) {
 	}
 }
 
+// NewRemote returns a key whose private key never leaves the external signer at signerURL, which signs its
+// transactions with the eth_signTransaction JSON-RPC method.
+func NewRemote(address common.Address, signerURL string) KeyV2 {
+	return KeyV2{
+		Address:      address,
+		EIP55Address: types.EIP55AddressFromAddress(address),
+		signerURL:    signerURL,
+	}
+}
+
 func (key KeyV2) ID() string {
 	return key.Address.Hex()
 }
 
+// Raw returns nil for remote keys.
 func (key KeyV2) Raw() Raw {
+	if key.privateKey == nil {
+		return nil
+	}
 	return key.privateKey.D.Bytes()
 }
 
+// RemoteRaw returns nil for local keys.
+func (key KeyV2) RemoteRaw() RemoteRaw {
+	if !key.IsRemote() {
+		return nil
+	}
+	return append(key.Address.Bytes(), key.signerURL...)
+}
+
+// ToEcdsaPrivKey returns nil for remote keys.
 func (key KeyV2) ToEcdsaPrivKey() *ecdsa.PrivateKey {
 	return key.privateKey
 }
 
+// IsRemote returns whether the private key is held by an external signer.
+func (key KeyV2) IsRemote() bool {
+	return key.signerURL != ""
+}
+
+// SignerURL returns the URL of the external signer of a remote key. It may contain credentials.
+func (key KeyV2) SignerURL() string {
+	return key.signerURL
+}
+
 func (key KeyV2) String() string {
+	if key.IsRemote() {
+		return fmt.Sprintf("EthKeyV2{Remote: true, Address: %s}", key.Address)
+	}
 	return fmt.Sprintf("EthKeyV2{PrivateKey: <redacted>, Address: %s}", key.Address)
 }
 
@@ -45,6 +45,21 @@ func (o *memoryORM) getEncryptedKeyRing(ctx context.Context) (encryptedKeyRing,
 	return *o.keyRing, nil
 }
 
+func (o *memoryORM) rotateEncryptedKeyRing(ctx context.Context, rotate func(encryptedKeyRing) (encryptedKeyRing, error)) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	var kr encryptedKeyRing
+	if o.keyRing != nil {
+		kr = *o.keyRing
+	}
+	rotated, err := rotate(kr)
+	if err != nil {
+		return err
+	}
+	o.keyRing = &rotated
+	return nil
+}
+
 func newInMemoryORM(ds sqlutil.DataSource) *memoryORM {
 	return &memoryORM{ds: ds}
 }
@@ -51,6 +51,11 @@ type Master interface {
 	VRF() VRF
 	Unlock(ctx context.Context, password string) error
 	IsEmpty(ctx context.Context) (bool, error)
+	// RotatePassword re-encrypts all the keys with newPassword and the scrypt params of the key store.
+	RotatePassword(ctx context.Context, oldPassword, newPassword string) error
+	// Backup returns an encrypted backup of all the keys and eth key states, which Restore adds back to a keystore.
+	Backup(ctx context.Context, password string) ([]byte, error)
+	Restore(ctx context.Context, backupJSON []byte, password string, dryRun bool) (RestoreReport, error)
 }
 
 type master struct {
@@ -146,6 +151,7 @@ type ORM interface {
 	isEmpty(context.Context) (bool, error)
 	saveEncryptedKeyRing(context.Context, *encryptedKeyRing, ...func(sqlutil.DataSource) error) error
 	getEncryptedKeyRing(context.Context) (encryptedKeyRing, error)
+	rotateEncryptedKeyRing(context.Context, func(encryptedKeyRing) (encryptedKeyRing, error)) error
 }
 
 type keystateORM interface {
@@ -198,6 +204,53 @@ func (km *keyManager) Unlock(ctx context.Context, password string) error {
 	return nil
 }
 
+// RotatePassword re-encrypts the key ring with newPassword and the scrypt params of the key store, in the transaction
+// which reads it from the DB. The re-encrypted key ring is decrypted with newPassword and compared with the original
+// one before the transaction is committed, so a failed rotation leaves the keys encrypted with oldPassword.
+func (km *keyManager) RotatePassword(ctx context.Context, oldPassword, newPassword string) error {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if !km.isLocked() && oldPassword != km.password {
+		return errors.New("old password does not match the password of the unlocked keystore")
+	}
+	if newPassword == "" {
+		return errors.New("new password must not be empty")
+	}
+
+	var rotated *keyRing
+	err := km.orm.rotateEncryptedKeyRing(ctx, func(ekr encryptedKeyRing) (encryptedKeyRing, error) {
+		if len(ekr.EncryptedKeys) == 0 {
+			return encryptedKeyRing{}, errors.New("keystore is empty")
+		}
+		kr, err := ekr.Decrypt(oldPassword)
+		if err != nil {
+			return encryptedKeyRing{}, errors.Wrap(err, "unable to decrypt encrypted key ring with old password")
+		}
+		rekr, err := kr.Encrypt(newPassword, km.scryptParams)
+		if err != nil {
+			return encryptedKeyRing{}, errors.Wrap(err, "unable to encrypt keyRing")
+		}
+		rotated, err = rekr.Decrypt(newPassword)
+		if err != nil {
+			return encryptedKeyRing{}, errors.Wrap(err, "unable to verify re-encrypted key ring")
+		}
+		if err = kr.verifyEqual(rotated); err != nil {
+			return encryptedKeyRing{}, errors.Wrap(err, "unable to verify re-encrypted key ring")
+		}
+		return rekr, nil
+	})
+	if err != nil {
+		return err
+	}
+	rotated.logPubKeys(km.logger)
+
+	if !km.isLocked() {
+		km.keyRing = rotated
+		km.password = newPassword
+	}
+	return nil
+}
+
 // caller must hold lock!
 func (km *keyManager) save(ctx context.Context, callbacks ...func(sqlutil.DataSource) error) error {
 	ekb, err := km.keyRing.Encrypt(km.password, km.scryptParams)
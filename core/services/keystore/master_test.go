@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/chaintype"
 )
 
 func TestMasterKeystore_Unlock_Save(t *testing.T) {
@@ -64,3 +66,73 @@ func TestMasterKeystore_Unlock_Save(t *testing.T) {
 		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
 	})
 }
+
+func TestMasterKeystore_RotatePassword(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := keystore.ExposedNewMaster(t, db)
+	const newPassword = "n3w-k3yst0re-p4ssw0rd"
+
+	t.Run("fails on an empty keystore", func(t *testing.T) {
+		require.ErrorContains(t, keyStore.RotatePassword(ctx, cltest.Password, newPassword), "keystore is empty")
+	})
+
+	require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	ethKey, _ := cltest.MustInsertRandomKey(t, keyStore.Eth())
+	p2pKey, err := keyStore.P2P().Create(ctx)
+	require.NoError(t, err)
+	ocr2Key, err := keyStore.OCR2().Create(ctx, chaintype.EVM)
+	require.NoError(t, err)
+	vrfKey, err := keyStore.VRF().Create(ctx)
+	require.NoError(t, err)
+
+	requireKeys := func(t *testing.T, ks keystore.Master) {
+		gotEthKey, err := ks.Eth().Get(ctx, ethKey.ID())
+		require.NoError(t, err)
+		assert.Equal(t, ethKey, gotEthKey)
+		gotP2PKey, err := ks.P2P().Get(p2pKey.PeerID())
+		require.NoError(t, err)
+		assert.Equal(t, p2pKey.Raw(), gotP2PKey.Raw())
+		gotOCR2Key, err := ks.OCR2().Get(ocr2Key.ID())
+		require.NoError(t, err)
+		assert.Equal(t, ocr2Key.Raw(), gotOCR2Key.Raw())
+		gotVRFKey, err := ks.VRF().Get(vrfKey.ID())
+		require.NoError(t, err)
+		assert.Equal(t, vrfKey.Raw(), gotVRFKey.Raw())
+	}
+
+	t.Run("rejects a wrong old password", func(t *testing.T) {
+		require.ErrorContains(t, keyStore.RotatePassword(ctx, "wrong password", newPassword), "old password does not match")
+
+		locked := keystore.ExposedNewMaster(t, db)
+		require.ErrorContains(t, locked.RotatePassword(ctx, "wrong password", newPassword), "unable to decrypt encrypted key ring with old password")
+		require.NoError(t, locked.Unlock(ctx, cltest.Password))
+	})
+
+	t.Run("re-encrypts all the keys with the new password", func(t *testing.T) {
+		require.NoError(t, keyStore.RotatePassword(ctx, cltest.Password, newPassword))
+		requireKeys(t, keyStore)
+		// The unlocked keystore saves the keys with the new password
+		_, err := keyStore.CSA().Create(ctx)
+		require.NoError(t, err)
+
+		require.Error(t, keystore.ExposedNewMaster(t, db).Unlock(ctx, cltest.Password))
+		reloaded := keystore.ExposedNewMaster(t, db)
+		require.NoError(t, reloaded.Unlock(ctx, newPassword))
+		requireKeys(t, reloaded)
+		csaKeys, err := reloaded.CSA().GetAll()
+		require.NoError(t, err)
+		assert.Len(t, csaKeys, 1)
+	})
+
+	t.Run("rotates the password of a locked keystore", func(t *testing.T) {
+		locked := keystore.ExposedNewMaster(t, db)
+		require.NoError(t, locked.RotatePassword(ctx, newPassword, cltest.Password))
+
+		reloaded := keystore.ExposedNewMaster(t, db)
+		require.NoError(t, reloaded.Unlock(ctx, cltest.Password))
+		requireKeys(t, reloaded)
+	})
+}
@@ -516,6 +516,41 @@ func (_m *Eth) Import(ctx context.Context, keyJSON []byte, password string, chai
 	return r0, r1
 }
 
+// ImportRemote provides a mock function with given fields: ctx, address, signerURL, chainIDs
+func (_m *Eth) ImportRemote(ctx context.Context, address common.Address, signerURL string, chainIDs ...*big.Int) (ethkey.KeyV2, error) {
+	_va := make([]interface{}, len(chainIDs))
+	for _i := range chainIDs {
+		_va[_i] = chainIDs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, address, signerURL)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportRemote")
+	}
+
+	var r0 ethkey.KeyV2
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, string, ...*big.Int) (ethkey.KeyV2, error)); ok {
+		return rf(ctx, address, signerURL, chainIDs...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, string, ...*big.Int) ethkey.KeyV2); ok {
+		r0 = rf(ctx, address, signerURL, chainIDs...)
+	} else {
+		r0 = ret.Get(0).(ethkey.KeyV2)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Address, string, ...*big.Int) error); ok {
+		r1 = rf(ctx, address, signerURL, chainIDs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SignTx provides a mock function with given fields: ctx, fromAddress, tx, chainID
 func (_m *Eth) SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
 	ret := _m.Called(ctx, fromAddress, tx, chainID)
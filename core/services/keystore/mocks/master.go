@@ -14,6 +14,36 @@ type Master struct {
 	mock.Mock
 }
 
+// Backup provides a mock function with given fields: ctx, password
+func (_m *Master) Backup(ctx context.Context, password string) ([]byte, error) {
+	ret := _m.Called(ctx, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Backup")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]byte, error)); ok {
+		return rf(ctx, password)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = rf(ctx, password)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CSA provides a mock function with given fields:
 func (_m *Master) CSA() keystore.CSA {
 	ret := _m.Called()
@@ -202,6 +232,52 @@ func (_m *Master) P2P() keystore.P2P {
 	return r0
 }
 
+// Restore provides a mock function with given fields: ctx, backupJSON, password, dryRun
+func (_m *Master) Restore(ctx context.Context, backupJSON []byte, password string, dryRun bool) (keystore.RestoreReport, error) {
+	ret := _m.Called(ctx, backupJSON, password, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 keystore.RestoreReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, string, bool) (keystore.RestoreReport, error)); ok {
+		return rf(ctx, backupJSON, password, dryRun)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, string, bool) keystore.RestoreReport); ok {
+		r0 = rf(ctx, backupJSON, password, dryRun)
+	} else {
+		r0 = ret.Get(0).(keystore.RestoreReport)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, string, bool) error); ok {
+		r1 = rf(ctx, backupJSON, password, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RotatePassword provides a mock function with given fields: ctx, oldPassword, newPassword
+func (_m *Master) RotatePassword(ctx context.Context, oldPassword string, newPassword string) error {
+	ret := _m.Called(ctx, oldPassword, newPassword)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotatePassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, oldPassword, newPassword)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Solana provides a mock function with given fields:
 func (_m *Master) Solana() keystore.Solana {
 	ret := _m.Called()
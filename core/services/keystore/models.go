@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"slices"
 	"time"
 
 	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
@@ -208,11 +209,55 @@ func (kr *keyRing) Encrypt(password string, scryptParams utils.ScryptParams) (ek
 	}, nil
 }
 
+// verifyEqual returns an error if other does not hold exactly the keys of kr, including the legacy ones.
+func (kr *keyRing) verifyEqual(other *keyRing) error {
+	keys, err := kr.allRawKeys()
+	if err != nil {
+		return err
+	}
+	otherKeys, err := other.allRawKeys()
+	if err != nil {
+		return err
+	}
+	for name := range otherKeys {
+		if !keys.has(name) {
+			keys[name] = nil
+		}
+	}
+	for name, values := range keys {
+		otherValues := otherKeys[name]
+		slices.Sort(values)
+		slices.Sort(otherValues)
+		if !slices.Equal(values, otherValues) {
+			return errors.Errorf("%s keys differ: expected %d keys, got %d", name, len(values), len(otherValues))
+		}
+	}
+	return nil
+}
+
+// allRawKeys returns the raw keys of each type which are encrypted in the key ring.
+func (kr *keyRing) allRawKeys() (rawLegacyKeys, error) {
+	marshalledRawKeyRingJson, err := json.Marshal(kr.raw())
+	if err != nil {
+		return nil, err
+	}
+	marshalledRawKeyRingJson, err = kr.LegacyKeys.UnloadUnsupported(marshalledRawKeyRingJson)
+	if err != nil {
+		return nil, err
+	}
+	keys := rawLegacyKeys{}
+	return keys, json.Unmarshal(marshalledRawKeyRingJson, &keys)
+}
+
 func (kr *keyRing) raw() (rawKeys rawKeyRing) {
 	for _, csaKey := range kr.CSA {
 		rawKeys.CSA = append(rawKeys.CSA, csaKey.Raw())
 	}
 	for _, ethKey := range kr.Eth {
+		if ethKey.IsRemote() {
+			rawKeys.EthRemote = append(rawKeys.EthRemote, ethKey.RemoteRaw())
+			continue
+		}
 		rawKeys.Eth = append(rawKeys.Eth, ethKey.Raw())
 	}
 	for _, ocrKey := range kr.OCR {
@@ -334,6 +379,7 @@ func (kr *keyRing) logPubKeys(lggr logger.Logger) {
 // (like public keys) to the database
 type rawKeyRing struct {
 	Eth        []ethkey.Raw
+	EthRemote  []ethkey.RemoteRaw
 	CSA        []csakey.Raw
 	OCR        []ocrkey.Raw
 	OCR2       []ocr2key.Raw
@@ -357,6 +403,10 @@ func (rawKeys rawKeyRing) keys() (*keyRing, error) {
 		ethKey := rawETHKey.Key()
 		keyRing.Eth[ethKey.ID()] = ethKey
 	}
+	for _, rawETHRemoteKey := range rawKeys.EthRemote {
+		ethKey := rawETHRemoteKey.Key()
+		keyRing.Eth[ethKey.ID()] = ethKey
+	}
 	for _, rawOCRKey := range rawKeys.OCR {
 		ocrKey := rawOCRKey.Key()
 		keyRing.OCR[ocrKey.ID()] = ocrKey
@@ -27,6 +27,7 @@ const password = "password"
 func TestKeyRing_Encrypt_Decrypt(t *testing.T) {
 	csa1, csa2 := csakey.MustNewV2XXXTestingOnly(big.NewInt(1)), csakey.MustNewV2XXXTestingOnly(big.NewInt(2))
 	eth1, eth2 := mustNewEthKey(t), mustNewEthKey(t)
+	ethRemote := ethkey.NewRemote(mustNewEthKey(t).Address, "http://localhost:9000")
 	ocr := []ocrkey.KeyV2{
 		ocrkey.MustNewV2XXXTestingOnly(big.NewInt(1)),
 		ocrkey.MustNewV2XXXTestingOnly(big.NewInt(2)),
@@ -47,6 +48,7 @@ func TestKeyRing_Encrypt_Decrypt(t *testing.T) {
 	originalKeyRingRaw := rawKeyRing{
 		CSA:        []csakey.Raw{csa1.Raw(), csa2.Raw()},
 		Eth:        []ethkey.Raw{eth1.Raw(), eth2.Raw()},
+		EthRemote:  []ethkey.RemoteRaw{ethRemote.RemoteRaw()},
 		OCR:        []ocrkey.Raw{ocr[0].Raw(), ocr[1].Raw()},
 		OCR2:       ocr2_raw,
 		P2P:        []p2pkey.Raw{p2p1.Raw(), p2p2.Raw()},
@@ -73,9 +75,10 @@ func TestKeyRing_Encrypt_Decrypt(t *testing.T) {
 		require.Equal(t, originalKeyRing.CSA[csa1.ID()].PublicKey, decryptedKeyRing.CSA[csa1.ID()].PublicKey)
 		require.Equal(t, originalKeyRing.CSA[csa2.ID()].PublicKey, decryptedKeyRing.CSA[csa2.ID()].PublicKey)
 		// compare eth keys
-		require.Equal(t, 2, len(decryptedKeyRing.Eth))
+		require.Equal(t, 3, len(decryptedKeyRing.Eth))
 		require.Equal(t, originalKeyRing.Eth[eth1.ID()].Address, decryptedKeyRing.Eth[eth1.ID()].Address)
 		require.Equal(t, originalKeyRing.Eth[eth2.ID()].Address, decryptedKeyRing.Eth[eth2.ID()].Address)
+		require.Equal(t, ethRemote, decryptedKeyRing.Eth[ethRemote.ID()])
 		// compare ocr keys
 		require.Equal(t, 2, len(decryptedKeyRing.OCR))
 		require.Equal(t, originalKeyRing.OCR[ocr[0].ID()].OnChainSigning.X, decryptedKeyRing.OCR[ocr[0].ID()].OnChainSigning.X)
@@ -168,3 +171,25 @@ func TestKeyRing_Encrypt_Decrypt(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestKeyRing_verifyEqual(t *testing.T) {
+	kr := newKeyRing()
+	eth1, eth2 := mustNewEthKey(t), mustNewEthKey(t)
+	kr.Eth[eth1.ID()] = *eth1
+	kr.Eth[eth2.ID()] = *eth2
+	kr.LegacyKeys.legacyRawKeys = rawLegacyKeys{"foo": {"bar"}}
+
+	ekr, err := kr.Encrypt(password, utils.FastScryptParams)
+	require.NoError(t, err)
+	decrypted, err := ekr.Decrypt(password)
+	require.NoError(t, err)
+	require.NoError(t, kr.verifyEqual(decrypted))
+
+	delete(decrypted.Eth, eth2.ID())
+	require.ErrorContains(t, kr.verifyEqual(decrypted), "Eth keys differ")
+
+	decrypted.Eth[eth2.ID()] = *eth2
+	decrypted.LegacyKeys.legacyRawKeys = rawLegacyKeys{}
+	require.ErrorContains(t, kr.verifyEqual(decrypted), "foo keys differ")
+	require.ErrorContains(t, decrypted.verifyEqual(kr), "foo keys differ")
+}
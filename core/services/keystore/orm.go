@@ -52,6 +52,29 @@ func (orm ksORM) saveEncryptedKeyRing(ctx context.Context, kr *encryptedKeyRing,
 	})
 }
 
+// rotateEncryptedKeyRing replaces the key ring with the one returned by rotate, in a transaction which locks the key
+// ring from the time it is read.
+func (orm ksORM) rotateEncryptedKeyRing(ctx context.Context, rotate func(encryptedKeyRing) (encryptedKeyRing, error)) error {
+	return sqlutil.TransactDataSource(ctx, orm.ds, nil, func(tx sqlutil.DataSource) error {
+		var kr encryptedKeyRing
+		err := tx.GetContext(ctx, &kr, `SELECT * FROM encrypted_key_rings LIMIT 1 FOR UPDATE`)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("keystore is empty")
+		} else if err != nil {
+			return errors.Wrap(err, "while loading keyring")
+		}
+		rotated, err := rotate(kr)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `
+		UPDATE encrypted_key_rings
+		SET encrypted_keys = $1, updated_at = NOW()
+	`, rotated.EncryptedKeys)
+		return errors.Wrap(err, "while saving keyring")
+	})
+}
+
 func (orm ksORM) getEncryptedKeyRing(ctx context.Context) (kr encryptedKeyRing, err error) {
 	err = orm.ds.GetContext(ctx, &kr, `SELECT * FROM encrypted_key_rings LIMIT 1`)
 	if errors.Is(err, sql.ErrNoRows) {
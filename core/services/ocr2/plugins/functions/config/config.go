@@ -43,6 +43,7 @@ type PluginConfig struct {
 	MaxSecretsSizesList                      []uint32                                  `json:"maxSecretsSizesList"`
 	MinimumSubscriptionBalance               assets.Link                               `json:"minimumSubscriptionBalance"`
 	AllowedHeartbeatInitiators               []string                                  `json:"allowedHeartbeatInitiators"`
+	AllowedSecretsAdmins                     []string                                  `json:"allowedSecretsAdmins"`
 	GatewayConnectorConfig                   *connector.ConnectorConfig                `json:"gatewayConnectorConfig"`
 	OnchainAllowlist                         *allowlist.OnchainAllowlistConfig         `json:"onchainAllowlist"`
 	OnchainSubscriptions                     *subscriptions.OnchainSubscriptionsConfig `json:"onchainSubscriptions"`
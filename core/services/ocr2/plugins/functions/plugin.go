@@ -211,6 +211,9 @@ func NewConnector(ctx context.Context, pluginConfig *config.PluginConfig, ethKey
 	if idx == -1 {
 		return nil, errors.New("key for configured node address not found")
 	}
+	if enabledKeys[idx].IsRemote() {
+		return nil, errors.Wrap(ethkey.ErrRemoteKey, "gateway connector requires a local key")
+	}
 	signerKey := enabledKeys[idx].ToEcdsaPrivKey()
 	if enabledKeys[idx].ID() != pluginConfig.GatewayConnectorConfig.NodeAddress {
 		return nil, errors.New("node address mismatch")
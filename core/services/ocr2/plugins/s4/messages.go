@@ -64,6 +64,18 @@ func UnmarshalAddress(address []byte) *ubig.Big {
 
 func (row *Row) VerifySignature() error {
 	address := common.BytesToAddress(row.Address)
+	if len(row.Batch) > 0 {
+		key := &s4.Key{
+			Address: address,
+			SlotId:  uint(row.Slotid),
+			Version: row.Version,
+		}
+		record := &s4.Record{
+			Payload:    row.Payload,
+			Expiration: row.Expiration,
+		}
+		return s4.VerifyBatchRecord(row.Batch, row.Signature, key, record)
+	}
 	e := &s4.Envelope{
 		Address:    address.Bytes(),
 		SlotID:     uint(row.Slotid),
@@ -119,4 +119,38 @@ func Test_VerifySignature(t *testing.T) {
 		sameRow := marshalUnmarshal(t, row)
 		require.NoError(t, sameRow.VerifySignature())
 	})
+
+	t.Run("batch", func(t *testing.T) {
+		pk, addr := testutils.NewPrivateKeyAndAddress(t)
+		rows := generateTestRows(t, 2, time.Minute)
+		entries := make([]*s4_svc.BatchEntry, len(rows))
+		for i, row := range rows {
+			row.Address = addr.Bytes()
+			row.Slotid = uint32(i)
+			entries[i] = &s4_svc.BatchEntry{
+				SlotId:  uint(row.Slotid),
+				Version: row.Version,
+				Record: s4_svc.Record{
+					Payload:    row.Payload,
+					Expiration: row.Expiration,
+				},
+			}
+		}
+		env := s4_svc.NewBatchEnvelope(addr, entries)
+		signature, err := env.Sign(pk)
+		require.NoError(t, err)
+		batch, err := env.ToJson()
+		require.NoError(t, err)
+
+		for _, row := range rows {
+			row.Signature = signature
+			row.Batch = batch
+			require.NoError(t, row.VerifySignature())
+			sameRow := marshalUnmarshal(t, row)
+			require.NoError(t, sameRow.VerifySignature())
+		}
+
+		rows[0].Payload = []byte("tampered")
+		require.ErrorIs(t, rows[0].VerifySignature(), s4_svc.ErrWrongSignature)
+	})
 }
@@ -271,6 +271,7 @@ func (c *plugin) ShouldAcceptFinalizedReport(ctx context.Context, ts types.Repor
 			Expiration: row.Expiration,
 			Confirmed:  true,
 			Signature:  row.Signature,
+			Batch:      row.Batch,
 		}
 
 		now := time.Now().UnixMilli()
@@ -316,6 +317,7 @@ func convertRow(from *s4.Row) *Row {
 		Expiration: from.Expiration,
 		Payload:    from.Payload,
 		Signature:  from.Signature,
+		Batch:      from.Batch,
 	}
 }
 
@@ -0,0 +1,209 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+var (
+	promCircuitBreakerRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_circuit_breaker_rejections_total",
+		Help: "Number of http and bridge task requests which were not sent because the circuit of their data source was open",
+	},
+		[]string{"pipeline_task_spec_id"},
+	)
+	promCircuitBreakerOpenings = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "pipeline_circuit_breaker_openings_total",
+		Help: "Number of times the circuit of an http or bridge task data source was opened",
+	})
+)
+
+// ErrCircuitOpen is returned by the http and bridge tasks instead of sending a request to a data source
+// which failed too often recently. It is not retryable.
+var ErrCircuitOpen = errors.New("circuit breaker is open: the data source failed too many times recently")
+
+// circuitBreakerSweepInterval is the minimum interval between two removals of the closed circuits without recent failures.
+const circuitBreakerSweepInterval = time.Minute
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+type circuit struct {
+	state circuitState
+	// failures are the times of the failures within the failure window, oldest first, while closed.
+	failures []time.Time
+	openedAt time.Time
+}
+
+// circuitBreakers tracks the failures of the data sources of the http and bridge tasks, across all the
+// pipeline runs of the node. Only the data sources which failed recently have a circuit.
+//
+// A data source which fails failureThreshold times within failureWindow has its circuit opened: its
+// requests are rejected with ErrCircuitOpen for openTimeout. After that, the circuit is half-open and a
+// single request is let through as a probe. The circuit closes if the probe succeeds, and opens again
+// otherwise.
+type circuitBreakers struct {
+	failureThreshold int
+	failureWindow    time.Duration
+	openTimeout      time.Duration
+	lggr             logger.Logger
+	now              func() time.Time
+
+	mu        sync.Mutex
+	circuits  map[string]*circuit
+	nextSweep time.Time
+}
+
+// newCircuitBreakers returns nil if circuit breaking is disabled. A nil *circuitBreakers allows every request.
+func newCircuitBreakers(cfg Config, lggr logger.Logger) *circuitBreakers {
+	if !cfg.CircuitBreakerEnabled() {
+		return nil
+	}
+	return &circuitBreakers{
+		failureThreshold: int(cfg.CircuitBreakerFailureThreshold()),
+		failureWindow:    cfg.CircuitBreakerFailureWindow(),
+		openTimeout:      cfg.CircuitBreakerOpenTimeout(),
+		lggr:             lggr.Named("CircuitBreakers"),
+		now:              time.Now,
+		circuits:         make(map[string]*circuit),
+	}
+}
+
+func httpCircuitKey(url URLParam) string {
+	return "http:" + url.String()
+}
+
+func bridgeCircuitKey(name StringParam) string {
+	return "bridge:" + string(name)
+}
+
+// circuitName returns key without its URL query, which may contain credentials, for logging.
+func circuitName(key string) string {
+	name, _, _ := strings.Cut(key, "?")
+	return name
+}
+
+// isDataSourceFailure returns whether a request failed because of its data source: client errors are
+// caused by the request itself.
+func isDataSourceFailure(statusCode int, err error) bool {
+	return statusCode >= 500 || (err != nil && statusCode < 400)
+}
+
+// allow returns ErrCircuitOpen if the request of the task dotID to the data source identified by key must
+// not be sent. Otherwise, the outcome of the request must be reported by calling report with the context
+// it was made with.
+func (c *circuitBreakers) allow(dotID string, key string) (report func(ctx context.Context, statusCode int, err error), err error) {
+	if c == nil {
+		return func(context.Context, int, error) {}, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	probe := false
+	if cb, ok := c.circuits[key]; ok {
+		switch cb.state {
+		case circuitOpen:
+			if c.now().Sub(cb.openedAt) < c.openTimeout {
+				promCircuitBreakerRejections.WithLabelValues(dotID).Inc()
+				return nil, ErrCircuitOpen
+			}
+			cb.state = circuitHalfOpen
+			probe = true
+		case circuitHalfOpen:
+			// Another request is already probing the data source.
+			promCircuitBreakerRejections.WithLabelValues(dotID).Inc()
+			return nil, ErrCircuitOpen
+		case circuitClosed:
+		}
+	}
+
+	return func(ctx context.Context, statusCode int, err error) {
+		c.report(key, probe, errors.Is(ctx.Err(), context.Canceled), isDataSourceFailure(statusCode, err))
+	}, nil
+}
+
+func (c *circuitBreakers) report(key string, probe bool, canceled bool, failed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	cb := c.circuits[key]
+
+	if probe {
+		if cb == nil || cb.state != circuitHalfOpen {
+			return
+		}
+		switch {
+		case canceled:
+			// The probe was abandoned, the next request will probe the data source.
+			cb.state = circuitOpen
+		case failed:
+			cb.state = circuitOpen
+			cb.openedAt = now
+			c.lggr.Warnw("Data source probe failed, circuit opened again", "dataSource", circuitName(key), "openTimeout", c.openTimeout)
+		default:
+			delete(c.circuits, key)
+			c.lggr.Infow("Data source probe succeeded, circuit closed", "dataSource", circuitName(key))
+		}
+		return
+	}
+
+	if canceled || !failed {
+		return
+	}
+
+	if cb == nil {
+		c.sweep(now)
+		cb = &circuit{}
+		c.circuits[key] = cb
+	}
+	if cb.state != circuitClosed {
+		// The circuit was opened by a concurrent request.
+		return
+	}
+	cb.failures = append(c.recentFailures(cb.failures, now), now)
+	if len(cb.failures) >= c.failureThreshold {
+		cb.state = circuitOpen
+		cb.openedAt = now
+		cb.failures = nil
+		promCircuitBreakerOpenings.Inc()
+		c.lggr.Warnw("Data source failed too many times, circuit opened", "dataSource", circuitName(key),
+			"failureThreshold", c.failureThreshold, "failureWindow", c.failureWindow, "openTimeout", c.openTimeout)
+	}
+}
+
+// recentFailures returns the failures within the failure window.
+func (c *circuitBreakers) recentFailures(failures []time.Time, now time.Time) []time.Time {
+	i := 0
+	for i < len(failures) && now.Sub(failures[i]) >= c.failureWindow {
+		i++
+	}
+	return failures[i:]
+}
+
+// sweep removes the closed circuits without failures within the failure window. It must be called with c.mu held.
+func (c *circuitBreakers) sweep(now time.Time) {
+	if now.Before(c.nextSweep) {
+		return
+	}
+	for key, cb := range c.circuits {
+		if cb.state == circuitClosed && len(c.recentFailures(cb.failures, now)) == 0 {
+			delete(c.circuits, key)
+		}
+	}
+	c.nextSweep = now.Add(circuitBreakerSweepInterval)
+}
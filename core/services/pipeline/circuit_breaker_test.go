@@ -0,0 +1,149 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+func TestCircuitBreakers(t *testing.T) {
+	t.Parallel()
+
+	errRequest := errors.New("request failed")
+
+	newCircuitBreakersAt := func(t *testing.T, now *time.Time) *circuitBreakers {
+		return &circuitBreakers{
+			failureThreshold: 3,
+			failureWindow:    time.Minute,
+			openTimeout:      30 * time.Second,
+			lggr:             logger.TestLogger(t),
+			now:              func() time.Time { return *now },
+			circuits:         make(map[string]*circuit),
+		}
+	}
+	request := func(c *circuitBreakers, ctx context.Context, key string, statusCode int, err error) error {
+		report, allowErr := c.allow("ds", key)
+		if allowErr != nil {
+			return allowErr
+		}
+		report(ctx, statusCode, err)
+		return nil
+	}
+	fail := func(c *circuitBreakers, key string) error {
+		return request(c, context.Background(), key, 0, errRequest)
+	}
+	succeed := func(c *circuitBreakers, key string) error {
+		return request(c, context.Background(), key, http.StatusOK, nil)
+	}
+
+	t.Run("nil allows every request", func(t *testing.T) {
+		var c *circuitBreakers
+		for i := 0; i < 10; i++ {
+			require.NoError(t, fail(c, "http:a"))
+		}
+	})
+
+	t.Run("opens after failureThreshold failures within failureWindow", func(t *testing.T) {
+		now := time.Now()
+		c := newCircuitBreakersAt(t, &now)
+
+		require.NoError(t, fail(c, "http:a"))
+		require.NoError(t, fail(c, "http:a"))
+		// failures of other data sources don't count
+		require.NoError(t, fail(c, "bridge:a"))
+		require.NoError(t, succeed(c, "http:a"))
+		require.NoError(t, fail(c, "http:a"))
+
+		require.ErrorIs(t, succeed(c, "http:a"), ErrCircuitOpen)
+		require.NoError(t, succeed(c, "bridge:a"))
+	})
+
+	t.Run("does not count failures outside of failureWindow", func(t *testing.T) {
+		now := time.Now()
+		c := newCircuitBreakersAt(t, &now)
+
+		require.NoError(t, fail(c, "http:a"))
+		require.NoError(t, fail(c, "http:a"))
+		now = now.Add(time.Minute)
+		require.NoError(t, fail(c, "http:a"))
+		require.NoError(t, fail(c, "http:a"))
+		require.NoError(t, succeed(c, "http:a"))
+	})
+
+	t.Run("does not count client errors and cancellations", func(t *testing.T) {
+		now := time.Now()
+		c := newCircuitBreakersAt(t, &now)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		for i := 0; i < 3; i++ {
+			require.NoError(t, request(c, context.Background(), "http:a", http.StatusBadRequest, errRequest))
+			require.NoError(t, request(c, ctx, "http:a", 0, errRequest))
+		}
+		require.NoError(t, succeed(c, "http:a"))
+
+		// server errors reported by external adapters count
+		for i := 0; i < 3; i++ {
+			require.NoError(t, request(c, context.Background(), "bridge:a", http.StatusInternalServerError, nil))
+		}
+		require.ErrorIs(t, succeed(c, "bridge:a"), ErrCircuitOpen)
+	})
+
+	t.Run("lets a single probe through after openTimeout", func(t *testing.T) {
+		now := time.Now()
+		c := newCircuitBreakersAt(t, &now)
+		for i := 0; i < 3; i++ {
+			require.NoError(t, fail(c, "http:a"))
+		}
+		now = now.Add(29 * time.Second)
+		require.ErrorIs(t, succeed(c, "http:a"), ErrCircuitOpen)
+
+		now = now.Add(time.Second)
+		report, err := c.allow("ds", "http:a")
+		require.NoError(t, err)
+		_, err = c.allow("ds", "http:a")
+		require.ErrorIs(t, err, ErrCircuitOpen)
+
+		// a failed probe opens the circuit for another openTimeout
+		report(context.Background(), http.StatusBadGateway, errRequest)
+		now = now.Add(29 * time.Second)
+		require.ErrorIs(t, succeed(c, "http:a"), ErrCircuitOpen)
+
+		// an abandoned probe lets the next request probe
+		now = now.Add(time.Second)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		require.NoError(t, request(c, ctx, "http:a", 0, errRequest))
+
+		// a successful probe closes the circuit
+		require.NoError(t, succeed(c, "http:a"))
+		assert.NotContains(t, c.circuits, "http:a")
+		require.NoError(t, fail(c, "http:a"))
+		require.NoError(t, succeed(c, "http:a"))
+	})
+
+	t.Run("removes the closed circuits without recent failures", func(t *testing.T) {
+		now := time.Now()
+		c := newCircuitBreakersAt(t, &now)
+
+		require.NoError(t, fail(c, "http:a"))
+		now = now.Add(2 * time.Minute)
+		require.NoError(t, fail(c, "http:b"))
+		assert.NotContains(t, c.circuits, "http:a")
+		assert.Contains(t, c.circuits, "http:b")
+	})
+}
+
+func TestCircuitName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "http:https://example.com/price", circuitName("http:https://example.com/price?apiKey=secret"))
+	assert.Equal(t, "bridge:voter_turnout", circuitName("bridge:voter_turnout"))
+}
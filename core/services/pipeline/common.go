@@ -67,6 +67,10 @@ type (
 		ReaperInterval() time.Duration
 		ReaperThreshold() time.Duration
 		VerboseLogging() bool
+		CircuitBreakerEnabled() bool
+		CircuitBreakerFailureThreshold() uint32
+		CircuitBreakerFailureWindow() time.Duration
+		CircuitBreakerOpenTimeout() time.Duration
 	}
 
 	BridgeConfig interface {
@@ -118,6 +122,10 @@ func pendingRunInfo() RunInfo {
 }
 
 func isRetryableHTTPError(statusCode int, err error) bool {
+	if errors.Is(err, ErrCircuitOpen) {
+		// The request was not sent, and the circuit stays open for longer than the task retries
+		return false
+	}
 	if statusCode >= 400 && statusCode < 500 {
 		// Client errors are not likely to succeed by resubmitting the exact same information again
 		return false
@@ -299,6 +307,7 @@ const (
 	TaskTypeHexDecode        TaskType = "hexdecode"
 	TaskTypeHexEncode        TaskType = "hexencode"
 	TaskTypeJSONParse        TaskType = "jsonparse"
+	TaskTypeJSONQuery        TaskType = "jsonquery"
 	TaskTypeLength           TaskType = "length"
 	TaskTypeLessThan         TaskType = "lessthan"
 	TaskTypeLookup           TaskType = "lookup"
@@ -359,6 +368,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &AnyTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeJSONParse:
 		task = &JSONParseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeJSONQuery:
+		task = &JSONQueryTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMemo:
 		task = &MemoTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMultiply:
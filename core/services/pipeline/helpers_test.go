@@ -8,6 +8,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
 )
 
 const (
@@ -49,6 +50,11 @@ func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, un
 	t.config = config
 	t.httpClient = restrictedHTTPClient
 	t.unrestrictedHTTPClient = unrestrictedHTTPClient
+	t.cache = newHTTPResponseCache()
+}
+
+func (t *HTTPTask) HelperSetCircuitBreakers(config Config, lggr logger.Logger) {
+	t.circuitBreakers = newCircuitBreakers(config, lggr)
 }
 
 func (t *ETHCallTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainContainer, config Config, specGasLimit *uint32, jobType string) {
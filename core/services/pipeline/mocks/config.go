@@ -14,6 +14,78 @@ type Config struct {
 	mock.Mock
 }
 
+// CircuitBreakerEnabled provides a mock function with given fields:
+func (_m *Config) CircuitBreakerEnabled() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CircuitBreakerEnabled")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// CircuitBreakerFailureThreshold provides a mock function with given fields:
+func (_m *Config) CircuitBreakerFailureThreshold() uint32 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CircuitBreakerFailureThreshold")
+	}
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// CircuitBreakerFailureWindow provides a mock function with given fields:
+func (_m *Config) CircuitBreakerFailureWindow() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CircuitBreakerFailureWindow")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// CircuitBreakerOpenTimeout provides a mock function with given fields:
+func (_m *Config) CircuitBreakerOpenTimeout() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CircuitBreakerOpenTimeout")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // DefaultHTTPLimit provides a mock function with given fields:
 func (_m *Config) DefaultHTTPLimit() int64 {
 	ret := _m.Called()
@@ -42,6 +42,10 @@ type Runner interface {
 	// ExecuteRun executes a new run in-memory according to a spec and returns the results.
 	// We expect spec.JobID and spec.JobName to be set for logging/prometheus.
 	ExecuteRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger) (run *Run, trrs TaskRunResults, err error)
+	// SimulateRun executes a new run in-memory like ExecuteRun, but the tasks with side effects are stubbed, and the
+	// tasks with fixtures return them instead of being executed. See Fixtures.
+	// Simulated runs have their own HTTP cache and circuit breakers, and do not write to the bridge cache.
+	SimulateRun(ctx context.Context, spec Spec, vars Vars, fixtures Fixtures, l logger.Logger) (run *Run, trrs TaskRunResults, err error)
 	// InsertFinishedRun saves the run results in the database.
 	// ds is an optional override, for example when executing a transaction.
 	InsertFinishedRun(ctx context.Context, ds sqlutil.DataSource, run *Run, saveSuccessfulTaskRuns bool) error
@@ -70,6 +74,8 @@ type runner struct {
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	httpCache              *httpResponseCache
+	circuitBreakers        *circuitBreakers
 
 	// test helper
 	runFinished func(*Run)
@@ -123,7 +129,9 @@ func NewRunner(orm ORM, btORM bridges.ORM, cfg Config, bridgeCfg BridgeConfig, l
 		lggr:                   lggr.Named("PipelineRunner"),
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
+		httpCache:              newHTTPResponseCache(),
 	}
+	r.circuitBreakers = newCircuitBreakers(cfg, r.lggr)
 	r.runReaperWorker = commonutils.NewSleeperTask(
 		commonutils.SleeperFuncTask(r.runReaper, "PipelineRunnerReaper"),
 	)
@@ -259,6 +267,40 @@ func (r *runner) ExecuteRun(
 	spec Spec,
 	vars Vars,
 	l logger.Logger,
+) (*Run, TaskRunResults, error) {
+	return r.executeRun(ctx, spec, vars, nil, l)
+}
+
+func (r *runner) SimulateRun(
+	ctx context.Context,
+	spec Spec,
+	vars Vars,
+	fixtures Fixtures,
+	l logger.Logger,
+) (*Run, TaskRunResults, error) {
+	// the pipeline of the spec is parsed again, as it may be shared with running jobs
+	spec.Pipeline = nil
+	pipeline, err := r.InitializePipeline(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	sim, err := newSimulation(pipeline, fixtures)
+	if err != nil {
+		return nil, nil, err
+	}
+	sim.isolate(pipeline, newHTTPResponseCache(), newCircuitBreakers(r.config, r.lggr))
+	spec.Pipeline = pipeline
+
+	return r.executeRun(ctx, spec, vars, sim, l.Named("Simulation"))
+}
+
+// executeRun executes a new run in-memory. sim is only set for simulated runs.
+func (r *runner) executeRun(
+	ctx context.Context,
+	spec Spec,
+	vars Vars,
+	sim *simulation,
+	l logger.Logger,
 ) (*Run, TaskRunResults, error) {
 	// Pipeline runs may return results after the context is cancelled, so we modify the
 	// deadline to give them time to return before the parent context deadline.
@@ -284,7 +326,7 @@ func (r *runner) ExecuteRun(
 	}
 
 	run := NewRun(spec, vars)
-	taskRunResults := r.run(ctx, pipeline, run, vars, l)
+	taskRunResults := r.run(ctx, pipeline, run, vars, sim, l)
 
 	if run.Pending {
 		return run, nil, fmt.Errorf("unexpected async run for spec ID %v, tried executing via ExecuteRun", spec.ID)
@@ -308,6 +350,8 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*HTTPTask).config = r.config
 			task.(*HTTPTask).httpClient = r.httpClient
 			task.(*HTTPTask).unrestrictedHTTPClient = r.unrestrictedHTTPClient
+			task.(*HTTPTask).cache = r.httpCache
+			task.(*HTTPTask).circuitBreakers = r.circuitBreakers
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
 			task.(*BridgeTask).bridgeConfig = r.bridgeConfig
@@ -317,6 +361,7 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			// must use the unrestrictedHTTPClient because some node operators
 			// may run external adapters on their own hardware
 			task.(*BridgeTask).httpClient = r.unrestrictedHTTPClient
+			task.(*BridgeTask).circuitBreakers = r.circuitBreakers
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).legacyChains = r.legacyEVMChains
 			task.(*ETHCallTask).config = r.config
@@ -345,7 +390,7 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 	return pipeline, nil
 }
 
-func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars, l logger.Logger) TaskRunResults {
+func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars, sim *simulation, l logger.Logger) TaskRunResults {
 	l = l.With("run.ID", run.ID, "executionID", uuid.New(), "specID", run.PipelineSpecID, "jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName)
 	l.Debug("Initiating tasks for pipeline run of spec")
 
@@ -366,7 +411,7 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		taskRun := taskRun
 		// execute
 		go recovery.WrapRecoverHandle(l, func() {
-			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
+			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, sim, l)
 
 			logTaskRunToPrometheus(result, run.PipelineSpec)
 
@@ -495,12 +540,23 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	return taskRunResults
 }
 
-func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryTaskRun, l logger.Logger) TaskRunResult {
+func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryTaskRun, sim *simulation, l logger.Logger) TaskRunResult {
 	start := time.Now()
 	l = l.With("taskName", taskRun.task.DotID(),
 		"taskType", taskRun.task.Type(),
 		"attempt", taskRun.attempts)
 
+	if result, ok := sim.resultFor(taskRun.task, taskRun.inputs); ok {
+		l.Debugw("Pipeline task simulated", "resultValue", result.Value, "resultError", result.Error)
+		return TaskRunResult{
+			ID:         taskRun.task.Base().uuid,
+			Task:       taskRun.task,
+			Result:     result,
+			CreatedAt:  start,
+			FinishedAt: null.TimeFrom(time.Now()),
+		}
+	}
+
 	// Task timeout will be whichever of the following timesout/cancels first:
 	// - Pipeline-level timeout
 	// - Specific task timeout (task.TaskTimeout)
@@ -646,7 +702,7 @@ func (r *runner) Run(ctx context.Context, run *Run, l logger.Logger, saveSuccess
 	}
 
 	for {
-		r.run(ctx, pipeline, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})), l)
+		r.run(ctx, pipeline, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})), nil, l)
 
 		if preinsert {
 			// FailSilently = run failed and task was marked failEarly. skip StoreRun and instead delete all trace of it
@@ -1024,3 +1024,89 @@ succeed;
 		assert.Equal(t, "1", trrs[0].Result.Value.(pipeline.ObjectParam).DecimalValue.Decimal().String())
 	})
 }
+
+func Test_PipelineRunner_SimulateRun(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	btORM := bridgesMocks.NewORM(t)
+	r, _ := newRunner(t, db, btORM, cfg)
+	lggr := logger.TestLogger(t)
+
+	spec := pipeline.Spec{
+		DotDagSource: `
+ds       [type=http method=GET url="https://example.invalid/price"];
+parse    [type=jsonparse path="data,result"];
+multiply [type=multiply times=100];
+submit   [type=ethtx to="0x613a38AC1659769640aaE063C651F48E0250454C" data="$(multiply)"];
+ds -> parse -> multiply -> submit;`,
+	}
+	resultOf := func(trrs pipeline.TaskRunResults, dotID string) pipeline.Result {
+		for _, trr := range trrs {
+			if trr.Task.DotID() == dotID {
+				return trr.Result
+			}
+		}
+		t.Fatalf("no result for task %s", dotID)
+		return pipeline.Result{}
+	}
+
+	t.Run("fixtures replace task results and side effects are stubbed", func(t *testing.T) {
+		run, trrs, err := r.SimulateRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), pipeline.Fixtures{
+			"ds": {Value: `{"data":{"result":"3000.5"}}`},
+		}, lggr)
+		require.NoError(t, err)
+		require.Len(t, trrs, 4)
+		assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+		assert.Equal(t, mustDecimal(t, "300050").String(), resultOf(trrs, "multiply").Value.(decimal.Decimal).String())
+		assert.NoError(t, resultOf(trrs, "submit").Error)
+		assert.Nil(t, resultOf(trrs, "submit").Value)
+	})
+
+	t.Run("fixtures can make tasks error", func(t *testing.T) {
+		run, trrs, err := r.SimulateRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), pipeline.Fixtures{
+			"ds": {Error: "503 service unavailable"},
+		}, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusErrored, run.State)
+		assert.EqualError(t, resultOf(trrs, "ds").Error, "503 service unavailable")
+		assert.ErrorIs(t, resultOf(trrs, "submit").Error, pipeline.ErrTooManyErrors)
+	})
+
+	t.Run("fixtures must refer to tasks of the pipeline", func(t *testing.T) {
+		_, _, err := r.SimulateRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), pipeline.Fixtures{
+			"not_a_task": {Value: "1"},
+		}, lggr)
+		assert.EqualError(t, err, "fixture for unknown task not_a_task")
+	})
+
+	t.Run("bridges which are not literally synchronous are stubbed", func(t *testing.T) {
+		bridgeSpec := pipeline.Spec{
+			DotDagSource: `bridge [type=bridge name="adapter" async="$(async)" requestData="{}"];`,
+		}
+		// the bridge ORM mock fails the test if the bridge is looked up to be executed
+		run, trrs, err := r.SimulateRun(testutils.Context(t), bridgeSpec, pipeline.NewVarsFrom(map[string]interface{}{"async": "true"}), nil, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+		assert.NoError(t, resultOf(trrs, "bridge").Error)
+		assert.Nil(t, resultOf(trrs, "bridge").Value)
+	})
+
+	t.Run("synchronous bridges do not write to the bridge cache", func(t *testing.T) {
+		s := httptest.NewServer(fakeStringResponder(t, `{"result":42}`))
+		defer s.Close()
+		bridgeURL, err := url.ParseRequestURI(s.URL)
+		require.NoError(t, err)
+		_, bt := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: bridgeURL.String()})
+		btORM.On("FindBridge", mock.Anything, bt.Name).Return(*bt, nil).Once()
+
+		bridgeSpec := pipeline.Spec{
+			DotDagSource: fmt.Sprintf(`bridge [type=bridge name="%s" cacheTTL=60 requestData="{}"];`, bt.Name),
+		}
+		// the bridge ORM mock fails the test if the response is upserted in the bridge cache
+		run, trrs, err := r.SimulateRun(testutils.Context(t), bridgeSpec, pipeline.NewVarsFrom(nil), nil, lggr)
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+		assert.NoError(t, resultOf(trrs, "bridge").Error)
+		assert.Equal(t, `{"result":42}`, resultOf(trrs, "bridge").Value)
+	})
+}
@@ -0,0 +1,83 @@
+package pipeline
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Fixture is the result returned by a task of a simulated run instead of executing it.
+type Fixture struct {
+	Value interface{} `json:"value"`
+	Error string      `json:"error,omitempty"`
+}
+
+// Fixtures override the results of the tasks of a simulated run, keyed by task DOT ID.
+// Tasks without a fixture are executed, except for the tasks with side effects (ethtx, vrf, vrfv2,
+// vrfv2plus and bridges which are not literally synchronous) which return an empty result instead.
+type Fixtures map[string]Fixture
+
+func (f Fixture) result() Result {
+	if f.Error != "" {
+		return Result{Error: errors.New(f.Error)}
+	}
+	return Result{Value: f.Value}
+}
+
+// simulation holds the state of a simulated run.
+type simulation struct {
+	fixtures Fixtures
+}
+
+func newSimulation(pipeline *Pipeline, fixtures Fixtures) (*simulation, error) {
+	for dotID := range fixtures {
+		if pipeline.ByDotID(dotID) == nil {
+			return nil, errors.Errorf("fixture for unknown task %s", dotID)
+		}
+	}
+	return &simulation{fixtures: fixtures}, nil
+}
+
+// isolate makes the tasks of pipeline use the given HTTP cache and circuit breakers instead of the ones shared by the
+// running jobs, and keeps its bridges from writing to the bridge cache, so that a simulation does not affect them.
+func (s *simulation) isolate(pipeline *Pipeline, cache *httpResponseCache, breakers *circuitBreakers) {
+	for _, task := range pipeline.Tasks {
+		switch t := task.(type) {
+		case *HTTPTask:
+			t.cache = cache
+			t.circuitBreakers = breakers
+		case *BridgeTask:
+			t.circuitBreakers = breakers
+			t.simulated = true
+		}
+	}
+}
+
+// resultFor returns the result of task when it mustn't be executed. It always returns false outside of simulations.
+// Like the tasks they stand for, the stubs of the tasks with side effects fail when any of their inputs errored.
+func (s *simulation) resultFor(task Task, inputs []Result) (Result, bool) {
+	if s == nil {
+		return Result{}, false
+	}
+	if fixture, ok := s.fixtures[task.DotID()]; ok {
+		return fixture.result(), true
+	}
+	if hasSideEffects(task) {
+		if _, err := CheckInputs(inputs, -1, -1, 0); err != nil {
+			return Result{Error: errors.Wrap(err, "task inputs")}, true
+		}
+		return Result{}, true
+	}
+	return Result{}, false
+}
+
+func hasSideEffects(task Task) bool {
+	switch task.Type() {
+	case TaskTypeETHTx, TaskTypeVRF, TaskTypeVRFV2, TaskTypeVRFV2Plus:
+		return true
+	case TaskTypeBridge:
+		// async may be set by a variable, so only bridges which are literally synchronous are executed
+		async := task.(*BridgeTask).Async
+		return async != "" && async != "false"
+	default:
+		return false
+	}
+}
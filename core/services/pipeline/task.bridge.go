@@ -69,6 +69,10 @@ type BridgeTask struct {
 	config       Config
 	bridgeConfig BridgeConfig
 	httpClient   *http.Client
+
+	circuitBreakers *circuitBreakers
+	// simulated is set for the bridges of simulated runs, whose responses must not be cached for the running jobs.
+	simulated bool
 }
 
 var _ Task = (*BridgeTask)(nil)
@@ -169,12 +173,23 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		cacheDuration = stalenessCap
 	}
 
-	var cachedResponse bool
-	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
-
-	// check for external adapter response object status
-	if code, ok := eautils.BestEffortExtractEAStatus(responseBytes); ok {
-		statusCode = code
+	var (
+		cachedResponse bool
+		responseBytes  []byte
+		statusCode     int
+		headers        http.Header
+		elapsed        time.Duration
+	)
+	// When the circuit of the bridge is open, the request is not sent and the cache is used as if it had failed.
+	report, err := t.circuitBreakers.allow(t.DotID(), bridgeCircuitKey(name))
+	if err == nil {
+		responseBytes, statusCode, headers, elapsed, err = makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+
+		// check for external adapter response object status
+		if code, ok := eautils.BestEffortExtractEAStatus(responseBytes); ok {
+			statusCode = code
+		}
+		report(requestCtx, statusCode, err)
 	}
 
 	if err != nil || statusCode != http.StatusOK {
@@ -219,7 +234,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		}
 	}
 
-	if !cachedResponse && cacheTTL > 0 {
+	if !cachedResponse && cacheTTL > 0 && !t.simulated {
 		err := t.orm.UpsertBridgeResponse(overtimeCtx, t.dotID, t.specId, responseBytes)
 		if err != nil {
 			lggr.Errorw("Bridge task: failed to upsert response in bridge cache", "err", err)
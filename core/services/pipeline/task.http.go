@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -24,10 +25,13 @@ type HTTPTask struct {
 	RequestData                    string `json:"requestData"`
 	AllowUnrestrictedNetworkAccess string
 	Headers                        string
+	CacheTTL                       string `json:"cacheTTL"`
 
 	config                 Config
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	cache                  *httpResponseCache
+	circuitBreakers        *circuitBreakers
 }
 
 var _ Task = (*HTTPTask)(nil)
@@ -63,6 +67,7 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		requestData                    MapParam
 		allowUnrestrictedNetworkAccess BoolParam
 		reqHeaders                     StringSliceParam
+		cacheTTL                       Uint64Param
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&method, From(NonemptyString(t.Method), "GET")), "method"),
@@ -73,6 +78,7 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		// You must set allowUnrestrictedNetworkAccess=true on the task to enable variable-interpolated URLs to make restricted network requests
 		errors.Wrap(ResolveParam(&allowUnrestrictedNetworkAccess, From(NonemptyString(t.AllowUnrestrictedNetworkAccess), !variableRegexp.MatchString(t.URL))), "allowUnrestrictedNetworkAccess"),
 		errors.Wrap(ResolveParam(&reqHeaders, From(NonemptyString(t.Headers), "[]")), "reqHeaders"),
+		errors.Wrap(ResolveParam(&cacheTTL, From(ValidDurationInSeconds(t.CacheTTL), 0)), "cacheTTL"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -103,7 +109,31 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	} else {
 		client = t.httpClient
 	}
-	responseBytes, statusCode, respHeaders, elapsed, err := makeHTTPRequest(requestCtx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit())
+	fetch := func(ctx context.Context) (httpResponse, error) {
+		report, err := t.circuitBreakers.allow(t.DotID(), httpCircuitKey(url))
+		if err != nil {
+			return httpResponse{}, err
+		}
+		body, statusCode, headers, elapsed, err := makeHTTPRequest(ctx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit())
+		report(ctx, statusCode, err)
+		return httpResponse{body: body, statusCode: statusCode, headers: headers, elapsed: elapsed}, err
+	}
+
+	var response httpResponse
+	var cached bool
+	if cacheTTL > 0 && t.cache != nil {
+		// cacheTTL should not exceed stalenessCap.
+		cacheDuration := time.Duration(cacheTTL) * time.Second
+		if cacheDuration > stalenessCap {
+			lggr.Warnf("HTTP task cacheTTL exceeds stalenessCap %s, overriding value to stalenessCap", stalenessCap)
+			cacheDuration = stalenessCap
+		}
+		key := httpRequestKey(method, url, reqHeaders, requestDataJSON, allowUnrestrictedNetworkAccess)
+		response, cached, err = t.cache.fetch(requestCtx, t.DotID(), key, cacheDuration, fetch)
+	} else {
+		response, err = fetch(requestCtx)
+	}
+	responseBytes, statusCode, respHeaders, elapsed := response.body, response.statusCode, response.headers, response.elapsed
 	if err != nil {
 		if errors.Is(errors.Cause(err), clhttp.ErrDisallowedIP) {
 			err = errors.Wrap(err, `connections to local resources are disabled by default, if you are sure this is safe, you can enable on a per-task basis by setting allowUnrestrictedNetworkAccess="true" in the pipeline task spec, e.g. fetch [type="http" method=GET url="$(decode_cbor.url)" allowUnrestrictedNetworkAccess="true"]`)
@@ -116,10 +146,13 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		"respHeaders", respHeaders,
 		"url", url.String(),
 		"dotID", t.DotID(),
+		"cached", cached,
 	)
 
-	promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
-	promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
+	if !cached {
+		promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
+		promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
+	}
 
 	// NOTE: We always stringify the response since this is required for all current jobs.
 	// If a binary response is required we might consider adding an adapter
@@ -0,0 +1,149 @@
+package pipeline
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/sync/singleflight"
+)
+
+var (
+	promHTTPCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_http_cache_hits_total",
+		Help: "Number of HTTP task requests answered from the response cache",
+	},
+		[]string{"pipeline_task_spec_id"},
+	)
+	promHTTPCacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_http_cache_misses_total",
+		Help: "Number of HTTP task requests which were not in the response cache",
+	},
+		[]string{"pipeline_task_spec_id"},
+	)
+	promHTTPCoalescedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_http_coalesced_requests_total",
+		Help: "Number of HTTP task requests which shared the response of an identical in-flight request",
+	},
+		[]string{"pipeline_task_spec_id"},
+	)
+)
+
+// httpResponseCacheSweepInterval is the minimum interval between two removals of the expired responses.
+const httpResponseCacheSweepInterval = time.Minute
+
+type httpResponse struct {
+	body       []byte
+	statusCode int
+	headers    http.Header
+	elapsed    time.Duration
+}
+
+type cachedHTTPResponse struct {
+	httpResponse
+	expiresAt time.Time
+}
+
+// httpResponseCache caches the successful responses of the http tasks with a cacheTTL, and coalesces
+// their identical in-flight requests, across all the pipeline runs of the node.
+type httpResponseCache struct {
+	group singleflight.Group
+
+	mu        sync.RWMutex
+	responses map[string]cachedHTTPResponse
+	nextSweep time.Time
+}
+
+func newHTTPResponseCache() *httpResponseCache {
+	return &httpResponseCache{responses: make(map[string]cachedHTTPResponse)}
+}
+
+// httpRequestKey identifies the requests which are answered with the same response.
+func httpRequestKey(method StringParam, url URLParam, reqHeaders []string, requestDataJSON []byte, allowUnrestrictedNetworkAccess BoolParam) string {
+	h := sha256.New()
+	for _, s := range append([]string{string(method), url.String(), strconv.FormatBool(bool(allowUnrestrictedNetworkAccess)), string(requestDataJSON)}, reqHeaders...) {
+		h.Write([]byte(strconv.Itoa(len(s))))
+		h.Write([]byte{':'})
+		h.Write([]byte(s))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// fetch returns the cached response to the request identified by key, or calls fetchFn to make it.
+// Concurrent callers with the same key share the response of a single call to fetchFn, which is made
+// with a context that is only cancelled by the deadline of ctx, so that one caller giving up doesn't
+// fail the others. Successful responses are cached for ttl.
+func (c *httpResponseCache) fetch(
+	ctx context.Context,
+	dotID string,
+	key string,
+	ttl time.Duration,
+	fetchFn func(ctx context.Context) (httpResponse, error),
+) (resp httpResponse, cached bool, err error) {
+	if resp, ok := c.get(key); ok {
+		promHTTPCacheHits.WithLabelValues(dotID).Inc()
+		return resp, true, nil
+	}
+	promHTTPCacheMisses.WithLabelValues(dotID).Inc()
+
+	ch := c.group.DoChan(key, func() (interface{}, error) {
+		var fetchCtx context.Context
+		var cancel context.CancelFunc
+		if deadline, ok := ctx.Deadline(); ok {
+			fetchCtx, cancel = context.WithDeadline(context.WithoutCancel(ctx), deadline)
+		} else {
+			fetchCtx, cancel = context.WithCancel(context.WithoutCancel(ctx))
+		}
+		defer cancel()
+
+		resp, err := fetchFn(fetchCtx)
+		if err == nil {
+			c.set(key, resp, ttl)
+		}
+		return resp, err
+	})
+
+	select {
+	case res := <-ch:
+		if res.Shared {
+			promHTTPCoalescedRequests.WithLabelValues(dotID).Inc()
+		}
+		return res.Val.(httpResponse), false, res.Err
+	case <-ctx.Done():
+		return httpResponse{}, false, errors.New("http request timed out or interrupted")
+	}
+}
+
+func (c *httpResponseCache) get(key string) (httpResponse, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	resp, ok := c.responses[key]
+	if !ok || time.Now().After(resp.expiresAt) {
+		return httpResponse{}, false
+	}
+	return resp.httpResponse, true
+}
+
+func (c *httpResponseCache) set(key string, resp httpResponse, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if now.After(c.nextSweep) {
+		for k, r := range c.responses {
+			if now.After(r.expiresAt) {
+				delete(c.responses, k)
+			}
+		}
+		c.nextSweep = now.Add(httpResponseCacheSweepInterval)
+	}
+	c.responses[key] = cachedHTTPResponse{httpResponse: resp, expiresAt: now.Add(ttl)}
+}
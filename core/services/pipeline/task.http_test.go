@@ -2,10 +2,13 @@ package pipeline_test
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"sort"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -15,6 +18,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
@@ -24,6 +28,7 @@ import (
 	clhttptest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/httptest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
@@ -412,3 +417,165 @@ func TestHTTPTask_Headers(t *testing.T) {
 		assert.Equal(t, []string{"Content-Length", "38", "Content-Type", "footype", "User-Agent", "Go-http-client/1.1", "X-Header-1", "foo", "X-Header-2", "bar"}, allHeaders(headers))
 	})
 }
+
+func TestHTTPTask_CacheTTL(t *testing.T) {
+	t.Parallel()
+
+	newServer := func(t *testing.T, delay time.Duration) (*httptest.Server, *atomic.Int32) {
+		var requests atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			n := requests.Add(1)
+			time.Sleep(delay)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(fmt.Sprintf(`{"request": %d}`, n)))
+			require.NoError(t, err)
+		}))
+		t.Cleanup(server.Close)
+		return server, &requests
+	}
+	newTask := func(t *testing.T, url string, cacheTTL string) *pipeline.HTTPTask {
+		config := configtest.NewTestGeneralConfig(t)
+		task := &pipeline.HTTPTask{
+			BaseTask: pipeline.NewBaseTask(0, "http", nil, nil, 0),
+			Method:   "GET",
+			URL:      url,
+			CacheTTL: cacheTTL,
+		}
+		c := clhttptest.NewTestLocalOnlyHTTPClient()
+		task.HelperSetDependencies(config.JobPipeline(), c, c)
+		return task
+	}
+
+	t.Run("caches responses for cacheTTL", func(t *testing.T) {
+		server, requests := newServer(t, 0)
+		task := newTask(t, server.URL, "1m")
+
+		for i := 0; i < 3; i++ {
+			result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			require.NoError(t, result.Error)
+			assert.Equal(t, `{"request": 1}`, result.Value)
+		}
+		assert.Equal(t, int32(1), requests.Load())
+	})
+
+	t.Run("does not cache without cacheTTL", func(t *testing.T) {
+		server, requests := newServer(t, 0)
+		task := newTask(t, server.URL, "")
+
+		for i := 1; i <= 3; i++ {
+			result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			require.NoError(t, result.Error)
+			assert.Equal(t, fmt.Sprintf(`{"request": %d}`, i), result.Value)
+		}
+		assert.Equal(t, int32(3), requests.Load())
+	})
+
+	t.Run("does not cache errors", func(t *testing.T) {
+		var requests atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+		task := newTask(t, server.URL, "1m")
+
+		for i := 0; i < 2; i++ {
+			result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			require.Error(t, result.Error)
+		}
+		assert.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("coalesces identical in-flight requests", func(t *testing.T) {
+		server, requests := newServer(t, 200*time.Millisecond)
+		task := newTask(t, server.URL, "1m")
+
+		var wg sync.WaitGroup
+		results := make([]pipeline.Result, 5)
+		for i := range results {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				results[i], _ = task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			}(i)
+		}
+		wg.Wait()
+
+		for _, result := range results {
+			require.NoError(t, result.Error)
+			assert.Equal(t, `{"request": 1}`, result.Value)
+		}
+		assert.Equal(t, int32(1), requests.Load())
+	})
+}
+
+func TestHTTPTask_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	var statusCode atomic.Int32
+	statusCode.Store(http.StatusInternalServerError)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(int(statusCode.Load()))
+		_, err := w.Write([]byte(`{"result": 1}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	const openTimeout = 500 * time.Millisecond
+	config := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.JobPipeline.CircuitBreaker.Enabled = ptr(true)
+		c.JobPipeline.CircuitBreaker.FailureThreshold = ptr[uint32](2)
+		c.JobPipeline.CircuitBreaker.FailureWindow = commonconfig.MustNewDuration(time.Minute)
+		c.JobPipeline.CircuitBreaker.OpenTimeout = commonconfig.MustNewDuration(openTimeout)
+	})
+	task := &pipeline.HTTPTask{
+		BaseTask: pipeline.NewBaseTask(0, "http", nil, nil, 0),
+		Method:   "GET",
+		URL:      server.URL,
+	}
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	task.HelperSetDependencies(config.JobPipeline(), c, c)
+	task.HelperSetCircuitBreakers(config.JobPipeline(), logger.TestLogger(t))
+
+	run := func() (pipeline.Result, pipeline.RunInfo) {
+		return task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	}
+
+	// The data source fails FailureThreshold times: the circuit opens.
+	for i := 0; i < 2; i++ {
+		result, runInfo := run()
+		require.Error(t, result.Error)
+		require.NotErrorIs(t, result.Error, pipeline.ErrCircuitOpen)
+		assert.True(t, runInfo.IsRetryable)
+	}
+	assert.Equal(t, int32(2), requests.Load())
+
+	result, runInfo := run()
+	require.ErrorIs(t, result.Error, pipeline.ErrCircuitOpen)
+	assert.False(t, runInfo.IsRetryable)
+	assert.Equal(t, int32(2), requests.Load())
+
+	// After OpenTimeout, a failed probe opens the circuit again.
+	time.Sleep(openTimeout)
+	result, _ = run()
+	require.Error(t, result.Error)
+	require.NotErrorIs(t, result.Error, pipeline.ErrCircuitOpen)
+	assert.Equal(t, int32(3), requests.Load())
+
+	result, _ = run()
+	require.ErrorIs(t, result.Error, pipeline.ErrCircuitOpen)
+	assert.Equal(t, int32(3), requests.Load())
+
+	// A successful probe closes the circuit.
+	statusCode.Store(http.StatusOK)
+	time.Sleep(openTimeout)
+	for i := 0; i < 2; i++ {
+		result, _ = run()
+		require.NoError(t, result.Error)
+		assert.Equal(t, `{"result": 1}`, result.Value)
+	}
+	assert.Equal(t, int32(5), requests.Load())
+}
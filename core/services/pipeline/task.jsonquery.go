@@ -0,0 +1,136 @@
+package pipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jmespath/go-jmespath"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// JSONQueryTask evaluates a JMESPath expression (https://jmespath.org) against
+// JSON data, e.g. to filter arrays, pick their max elements or project fields:
+//
+//	query [type=jsonquery expression="max_by(data.prices, &volume).price"]
+//
+// JSON numbers are decoded as float64, the only number type of JMESPath. Data containing integers which
+// float64 cannot represent exactly (e.g. wei amounts) is rejected, as they could not be compared or summed
+// without losing precision; use jsonparse to read them. An expression matching nothing returns nil.
+//
+// Return types:
+//
+//	float64
+//	string
+//	bool
+//	map[string]interface{}
+//	[]interface{}
+//	nil
+type JSONQueryTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Expression string `json:"expression"`
+	Data       string `json:"data"`
+}
+
+var _ Task = (*JSONQueryTask)(nil)
+
+func (t *JSONQueryTask) Type() TaskType {
+	return TaskTypeJSONQuery
+}
+
+func (t *JSONQueryTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		expression StringParam
+		data       BytesParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&expression, From(VarExpr(t.Expression, vars), NonemptyString(t.Expression))), "expression"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), Input(inputs, 0))), "data"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	var decoded interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err = d.Decode(&decoded); err != nil {
+		return Result{Error: multierr.Combine(ErrBadInput, err)}, runInfo
+	}
+
+	decoded, err = toJMESPathNumbers(decoded)
+	if err != nil {
+		return Result{Error: multierr.Combine(ErrBadInput, err)}, runInfo
+	}
+
+	value, err := evaluateJMESPath(string(expression), decoded)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	return Result{Value: value}, runInfo
+}
+
+// maxExactFloat64Integer is the largest integer magnitude up to which every integer is exactly representable as a float64.
+const maxExactFloat64Integer = 1 << 53
+
+// toJMESPathNumbers converts the JSON numbers of data to float64, which is the only number type go-jmespath
+// supports. It fails on integers which would lose precision as a float64.
+func toJMESPathNumbers(data interface{}) (interface{}, error) {
+	var err error
+	switch v := data.(type) {
+	case map[string]interface{}:
+		for key, elem := range v {
+			if v[key], err = toJMESPathNumbers(elem); err != nil {
+				return nil, err
+			}
+		}
+		return v, nil
+	case []interface{}:
+		for i, elem := range v {
+			if v[i], err = toJMESPathNumbers(elem); err != nil {
+				return nil, err
+			}
+		}
+		return v, nil
+	case json.Number:
+		if !strings.ContainsAny(v.String(), ".eE") {
+			if i, err := v.Int64(); err != nil || i > maxExactFloat64Integer || i < -maxExactFloat64Integer {
+				return nil, errors.Errorf("integer %s can't be represented exactly as a JMESPath number, use jsonparse to read it", v)
+			}
+		}
+		return v.Float64()
+	default:
+		return data, nil
+	}
+}
+
+// evaluateJMESPath evaluates expression against data. go-jmespath panics on some
+// invalid expressions and function arguments, so panics are returned as errors.
+func evaluateJMESPath(expression string, data interface{}) (value interface{}, err error) {
+	defer func() {
+		if rerr := recover(); rerr != nil {
+			err = fmt.Errorf("could not evaluate JMESPath expression %q: %v", expression, rerr)
+		}
+	}()
+
+	compiled, err := jmespath.Compile(expression)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid JMESPath expression %q", expression)
+	}
+	value, err = compiled.Search(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not evaluate JMESPath expression %q", expression)
+	}
+	return value, nil
+}
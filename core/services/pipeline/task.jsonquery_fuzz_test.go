@@ -0,0 +1,49 @@
+//go:build go1.18
+
+package pipeline_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func FuzzJSONQueryTask(f *testing.F) {
+	data := `{"data": {"prices": [{"exchange": "a", "price": 3000.5, "volume": 10, "active": true}, {"exchange": "b", "price": 2999, "volume": 40, "active": false}]}}`
+	f.Add(`data.prices[0].price`, data)
+	f.Add(`data.prices[-1].exchange`, data)
+	f.Add(`data.prices[?active].exchange`, data)
+	f.Add("data.prices[?volume > `20`] | [0]", data)
+	f.Add(`max_by(data.prices, &volume).price`, data)
+	f.Add(`sort_by(data.prices, &price)[*].{venue: exchange, size: volume}`, data)
+	f.Add(`sum(data.prices[*].volume)`, data)
+	f.Add(`length(data.prices)`, data)
+	f.Add(`data.*.[exchange, price][]`, data)
+	f.Add(`[0:10:2]`, `[0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11]`)
+	f.Add(`@`, `"0x1234"`)
+	f.Add(`foo.bar`, `null`)
+	f.Add(`max_by(data.prices, &active)`, data)
+	f.Add(`data.prices[?`, data)
+	f.Add(`[::0]`, `[1, 2]`)
+	f.Add(`data`, `{"data":`)
+	f.Fuzz(func(t *testing.T, expression string, data string) {
+		if len(expression) > 10_000 || len(data) > 1_000_000 {
+			t.Skip()
+		}
+		task := pipeline.JSONQueryTask{
+			BaseTask:   pipeline.NewBaseTask(0, "query", nil, nil, 0),
+			Expression: expression,
+		}
+		result, _ := task.Run(testutils.Context(t), logger.NullLogger, pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: data}})
+		if result.Error != nil {
+			t.Skip()
+		}
+		// results are JSON values, which the runner must be able to save
+		if _, err := json.Marshal(result.Value); err != nil {
+			t.Fatalf("result %#v of %q is not JSON serializable: %v", result.Value, expression, err)
+		}
+	})
+}
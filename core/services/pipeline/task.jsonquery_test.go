@@ -0,0 +1,218 @@
+package pipeline_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestJSONQueryTask(t *testing.T) {
+	t.Parallel()
+
+	prices := `{"data": {"prices": [
+		{"exchange": "a", "price": 3000.5, "volume": 10, "active": true},
+		{"exchange": "b", "price": 3001.25, "volume": 25, "active": true},
+		{"exchange": "c", "price": 2999, "volume": 40, "active": false}
+	]}}`
+
+	tests := []struct {
+		name              string
+		expression        string
+		data              string
+		vars              pipeline.Vars
+		inputs            []pipeline.Result
+		wantData          interface{}
+		wantError         error
+		wantErrorContains string
+	}{
+		{
+			"key path",
+			"data.prices[0].exchange",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			"a",
+			nil,
+			"",
+		},
+		{
+			"negative index",
+			"data.prices[-1].price",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			float64(2999),
+			nil,
+			"",
+		},
+		{
+			"filter and projection",
+			"data.prices[?active].exchange",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			[]interface{}{"a", "b"},
+			nil,
+			"",
+		},
+		{
+			"max element",
+			"max_by(data.prices, &volume).price",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			float64(2999),
+			nil,
+			"",
+		},
+		{
+			"multiselect hash",
+			"data.prices[1].{venue: exchange, size: volume}",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			map[string]interface{}{"venue": "b", "size": float64(25)},
+			nil,
+			"",
+		},
+		{
+			"no match returns nil",
+			"data.volumes",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			nil,
+			"",
+		},
+		{
+			"expression and data from vars",
+			"$(query)",
+			"$(foo.bar)",
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"query": "sum(data.prices[*].volume)",
+				"foo":   map[string]interface{}{"bar": prices},
+			}),
+			nil,
+			float64(75),
+			nil,
+			"",
+		},
+		{
+			"invalid expression",
+			"data.prices[?",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			nil,
+			"invalid JMESPath expression",
+		},
+		{
+			"invalid function argument",
+			"max_by(data.prices, &active)",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			nil,
+			"could not evaluate JMESPath expression",
+		},
+		{
+			"largest exact integer",
+			"balance",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"balance": -9007199254740992}`}},
+			float64(-9007199254740992),
+			nil,
+			"",
+		},
+		{
+			"integer beyond float64 precision",
+			"balance",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"balance": 9007199254740993}`}},
+			nil,
+			pipeline.ErrBadInput,
+			"integer 9007199254740993 can't be represented exactly as a JMESPath number",
+		},
+		{
+			"wei amounts are rejected rather than compared without precision",
+			"max_by(accounts, &wei).active",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"accounts": [
+				{"active": true, "wei": 123456789012345678901234567890},
+				{"active": false, "wei": 123456789012345678901234567891}
+			]}`}},
+			nil,
+			pipeline.ErrBadInput,
+			"integer 123456789012345678901234567890 can't be represented exactly as a JMESPath number",
+		},
+		{
+			"invalid JSON",
+			"data",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data":`}},
+			nil,
+			pipeline.ErrBadInput,
+			"",
+		},
+		{
+			"missing expression",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: prices}},
+			nil,
+			pipeline.ErrParameterEmpty,
+			"expression",
+		},
+		{
+			"errored input",
+			"data",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Error: pipeline.ErrTimeout}},
+			nil,
+			pipeline.ErrTooManyErrors,
+			"task inputs",
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.JSONQueryTask{
+				BaseTask:   pipeline.NewBaseTask(0, "query", nil, nil, 0),
+				Expression: test.expression,
+				Data:       test.data,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantError != nil || test.wantErrorContains != "" {
+				require.Error(t, result.Error)
+				if test.wantError != nil {
+					require.ErrorIs(t, result.Error, test.wantError)
+				}
+				if test.wantErrorContains != "" {
+					require.Contains(t, result.Error.Error(), test.wantErrorContains)
+				}
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.wantData, result.Value)
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	coretypes "github.com/smartcontractkit/chainlink-common/pkg/types/core"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	txm "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
@@ -565,7 +566,11 @@ func newOnChainContractTransmitter(ctx context.Context, lggr logger.Logger, rarg
 	if opts.subjectID != nil {
 		subject = *opts.subjectID
 	}
-	strategy := txmgrcommon.NewQueueingTxStrategy(subject, relayConfig.DefaultTransactionQueueDepth)
+	priority, err := txmgrtypes.NewTxPriority(relayConfig.TransactionPriority)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "invalid transactionPriority")
+	}
+	strategy := txmgrcommon.NewPriorityStrategy(txmgrcommon.NewQueueingTxStrategy(subject, relayConfig.DefaultTransactionQueueDepth), priority)
 
 	var checker txm.TransmitCheckerSpec
 	if relayConfig.SimulateTransactions {
@@ -582,7 +587,6 @@ func newOnChainContractTransmitter(ctx context.Context, lggr logger.Logger, rarg
 	}
 
 	var transmitter Transmitter
-	var err error
 
 	switch commontypes.OCR2PluginType(rargs.ProviderType) {
 	case commontypes.Median:
@@ -146,6 +146,8 @@ type RelayConfig struct {
 
 	DefaultTransactionQueueDepth uint32 `json:"defaultTransactionQueueDepth"`
 	SimulateTransactions         bool   `json:"simulateTransactions"`
+	// TransactionPriority is the txmgr priority lane of the transmissions: low, normal (default), high or critical
+	TransactionPriority string `json:"transactionPriority"`
 
 	// Contract-specific
 	SendingKeys pq.StringArray `json:"sendingKeys"`
@@ -43,6 +43,22 @@ func NewSingleAddressRange(address *ubig.Big) (*AddressRange, error) {
 	}, nil
 }
 
+// NewAddressPrefixRange creates AddressRange for all addresses starting with the given prefix.
+// The prefix must not be longer than an address.
+func NewAddressPrefixRange(prefix []byte) (*AddressRange, error) {
+	if len(prefix) > common.AddressLength {
+		return nil, errors.New("invalid address prefix")
+	}
+	minAddress := make([]byte, common.AddressLength)
+	maxAddress := bytes.Repeat([]byte{0xff}, common.AddressLength)
+	copy(minAddress, prefix)
+	copy(maxAddress, prefix)
+	return &AddressRange{
+		MinAddress: ubig.New(new(big.Int).SetBytes(minAddress)),
+		MaxAddress: ubig.New(new(big.Int).SetBytes(maxAddress)),
+	}, nil
+}
+
 // NewInitialAddressRangeForIntervals splits the full address space with intervals,
 // and returns a range for the first interval.
 // Number of intervals must be > 0 and a power of 2.
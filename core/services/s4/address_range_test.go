@@ -3,6 +3,8 @@ package s4_test
 import (
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/services/s4"
 
@@ -101,3 +103,22 @@ func TestAddressRange_Contains(t *testing.T) {
 	assert.True(t, r.Contains(r.MaxAddress))
 	assert.False(t, r.Contains(r.MinAddress.Sub(big.NewI(1))))
 }
+
+func TestAddressRange_NewAddressPrefixRange(t *testing.T) {
+	t.Parallel()
+
+	par, err := s4.NewAddressPrefixRange([]byte{0x12, 0x34})
+	assert.NoError(t, err)
+	assert.True(t, par.Contains(big.New(common.HexToAddress("0x1234000000000000000000000000000000000000").Big())))
+	assert.True(t, par.Contains(big.New(common.HexToAddress("0x1234ffffffffffffffffffffffffffffffffffff").Big())))
+	assert.False(t, par.Contains(big.New(common.HexToAddress("0x1233ffffffffffffffffffffffffffffffffffff").Big())))
+	assert.False(t, par.Contains(big.New(common.HexToAddress("0x1235000000000000000000000000000000000000").Big())))
+
+	par, err = s4.NewAddressPrefixRange(nil)
+	assert.NoError(t, err)
+	assert.Zero(t, par.MinAddress.Cmp(s4.MinAddress))
+	assert.Zero(t, par.MaxAddress.Cmp(s4.MaxAddress))
+
+	_, err = s4.NewAddressPrefixRange(make([]byte, common.AddressLength+1))
+	assert.Error(t, err)
+}
@@ -50,6 +50,14 @@ func (c CachedORM) Update(ctx context.Context, row *Row) error {
 	return c.underlayingORM.Update(ctx, row)
 }
 
+func (c CachedORM) UpdateBatch(ctx context.Context, rows []*Row) error {
+	for _, row := range rows {
+		c.deleteRowFromSnapshotCache(row)
+	}
+
+	return c.underlayingORM.UpdateBatch(ctx, rows)
+}
+
 func (c CachedORM) DeleteExpired(ctx context.Context, limit uint, utcNow time.Time) (int64, error) {
 	deletedRows, err := c.underlayingORM.DeleteExpired(ctx, limit, utcNow)
 	if err != nil {
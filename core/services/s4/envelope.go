@@ -1,11 +1,13 @@
 package s4
 
 import (
+	"bytes"
 	"crypto/ecdsa"
 	"encoding/json"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
@@ -75,3 +77,104 @@ func (e Envelope) ToJson() ([]byte, error) {
 	js := fmt.Sprintf(`{"address":%s,"slotid":%d,"payload":%s,"version":%d,"expiration":%d}`, address, e.SlotID, payload, e.Version, e.Expiration)
 	return []byte(js), nil
 }
+
+// BatchEnvelope represents a JSON object that is signed to put several records
+// of an address at once. Payloads are represented by their keccak256 hash,
+// so that each record of the batch can be verified on its own.
+// A signer is responsible for generating a JSON that has no whitespace and
+// the keys appear in this exact order:
+// {"address":base64,"entries":[{"slotid":int,"payloadhash":base64,"version":int,"expiration":int},...]}
+type BatchEnvelope struct {
+	Address []byte               `json:"address"`
+	Entries []BatchEnvelopeEntry `json:"entries"`
+}
+
+// BatchEnvelopeEntry represents a single record of a BatchEnvelope.
+type BatchEnvelopeEntry struct {
+	SlotID      uint   `json:"slotid"`
+	PayloadHash []byte `json:"payloadhash"`
+	Version     uint64 `json:"version"`
+	Expiration  int64  `json:"expiration"`
+}
+
+func NewBatchEnvelope(address common.Address, entries []*BatchEntry) *BatchEnvelope {
+	envelope := &BatchEnvelope{
+		Address: address.Bytes(),
+		Entries: make([]BatchEnvelopeEntry, len(entries)),
+	}
+	for i, entry := range entries {
+		envelope.Entries[i] = BatchEnvelopeEntry{
+			SlotID:      entry.SlotId,
+			PayloadHash: crypto.Keccak256(entry.Record.Payload),
+			Version:     entry.Version,
+			Expiration:  entry.Record.Expiration,
+		}
+	}
+	return envelope
+}
+
+// Sign calculates signature for the serialized batch envelope data.
+func (e BatchEnvelope) Sign(privateKey *ecdsa.PrivateKey) (signature []byte, err error) {
+	if len(e.Address) != common.AddressLength {
+		return nil, fmt.Errorf("invalid address length: %d", len(e.Address))
+	}
+	js, err := e.ToJson()
+	if err != nil {
+		return nil, err
+	}
+	return utils.GenerateEthSignature(privateKey, js)
+}
+
+// GetSignerAddress verifies the signature and returns the signing address.
+func (e BatchEnvelope) GetSignerAddress(signature []byte) (address common.Address, err error) {
+	if len(e.Address) != common.AddressLength {
+		return common.Address{}, fmt.Errorf("invalid address length: %d", len(e.Address))
+	}
+	js, err := e.ToJson()
+	if err != nil {
+		return common.Address{}, err
+	}
+	return utils.GetSignersEthAddress(js, signature)
+}
+
+func (e BatchEnvelope) ToJson() ([]byte, error) {
+	nonNilEntries := e.Entries
+	if nonNilEntries == nil {
+		// prevent unwanted "null" values in JSON representation
+		nonNilEntries = []BatchEnvelopeEntry{}
+	}
+	return json.Marshal(BatchEnvelope{
+		Address: e.Address,
+		Entries: nonNilEntries,
+	})
+}
+
+// VerifyBatchRecord verifies that the record identified by key is part of the
+// given serialized BatchEnvelope, and that the batch is signed by the key address.
+func VerifyBatchRecord(batch []byte, signature []byte, key *Key, record *Record) error {
+	var envelope BatchEnvelope
+	if err := json.Unmarshal(batch, &envelope); err != nil {
+		return fmt.Errorf("invalid batch: %w", err)
+	}
+	if !bytes.Equal(envelope.Address, key.Address.Bytes()) {
+		return ErrWrongSignature
+	}
+
+	payloadHash := crypto.Keccak256(record.Payload)
+	found := false
+	for _, entry := range envelope.Entries {
+		if entry.SlotID == key.SlotId && entry.Version == key.Version && entry.Expiration == record.Expiration && bytes.Equal(entry.PayloadHash, payloadHash) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return ErrWrongSignature
+	}
+
+	signer, err := utils.GetSignersEthAddress(batch, signature)
+	if err != nil || signer != key.Address {
+		return ErrWrongSignature
+	}
+	return nil
+}
@@ -59,3 +59,92 @@ func TestEnvelope(t *testing.T) {
 		assert.Equal(t, *env, decoded)
 	})
 }
+
+func TestBatchEnvelope(t *testing.T) {
+	t.Parallel()
+
+	privateKey, address := testutils.NewPrivateKeyAndAddress(t)
+	expiration := time.Now().Add(time.Hour).UnixMilli()
+	entries := []*s4.BatchEntry{
+		{
+			SlotId:  1,
+			Version: 5,
+			Record: s4.Record{
+				Payload:    []byte("foo"),
+				Expiration: expiration,
+			},
+		},
+		{
+			SlotId:  3,
+			Version: 7,
+			Record: s4.Record{
+				Payload:    []byte("bar"),
+				Expiration: expiration,
+			},
+		},
+	}
+	env := s4.NewBatchEnvelope(address, entries)
+
+	t.Run("signing", func(t *testing.T) {
+		sig, err := env.Sign(privateKey)
+		assert.NoError(t, err)
+
+		addr, err := env.GetSignerAddress(sig)
+		assert.NoError(t, err)
+		assert.Equal(t, address, addr)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		js, err := env.ToJson()
+		assert.NoError(t, err)
+
+		var decoded s4.BatchEnvelope
+		err = json.Unmarshal(js, &decoded)
+		assert.NoError(t, err)
+		assert.Equal(t, *env, decoded)
+
+		js, err = s4.BatchEnvelope{Address: address.Bytes()}.ToJson()
+		assert.NoError(t, err)
+		assert.Contains(t, string(js), `"entries":[]`)
+	})
+
+	t.Run("verify record", func(t *testing.T) {
+		sig, err := env.Sign(privateKey)
+		assert.NoError(t, err)
+		batch, err := env.ToJson()
+		assert.NoError(t, err)
+
+		for _, entry := range entries {
+			key := &s4.Key{
+				Address: address,
+				SlotId:  entry.SlotId,
+				Version: entry.Version,
+			}
+			assert.NoError(t, s4.VerifyBatchRecord(batch, sig, key, &entry.Record))
+
+			tampered := &s4.Record{
+				Payload:    []byte("baz"),
+				Expiration: entry.Record.Expiration,
+			}
+			assert.ErrorIs(t, s4.VerifyBatchRecord(batch, sig, key, tampered), s4.ErrWrongSignature)
+		}
+
+		key := &s4.Key{
+			Address: address,
+			SlotId:  2,
+			Version: 5,
+		}
+		assert.ErrorIs(t, s4.VerifyBatchRecord(batch, sig, key, &entries[0].Record), s4.ErrWrongSignature)
+
+		key = &s4.Key{
+			Address: testutils.NewAddress(),
+			SlotId:  1,
+			Version: 5,
+		}
+		assert.ErrorIs(t, s4.VerifyBatchRecord(batch, sig, key, &entries[0].Record), s4.ErrWrongSignature)
+
+		sig[0]++
+		key.Address = address
+		assert.ErrorIs(t, s4.VerifyBatchRecord(batch, sig, key, &entries[0].Record), s4.ErrWrongSignature)
+	})
+}
@@ -10,4 +10,7 @@ var (
 	ErrPastExpiration    = errors.New("past expiration")
 	ErrVersionTooLow     = errors.New("version too low")
 	ErrExpirationTooLong = errors.New("expiration too long")
+	ErrEmptyBatch        = errors.New("batch is empty")
+	ErrDuplicateSlotId   = errors.New("duplicate slot id in batch")
+	ErrBatchTooBig       = errors.New("batch is too big")
 )
@@ -48,30 +48,46 @@ func (o *inMemoryOrm) Get(ctx context.Context, address *big.Big, slotId uint) (*
 }
 
 func (o *inMemoryOrm) Update(ctx context.Context, row *Row) error {
+	return o.UpdateBatch(ctx, []*Row{row})
+}
+
+func (o *inMemoryOrm) UpdateBatch(ctx context.Context, rows []*Row) error {
 	o.mu.Lock()
 	defer o.mu.Unlock()
 
+	for _, row := range rows {
+		if !o.versionOk(row) {
+			return ErrVersionTooLow
+		}
+	}
+
+	now := time.Now().UTC()
+	for _, row := range rows {
+		mkey := key{
+			address: row.Address.Hex(),
+			slot:    row.SlotId,
+		}
+		o.rows[mkey] = &mrow{
+			Row:       row.Clone(),
+			UpdatedAt: now,
+		}
+	}
+	return nil
+}
+
+func (o *inMemoryOrm) versionOk(row *Row) bool {
 	mkey := key{
 		address: row.Address.Hex(),
 		slot:    row.SlotId,
 	}
 	existing, ok := o.rows[mkey]
-	versionOk := false
-	if ok && row.Confirmed {
-		versionOk = existing.Row.Version <= row.Version
-	}
-	if ok && !row.Confirmed {
-		versionOk = existing.Row.Version < row.Version
-	}
-	if ok && !versionOk {
-		return ErrVersionTooLow
+	if !ok {
+		return true
 	}
-
-	o.rows[mkey] = &mrow{
-		Row:       row.Clone(),
-		UpdatedAt: time.Now().UTC(),
+	if row.Confirmed {
+		return existing.Row.Version <= row.Version
 	}
-	return nil
+	return existing.Row.Version < row.Version
 }
 
 func (o *inMemoryOrm) DeleteExpired(ctx context.Context, limit uint, now time.Time) (int64, error) {
@@ -94,20 +110,21 @@ func (o *inMemoryOrm) DeleteExpired(ctx context.Context, limit uint, now time.Ti
 	return int64(len(queue)), nil
 }
 
-func (o *inMemoryOrm) GetSnapshot(ctx context.Context, _ *AddressRange) ([]*SnapshotRow, error) {
+func (o *inMemoryOrm) GetSnapshot(ctx context.Context, addressRange *AddressRange) ([]*SnapshotRow, error) {
 	o.mu.RLock()
 	defer o.mu.RUnlock()
 
 	now := time.Now().UnixMilli()
 	var rows []*SnapshotRow
 	for _, mrow := range o.rows {
-		if mrow.Row.Expiration > now {
+		if mrow.Row.Expiration > now && addressRange.Contains(mrow.Row.Address) {
 			rows = append(rows, &SnapshotRow{
-				Address:    big.New(mrow.Row.Address.ToInt()),
-				SlotId:     mrow.Row.SlotId,
-				Version:    mrow.Row.Version,
-				Expiration: mrow.Row.Expiration,
-				Confirmed:  mrow.Row.Confirmed,
+				Address:     big.New(mrow.Row.Address.ToInt()),
+				SlotId:      mrow.Row.SlotId,
+				Version:     mrow.Row.Version,
+				Expiration:  mrow.Row.Expiration,
+				Confirmed:   mrow.Row.Confirmed,
+				PayloadSize: uint64(len(mrow.Row.Payload)),
 			})
 		}
 	}
@@ -170,3 +170,74 @@ func TestInMemoryORM_GetSnapshot(t *testing.T) {
 		assert.Equal(t, 1, c)
 	}
 }
+
+func TestInMemoryORM_UpdateBatch(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	orm := s4.NewInMemoryORM()
+	address := big.New(testutils.NewAddress().Big())
+	expiration := time.Now().Add(time.Minute).UnixMilli()
+	rows := make([]*s4.Row, 3)
+	for i := range rows {
+		rows[i] = &s4.Row{
+			Address:    address,
+			SlotId:     uint(i),
+			Payload:    []byte{byte(i)},
+			Version:    5,
+			Expiration: expiration,
+			Confirmed:  false,
+			Signature:  []byte{},
+			Batch:      []byte("batch"),
+		}
+	}
+
+	err := orm.UpdateBatch(ctx, rows)
+	assert.NoError(t, err)
+	for _, row := range rows {
+		e, err := orm.Get(ctx, address, row.SlotId)
+		assert.NoError(t, err)
+		assert.Equal(t, row, e)
+	}
+
+	t.Run("all or nothing", func(t *testing.T) {
+		newRows := []*s4.Row{rows[0].Clone(), rows[1].Clone()}
+		newRows[0].Version = 6
+		err := orm.UpdateBatch(ctx, newRows)
+		assert.ErrorIs(t, err, s4.ErrVersionTooLow)
+
+		e, err := orm.Get(ctx, address, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(5), e.Version)
+	})
+}
+
+func TestInMemoryORM_GetSnapshotAddressRange(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	orm := s4.NewInMemoryORM()
+	expiration := time.Now().Add(100 * time.Second).UnixMilli()
+	for i := 0; i < 4; i++ {
+		var thisAddress common.Address
+		thisAddress[0] = byte(i)
+
+		err := orm.Update(ctx, &s4.Row{
+			Address:    big.New(thisAddress.Big()),
+			SlotId:     1,
+			Payload:    make([]byte, i),
+			Version:    1,
+			Expiration: expiration,
+		})
+		assert.NoError(t, err)
+	}
+
+	var address common.Address
+	address[0] = 2
+	addressRange, err := s4.NewSingleAddressRange(big.New(address.Big()))
+	assert.NoError(t, err)
+	rows, err := orm.GetSnapshot(ctx, addressRange)
+	assert.NoError(t, err)
+	assert.Len(t, rows, 1)
+	assert.Equal(t, uint64(2), rows[0].PayloadSize)
+}
@@ -104,6 +104,36 @@ func (_m *Storage) List(ctx context.Context, address common.Address) ([]*s4.Snap
 	return r0, r1
 }
 
+// ListRange provides a mock function with given fields: ctx, filter
+func (_m *Storage) ListRange(ctx context.Context, filter s4.ListFilter) ([]*s4.SnapshotRow, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRange")
+	}
+
+	var r0 []*s4.SnapshotRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, s4.ListFilter) ([]*s4.SnapshotRow, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, s4.ListFilter) []*s4.SnapshotRow); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*s4.SnapshotRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, s4.ListFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Put provides a mock function with given fields: ctx, key, record, signature
 func (_m *Storage) Put(ctx context.Context, key *s4.Key, record *s4.Record, signature []byte) error {
 	ret := _m.Called(ctx, key, record, signature)
@@ -122,6 +152,24 @@ func (_m *Storage) Put(ctx context.Context, key *s4.Key, record *s4.Record, sign
 	return r0
 }
 
+// PutBatch provides a mock function with given fields: ctx, address, entries, signature
+func (_m *Storage) PutBatch(ctx context.Context, address common.Address, entries []*s4.BatchEntry, signature []byte) error {
+	ret := _m.Called(ctx, address, entries, signature)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutBatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Address, []*s4.BatchEntry, []byte) error); ok {
+		r0 = rf(ctx, address, entries, signature)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewStorage creates a new instance of Storage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewStorage(t interface {
@@ -16,6 +16,9 @@ type Row struct {
 	Expiration int64
 	Confirmed  bool
 	Signature  []byte
+	// Batch is the serialized BatchEnvelope signed by Signature,
+	// when the row was put as part of a batch.
+	Batch []byte
 }
 
 // SnapshotRow(s) are returned by GetSnapshot function.
@@ -44,6 +47,10 @@ type ORM interface {
 	// UpdatedAt field value is ignored.
 	Update(ctx context.Context, row *Row) error
 
+	// UpdateBatch updates the given rows like Update, in a single transaction.
+	// If any row fails to update, no row is updated.
+	UpdateBatch(ctx context.Context, rows []*Row) error
+
 	// DeleteExpired deletes any entries having Expiration < utcNow,
 	// up to the given limit.
 	// Returns the number of deleted rows.
@@ -70,5 +77,9 @@ func (r Row) Clone() *Row {
 	}
 	copy(clone.Payload, r.Payload)
 	copy(clone.Signature, r.Signature)
+	if r.Batch != nil {
+		clone.Batch = make([]byte, len(r.Batch))
+		copy(clone.Batch, r.Batch)
+	}
 	return &clone
 }
@@ -33,10 +33,18 @@ func NewPostgresORM(ds sqlutil.DataSource, tableName, namespace string) ORM {
 	}
 }
 
+func (o *orm) withDataSource(ds sqlutil.DataSource) *orm {
+	return &orm{
+		ds:        ds,
+		tableName: o.tableName,
+		namespace: o.namespace,
+	}
+}
+
 func (o *orm) Get(ctx context.Context, address *big.Big, slotId uint) (*Row, error) {
 	row := &Row{}
 
-	stmt := fmt.Sprintf(`SELECT address, slot_id, version, expiration, confirmed, payload, signature FROM %s 
+	stmt := fmt.Sprintf(`SELECT address, slot_id, version, expiration, confirmed, payload, signature, batch FROM %s 
 WHERE namespace=$1 AND address=$2 AND slot_id=$3;`, o.tableName)
 	if err := o.ds.GetContext(ctx, row, stmt, o.namespace, address, slotId); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -48,22 +56,38 @@ WHERE namespace=$1 AND address=$2 AND slot_id=$3;`, o.tableName)
 }
 
 func (o *orm) Update(ctx context.Context, row *Row) error {
+	return o.update(ctx, row)
+}
+
+func (o *orm) UpdateBatch(ctx context.Context, rows []*Row) error {
+	return sqlutil.Transact(ctx, o.withDataSource, o.ds, nil, func(tx *orm) error {
+		for _, row := range rows {
+			if err := tx.update(ctx, row); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (o *orm) update(ctx context.Context, row *Row) error {
 	// This query inserts or updates a row, depending on whether the version is higher than the existing one.
 	// We only allow the same version when the row is confirmed.
 	// We never transition back from unconfirmed to confirmed state.
-	stmt := fmt.Sprintf(`INSERT INTO %s as t (namespace, address, slot_id, version, expiration, confirmed, payload, signature, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+	stmt := fmt.Sprintf(`INSERT INTO %s as t (namespace, address, slot_id, version, expiration, confirmed, payload, signature, batch, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW())
 ON CONFLICT (namespace, address, slot_id)
 DO UPDATE SET version = EXCLUDED.version,
 expiration = EXCLUDED.expiration,
 confirmed = EXCLUDED.confirmed,
 payload = EXCLUDED.payload,
 signature = EXCLUDED.signature,
+batch = EXCLUDED.batch,
 updated_at = NOW()
 WHERE (t.version < EXCLUDED.version) OR (t.version <= EXCLUDED.version AND EXCLUDED.confirmed IS TRUE)
 RETURNING id;`, o.tableName)
 	var id uint64
-	err := o.ds.GetContext(ctx, &id, stmt, o.namespace, row.Address, row.SlotId, row.Version, row.Expiration, row.Confirmed, row.Payload, row.Signature)
+	err := o.ds.GetContext(ctx, &id, stmt, o.namespace, row.Address, row.SlotId, row.Version, row.Expiration, row.Confirmed, row.Payload, row.Signature, row.Batch)
 	if errors.Is(err, sql.ErrNoRows) {
 		return ErrVersionTooLow
 	}
@@ -95,7 +119,7 @@ func (o *orm) GetSnapshot(ctx context.Context, addressRange *AddressRange) ([]*S
 func (o *orm) GetUnconfirmedRows(ctx context.Context, limit uint) ([]*Row, error) {
 	rows := make([]*Row, 0)
 
-	stmt := fmt.Sprintf(`SELECT address, slot_id, version, expiration, confirmed, payload, signature FROM %s
+	stmt := fmt.Sprintf(`SELECT address, slot_id, version, expiration, confirmed, payload, signature, batch FROM %s
 WHERE namespace = $1 AND confirmed IS FALSE ORDER BY updated_at LIMIT $2;`, o.tableName)
 	if err := o.ds.SelectContext(ctx, &rows, stmt, o.namespace, limit); err != nil {
 		if !errors.Is(err, sql.ErrNoRows) {
@@ -285,3 +285,35 @@ func TestPostgresORM_BigIntVersion(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, row, gotRow)
 }
+
+func TestPostgresORM_UpdateBatch(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	orm := setupORM(t, "test")
+	rows := generateTestRows(t, 3)
+	batch := cltest.MustRandomBytes(t, 64)
+	for _, row := range rows {
+		row.Batch = batch
+	}
+
+	err := orm.UpdateBatch(ctx, rows)
+	assert.NoError(t, err)
+	for _, row := range rows {
+		gotRow, err := orm.Get(ctx, row.Address, row.SlotId)
+		assert.NoError(t, err)
+		assert.Equal(t, row, gotRow)
+	}
+
+	t.Run("all or nothing", func(t *testing.T) {
+		newRows := []*s4.Row{rows[0].Clone(), rows[1].Clone()}
+		newRows[0].Version++
+		newRows[1].Confirmed = false
+		err := orm.UpdateBatch(ctx, newRows)
+		assert.ErrorIs(t, err, s4.ErrVersionTooLow)
+
+		gotRow, err := orm.Get(ctx, rows[0].Address, rows[0].SlotId)
+		assert.NoError(t, err)
+		assert.Equal(t, rows[0].Version, gotRow.Version)
+	})
+}
@@ -16,6 +16,9 @@ type Constraints struct {
 	MaxPayloadSizeBytes    uint   `json:"maxPayloadSizeBytes"`
 	MaxSlotsPerUser        uint   `json:"maxSlotsPerUser"`
 	MaxExpirationLengthSec uint64 `json:"maxExpirationLengthSec"`
+	// MaxBatchSize is the maximum number of records put by PutBatch. Every record of
+	// a batch carries the whole signed batch, so batches are disabled when zero.
+	MaxBatchSize uint `json:"maxBatchSize"`
 }
 
 // Key identifies a versioned user record.
@@ -42,6 +45,30 @@ type Metadata struct {
 	Confirmed bool
 	// Signature contains the original user signature.
 	Signature []byte
+	// Batch contains the serialized BatchEnvelope signed by the user,
+	// when the record was put as part of a batch.
+	Batch []byte
+}
+
+// BatchEntry is a single record put by PutBatch.
+type BatchEntry struct {
+	// SlotId is a slot number
+	SlotId uint
+	// Version is a data version
+	Version uint64
+	// Record is the user record
+	Record Record
+}
+
+// ListFilter selects the records returned by ListRange.
+type ListFilter struct {
+	// AddressRange limits the addresses, e.g. to a prefix (see NewAddressPrefixRange).
+	// A nil AddressRange matches all addresses.
+	AddressRange *AddressRange
+	// MinVersion is the minimum version (inclusive).
+	MinVersion uint64
+	// MaxVersion is the maximum version (inclusive). Zero means no maximum.
+	MaxVersion uint64
 }
 
 //go:generate mockery --quiet --name Storage --output ./mocks/ --case=underscore
@@ -64,6 +91,16 @@ type Storage interface {
 	// List returns a snapshot for the specified address.
 	// Slots having no data are not returned.
 	List(ctx context.Context, address common.Address) ([]*SnapshotRow, error)
+
+	// PutBatch creates (or updates) several records of the same address at once.
+	// Either all records are stored or none. Slots must be distinct.
+	// For signature calculation see BatchEnvelope in envelope.go
+	PutBatch(ctx context.Context, address common.Address, entries []*BatchEntry, signature []byte) error
+
+	// ListRange returns a snapshot of the records of all addresses matching the filter.
+	// It is meant for administrative tooling, as it is not limited to a single user.
+	// Slots having no data are not returned.
+	ListRange(ctx context.Context, filter ListFilter) ([]*SnapshotRow, error)
 }
 
 type storage struct {
@@ -114,6 +151,10 @@ func (s *storage) Get(ctx context.Context, key *Key) (*Record, *Metadata, error)
 		Signature: make([]byte, len(row.Signature)),
 	}
 	copy(metadata.Signature, row.Signature)
+	if row.Batch != nil {
+		metadata.Batch = make([]byte, len(row.Batch))
+		copy(metadata.Batch, row.Batch)
+	}
 
 	return record, metadata, nil
 }
@@ -128,18 +169,8 @@ func (s *storage) List(ctx context.Context, address common.Address) ([]*Snapshot
 }
 
 func (s *storage) Put(ctx context.Context, key *Key, record *Record, signature []byte) error {
-	if key.SlotId >= s.contraints.MaxSlotsPerUser {
-		return ErrSlotIdTooBig
-	}
-	if len(record.Payload) > int(s.contraints.MaxPayloadSizeBytes) {
-		return ErrPayloadTooBig
-	}
-	now := s.clock.Now().UnixMilli()
-	if now > record.Expiration {
-		return ErrPastExpiration
-	}
-	if record.Expiration-now > int64(s.contraints.MaxExpirationLengthSec)*1000 {
-		return ErrExpirationTooLong
+	if err := s.validateRecord(key.SlotId, record); err != nil {
+		return err
 	}
 
 	envelope := NewEnvelopeFromRecord(key, record)
@@ -162,3 +193,87 @@ func (s *storage) Put(ctx context.Context, key *Key, record *Record, signature [
 
 	return s.orm.Update(ctx, row)
 }
+
+func (s *storage) PutBatch(ctx context.Context, address common.Address, entries []*BatchEntry, signature []byte) error {
+	if len(entries) == 0 {
+		return ErrEmptyBatch
+	}
+	if len(entries) > int(s.contraints.MaxBatchSize) {
+		return ErrBatchTooBig
+	}
+	slots := make(map[uint]struct{}, len(entries))
+	for _, entry := range entries {
+		if err := s.validateRecord(entry.SlotId, &entry.Record); err != nil {
+			return err
+		}
+		if _, ok := slots[entry.SlotId]; ok {
+			return ErrDuplicateSlotId
+		}
+		slots[entry.SlotId] = struct{}{}
+	}
+
+	envelope := NewBatchEnvelope(address, entries)
+	signer, err := envelope.GetSignerAddress(signature)
+	if err != nil || signer != address {
+		return ErrWrongSignature
+	}
+	batch, err := envelope.ToJson()
+	if err != nil {
+		return err
+	}
+
+	rows := make([]*Row, len(entries))
+	for i, entry := range entries {
+		rows[i] = &Row{
+			Address:    big.New(address.Big()),
+			SlotId:     entry.SlotId,
+			Payload:    make([]byte, len(entry.Record.Payload)),
+			Version:    entry.Version,
+			Expiration: entry.Record.Expiration,
+			Confirmed:  false,
+			Signature:  make([]byte, len(signature)),
+			Batch:      batch,
+		}
+		copy(rows[i].Payload, entry.Record.Payload)
+		copy(rows[i].Signature, signature)
+	}
+
+	return s.orm.UpdateBatch(ctx, rows)
+}
+
+func (s *storage) ListRange(ctx context.Context, filter ListFilter) ([]*SnapshotRow, error) {
+	addressRange := filter.AddressRange
+	if addressRange == nil {
+		addressRange = NewFullAddressRange()
+	}
+	snapshot, err := s.orm.GetSnapshot(ctx, addressRange)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]*SnapshotRow, 0, len(snapshot))
+	for _, row := range snapshot {
+		if row.Version < filter.MinVersion || (filter.MaxVersion > 0 && row.Version > filter.MaxVersion) {
+			continue
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func (s *storage) validateRecord(slotId uint, record *Record) error {
+	if slotId >= s.contraints.MaxSlotsPerUser {
+		return ErrSlotIdTooBig
+	}
+	if len(record.Payload) > int(s.contraints.MaxPayloadSizeBytes) {
+		return ErrPayloadTooBig
+	}
+	now := s.clock.Now().UnixMilli()
+	if now > record.Expiration {
+		return ErrPastExpiration
+	}
+	if record.Expiration-now > int64(s.contraints.MaxExpirationLengthSec)*1000 {
+		return ErrExpirationTooLong
+	}
+	return nil
+}
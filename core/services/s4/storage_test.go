@@ -22,6 +22,7 @@ var (
 		MaxSlotsPerUser:        5,
 		MaxPayloadSizeBytes:    32,
 		MaxExpirationLengthSec: 3600,
+		MaxBatchSize:           3,
 	}
 )
 
@@ -235,3 +236,119 @@ func TestStorage_List(t *testing.T) {
 		}
 	}
 }
+
+func TestStorage_PutBatch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	privateKey, address := testutils.NewPrivateKeyAndAddress(t)
+	entries := []*s4.BatchEntry{
+		{
+			SlotId:  1,
+			Version: 3,
+			Record: s4.Record{
+				Payload:    []byte("foo"),
+				Expiration: now.Add(time.Hour).UnixMilli(),
+			},
+		},
+		{
+			SlotId:  2,
+			Version: 4,
+			Record: s4.Record{
+				Payload:    []byte("bar"),
+				Expiration: now.Add(time.Hour).UnixMilli(),
+			},
+		},
+	}
+	env := s4.NewBatchEnvelope(address, entries)
+	signature, err := env.Sign(privateKey)
+	require.NoError(t, err)
+	batch, err := env.ToJson()
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		ormMock, storage := setupTestStorage(t, now)
+		ormMock.On("UpdateBatch", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			rows := args.Get(1).([]*s4.Row)
+			require.Len(t, rows, 2)
+			for i, row := range rows {
+				assert.Equal(t, big.New(address.Big()), row.Address)
+				assert.Equal(t, entries[i].SlotId, row.SlotId)
+				assert.Equal(t, entries[i].Version, row.Version)
+				assert.Equal(t, entries[i].Record.Payload, row.Payload)
+				assert.False(t, row.Confirmed)
+				assert.Equal(t, signature, row.Signature)
+				assert.Equal(t, batch, row.Batch)
+			}
+		})
+
+		err := storage.PutBatch(testutils.Context(t), address, entries, signature)
+		assert.NoError(t, err)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, storage := setupTestStorage(t, now)
+		ctx := testutils.Context(t)
+
+		err := storage.PutBatch(ctx, address, nil, signature)
+		assert.ErrorIs(t, err, s4.ErrEmptyBatch)
+
+		err = storage.PutBatch(ctx, testutils.NewAddress(), entries, signature)
+		assert.ErrorIs(t, err, s4.ErrWrongSignature)
+
+		tooBig := []*s4.BatchEntry{entries[0], {SlotId: constraints.MaxSlotsPerUser, Record: entries[1].Record}}
+		err = storage.PutBatch(ctx, address, tooBig, signature)
+		assert.ErrorIs(t, err, s4.ErrSlotIdTooBig)
+
+		duplicate := []*s4.BatchEntry{entries[0], entries[0]}
+		err = storage.PutBatch(ctx, address, duplicate, signature)
+		assert.ErrorIs(t, err, s4.ErrDuplicateSlotId)
+
+		tooMany := []*s4.BatchEntry{entries[0], entries[1], {SlotId: 3, Record: entries[1].Record}, {SlotId: 4, Record: entries[1].Record}}
+		err = storage.PutBatch(ctx, address, tooMany, signature)
+		assert.ErrorIs(t, err, s4.ErrBatchTooBig)
+	})
+}
+
+func TestStorage_ListRange(t *testing.T) {
+	t.Parallel()
+
+	ormMock, storage := setupTestStorage(t, time.Now())
+	ormRows := []*s4.SnapshotRow{
+		{
+			Address: big.New(testutils.NewAddress().Big()),
+			SlotId:  1,
+			Version: 1,
+		},
+		{
+			Address: big.New(testutils.NewAddress().Big()),
+			SlotId:  2,
+			Version: 5,
+		},
+		{
+			Address: big.New(testutils.NewAddress().Big()),
+			SlotId:  3,
+			Version: 10,
+		},
+	}
+	addressRange, err := s4.NewAddressPrefixRange([]byte{0x12})
+	require.NoError(t, err)
+	ormMock.On("GetSnapshot", mock.Anything, s4.NewFullAddressRange()).Return(ormRows, nil)
+	ormMock.On("GetSnapshot", mock.Anything, addressRange).Return(ormRows[:1], nil)
+
+	rows, err := storage.ListRange(testutils.Context(t), s4.ListFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, ormRows, rows)
+
+	rows, err = storage.ListRange(testutils.Context(t), s4.ListFilter{MinVersion: 2, MaxVersion: 9})
+	require.NoError(t, err)
+	assert.Equal(t, ormRows[1:2], rows)
+
+	rows, err = storage.ListRange(testutils.Context(t), s4.ListFilter{MinVersion: 5})
+	require.NoError(t, err)
+	assert.Equal(t, ormRows[1:], rows)
+
+	rows, err = storage.ListRange(testutils.Context(t), s4.ListFilter{AddressRange: addressRange})
+	require.NoError(t, err)
+	assert.Equal(t, ormRows[:1], rows)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"sync"
 
 	"github.com/google/uuid"
 	"github.com/pelletier/go-toml"
@@ -21,6 +22,9 @@ type Delegate struct {
 	logger   logger.Logger
 	peerID   func() *p2ptypes.PeerID
 	store    store.Store
+
+	enginesMu sync.RWMutex
+	engines   map[string]*Engine // keyed by workflow ID
 }
 
 var _ job.Delegate = (*Delegate)(nil)
@@ -33,7 +37,14 @@ func (d *Delegate) BeforeJobCreated(spec job.Job) {}
 
 func (d *Delegate) AfterJobCreated(jb job.Job) {}
 
-func (d *Delegate) BeforeJobDeleted(spec job.Job) {}
+func (d *Delegate) BeforeJobDeleted(spec job.Job) {
+	if spec.WorkflowSpec == nil {
+		return
+	}
+	d.enginesMu.Lock()
+	defer d.enginesMu.Unlock()
+	delete(d.engines, spec.WorkflowSpec.WorkflowID)
+}
 
 func (d *Delegate) OnDeleteJob(context.Context, job.Job) error { return nil }
 
@@ -59,9 +70,77 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) ([]job.Ser
 	if err != nil {
 		return nil, err
 	}
+
+	d.enginesMu.Lock()
+	d.engines[cfg.WorkflowID] = engine
+	d.enginesMu.Unlock()
 	return []job.ServiceCtx{engine}, nil
 }
 
+//go:generate mockery --quiet --name ExecutionService --output ./mocks/ --case=underscore
+
+// ExecutionService inspects the executions of the workflows run by a node and restarts them.
+type ExecutionService interface {
+	// ListExecutions returns a page of the executions matching the filter, without their steps,
+	// most recently created first, along with the total number of matching executions.
+	ListExecutions(ctx context.Context, filter store.ExecutionFilter, offset, limit int) ([]store.WorkflowExecution, int, error)
+	// GetExecution returns an execution along with the inputs and outputs of its steps.
+	GetExecution(ctx context.Context, executionID string) (store.WorkflowExecution, error)
+	// DeadLetters returns a page of the workflow executions that errored or timed out, along with their steps,
+	// most recently finished first, along with the total number of such executions.
+	DeadLetters(ctx context.Context, offset, limit int) ([]store.WorkflowExecution, int, error)
+	// Redrive resumes an execution from the dead-letter list with the engine of its workflow.
+	Redrive(ctx context.Context, executionID string) error
+	// RerunFromStep executes a finished execution again from the given step with the engine of its workflow.
+	RerunFromStep(ctx context.Context, executionID string, stepRef string) error
+}
+
+var _ ExecutionService = (*Delegate)(nil)
+
+func (d *Delegate) ListExecutions(ctx context.Context, filter store.ExecutionFilter, offset, limit int) ([]store.WorkflowExecution, int, error) {
+	return d.store.List(ctx, filter, offset, limit)
+}
+
+func (d *Delegate) GetExecution(ctx context.Context, executionID string) (store.WorkflowExecution, error) {
+	return d.store.Get(ctx, executionID)
+}
+
+func (d *Delegate) DeadLetters(ctx context.Context, offset, limit int) ([]store.WorkflowExecution, int, error) {
+	return d.store.GetDeadLetters(ctx, offset, limit)
+}
+
+func (d *Delegate) Redrive(ctx context.Context, executionID string) error {
+	engine, err := d.engineFor(ctx, executionID)
+	if err != nil {
+		return err
+	}
+	return engine.Redrive(ctx, executionID)
+}
+
+func (d *Delegate) RerunFromStep(ctx context.Context, executionID string, stepRef string) error {
+	engine, err := d.engineFor(ctx, executionID)
+	if err != nil {
+		return err
+	}
+	return engine.RerunFromStep(ctx, executionID, stepRef)
+}
+
+// engineFor returns the engine running the workflow of the given execution.
+func (d *Delegate) engineFor(ctx context.Context, executionID string) (*Engine, error) {
+	execution, err := d.store.Get(ctx, executionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get execution %s: %w", executionID, err)
+	}
+
+	d.enginesMu.RLock()
+	engine, ok := d.engines[execution.WorkflowID]
+	d.enginesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("workflow %s of execution %s is not running", execution.WorkflowID, executionID)
+	}
+	return engine, nil
+}
+
 func initializeDONInfo(lggr logger.Logger) (*capabilities.DON, error) {
 	var key [16]byte
 
@@ -101,7 +180,7 @@ func initializeDONInfo(lggr logger.Logger) (*capabilities.DON, error) {
 }
 
 func NewDelegate(logger logger.Logger, registry core.CapabilitiesRegistry, store store.Store, peerID func() *p2ptypes.PeerID) *Delegate {
-	return &Delegate{logger: logger, registry: registry, store: store, peerID: peerID}
+	return &Delegate{logger: logger, registry: registry, store: store, peerID: peerID, engines: map[string]*Engine{}}
 }
 
 func ValidatedWorkflowSpec(tomlString string) (job.Job, error) {
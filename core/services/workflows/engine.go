@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -36,6 +37,13 @@ type stepRequest struct {
 	state   store.WorkflowExecution
 }
 
+// restartRequest asks the loop to restart a finished execution, see Redrive and RerunFromStep.
+type restartRequest struct {
+	executionID string
+	fromStep    string // empty when redriving
+	errCh       chan error
+}
+
 // Engine handles the lifecycle of a single workflow and its executions.
 type Engine struct {
 	services.StateMachine
@@ -48,10 +56,14 @@ type Engine struct {
 	triggerEvents        chan capabilities.CapabilityResponse
 	newWorkerCh          chan struct{}
 	stepUpdateCh         chan store.WorkflowExecutionStep
+	restartRequests      chan restartRequest
 	wg                   sync.WaitGroup
 	stopCh               services.StopChan
 	newWorkerTimeout     time.Duration
 	maxExecutionDuration time.Duration
+	// restartedAt holds when the executions that were restarted since the engine started were restarted,
+	// since their maxExecutionDuration starts over. Only accessed by the loop.
+	restartedAt map[string]time.Time
 
 	// testing lifecycle hook to signal when an execution is finished.
 	onExecutionFinished func(string)
@@ -388,6 +400,8 @@ func (e *Engine) loop(ctx context.Context) {
 			if err != nil {
 				e.logger.Errorf("failed to update step state: %+v, %s", stepUpdate, err)
 			}
+		case req := <-e.restartRequests:
+			req.errCh <- e.restart(ctx, req.executionID, req.fromStep)
 		}
 	}
 }
@@ -492,7 +506,11 @@ func (e *Engine) handleStepUpdate(ctx context.Context, stepUpdate store.Workflow
 
 		// We haven't completed the workflow, but should we continue?
 		// If we've been executing for too long, let's time the workflow out and stop here.
-		if state.CreatedAt != nil && e.clock.Since(*state.CreatedAt) > e.maxExecutionDuration {
+		startedAt := state.CreatedAt
+		if restartedAt, ok := e.restartedAt[state.ExecutionID]; ok {
+			startedAt = &restartedAt
+		}
+		if startedAt != nil && e.clock.Since(*startedAt) > e.maxExecutionDuration {
 			return e.finishExecution(ctx, state.ExecutionID, store.StatusTimeout)
 		}
 
@@ -547,11 +565,114 @@ func (e *Engine) finishExecution(ctx context.Context, executionID string, status
 	if err != nil {
 		return err
 	}
+	delete(e.restartedAt, executionID)
 
 	e.onExecutionFinished(executionID)
 	return nil
 }
 
+// Redrive resumes an execution of this workflow from the dead-letter list, i.e. one that errored or timed out.
+// The errored steps are executed again, followed by any steps that were never executed, while the completed steps
+// are kept. The execution is given a new maxExecutionDuration.
+func (e *Engine) Redrive(ctx context.Context, executionID string) error {
+	return e.requestRestart(ctx, restartRequest{executionID: executionID})
+}
+
+// RerunFromStep executes a finished execution of this workflow again from the given step: the step and all the
+// steps depending on it are executed again, along with any other step that hasn't completed, using the outputs
+// of the other completed steps. The execution is given a new maxExecutionDuration.
+func (e *Engine) RerunFromStep(ctx context.Context, executionID string, stepRef string) error {
+	return e.requestRestart(ctx, restartRequest{executionID: executionID, fromStep: stepRef})
+}
+
+func (e *Engine) requestRestart(ctx context.Context, req restartRequest) error {
+	req.errCh = make(chan error, 1)
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-e.stopCh:
+		return errors.New("engine is shutting down")
+	case e.restartRequests <- req:
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-req.errCh:
+		return err
+	}
+}
+
+func (e *Engine) restart(ctx context.Context, executionID string, fromStep string) error {
+	state, err := e.executionStates.Get(ctx, executionID)
+	if err != nil {
+		return fmt.Errorf("failed to get execution %s: %w", executionID, err)
+	}
+	if state.WorkflowID != e.workflow.id {
+		return fmt.Errorf("execution %s does not belong to workflow %s", executionID, e.workflow.id)
+	}
+
+	var reset []string
+	if fromStep == "" {
+		if state.Status != store.StatusErrored && state.Status != store.StatusTimeout {
+			return fmt.Errorf("cannot redrive execution %s with status %s: only errored or timed out executions can be redriven", executionID, state.Status)
+		}
+		for _, s := range state.Steps {
+			if s.Status == store.StatusErrored {
+				reset = append(reset, s.Ref)
+			}
+		}
+	} else {
+		if state.Status == store.StatusStarted {
+			return fmt.Errorf("cannot rerun execution %s: it is still running", executionID)
+		}
+		if fromStep == workflows.KeywordTrigger {
+			return fmt.Errorf("cannot rerun execution %s from its trigger: a new execution must be triggered instead", executionID)
+		}
+		if _, err = e.workflow.Vertex(fromStep); err != nil {
+			return fmt.Errorf("cannot rerun execution %s: workflow %s has no step %s", executionID, e.workflow.id, fromStep)
+		}
+		err = e.workflow.walkDo(fromStep, func(s *step) error {
+			if _, ok := state.Steps[s.Ref]; ok {
+				reset = append(reset, s.Ref)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	e.logger.Infow("restarting execution", "executionID", executionID, "status", state.Status, "fromStep", fromStep, "resetSteps", reset)
+	for _, ref := range reset {
+		// Reset the step, so the execution isn't considered finished until it has been executed again.
+		state, err = e.executionStates.UpsertStep(ctx, &store.WorkflowExecutionStep{
+			ExecutionID: executionID,
+			Ref:         ref,
+			Status:      store.StatusStarted,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if err = e.executionStates.UpdateStatus(ctx, executionID, store.StatusStarted); err != nil {
+		return err
+	}
+	e.restartedAt[executionID] = e.clock.Now()
+
+	// Enqueue the steps that haven't completed, but whose dependencies have.
+	return e.workflow.walkDo(workflows.KeywordTrigger, func(s *step) error {
+		if s.Ref == workflows.KeywordTrigger {
+			return nil
+		}
+		if ss, ok := state.Steps[s.Ref]; ok && ss.Status == store.StatusCompleted {
+			return nil
+		}
+		e.queueIfReady(state, s)
+		return nil
+	})
+}
+
 func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 	defer func() { e.newWorkerCh <- struct{}{} }()
 	defer e.wg.Done()
@@ -567,7 +688,8 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 		Ref:         msg.stepRef,
 	}
 
-	inputs, outputs, err := e.executeStep(ctx, l, msg)
+	inputs, outputs, attempts, err := e.executeStepWithRetries(ctx, l, msg)
+	stepState.Attempts = attempts
 	if err != nil {
 		l.Errorf("error executing step request: %s", err)
 		stepState.Outputs.Err = err
@@ -593,13 +715,36 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 	}
 }
 
-// executeStep executes the referenced capability within a step and returns the result.
-func (e *Engine) executeStep(ctx context.Context, l logger.Logger, msg stepRequest) (*values.Map, values.Value, error) {
+// executeStepWithRetries executes a step, retrying it with backoff up to the number of retries of the step's policy.
+// It returns the result of the last attempt, and the number of attempts made.
+func (e *Engine) executeStepWithRetries(ctx context.Context, l logger.Logger, msg stepRequest) (*values.Map, values.Value, int, error) {
 	step, err := e.workflow.Vertex(msg.stepRef)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, 0, err
 	}
 
+	b := step.policy.newBackoff()
+	for attempt := 1; ; attempt++ {
+		inputs, outputs, err := e.executeStep(ctx, l, step, msg)
+		if err == nil || attempt > step.policy.retries {
+			return inputs, outputs, attempt, err
+		}
+
+		wait := b.Duration()
+		l.Warnw("step execution failed, retrying", "attempt", attempt, "retries", step.policy.retries, "backoff", wait, "err", err)
+		t := e.clock.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return inputs, nil, attempt, err
+		case <-t.Chan():
+		}
+	}
+}
+
+// executeStep executes the referenced capability within a step and returns the result.
+func (e *Engine) executeStep(ctx context.Context, l logger.Logger, step *step, msg stepRequest) (*values.Map, values.Value, error) {
+
 	i, err := findAndInterpolateAllKeys(step.Inputs, msg.state)
 	if err != nil {
 		return nil, nil, err
@@ -622,8 +767,17 @@ func (e *Engine) executeStep(ctx context.Context, l logger.Logger, msg stepReque
 		},
 	}
 
+	if step.policy.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, step.policy.timeout)
+		defer cancel()
+	}
+
 	output, err := executeSyncAndUnwrapSingleValue(ctx, step.capability, tr)
 	if err != nil {
+		if step.policy.timeout > 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return inputs, nil, fmt.Errorf("step timed out after %s: %w", step.policy.timeout, err)
+		}
 		return inputs, nil, err
 	}
 
@@ -814,10 +968,12 @@ func NewEngine(cfg Config) (engine *Engine, err error) {
 		pendingStepRequests:  make(chan stepRequest, cfg.QueueSize),
 		newWorkerCh:          newWorkerCh,
 		stepUpdateCh:         make(chan store.WorkflowExecutionStep),
+		restartRequests:      make(chan restartRequest),
 		triggerEvents:        make(chan capabilities.CapabilityResponse),
 		stopCh:               make(chan struct{}),
 		newWorkerTimeout:     cfg.NewWorkerTimeout,
 		maxExecutionDuration: cfg.MaxExecutionDuration,
+		restartedAt:          map[string]time.Time{},
 
 		onExecutionFinished: cfg.onExecutionFinished,
 		afterInit:           cfg.afterInit,
@@ -3,6 +3,8 @@ package workflows
 import (
 	"context"
 	"errors"
+	"math"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -632,3 +634,179 @@ func TestEngine_TimesOutOldExecutions(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, store.StatusTimeout, gotEx.Status)
 }
+
+const retryingWorkflow = `
+triggers:
+  - id: "mercury-trigger"
+    config:
+      feedlist:
+        - "0x1111111111111111111100000000000000000000000000000000000000000000" # ETHUSD
+
+consensus:
+  - id: "offchain_reporting"
+    ref: "evm_median"
+    inputs:
+      observations:
+        - "$(trigger.outputs)"
+    timeout: "1s"
+    retries: 2
+    backoff:
+      min: "10ms"
+      max: "10ms"
+
+targets:
+  - id: "write_polygon-testnet-mumbai"
+    inputs:
+      report: "$(evm_median.outputs.report)"
+`
+
+// mockFlakyConsensus returns a consensus capability that fails until failures is set to 0.
+func mockFlakyConsensus(failures *atomic.Int32) *mockCapability {
+	consensus := mockConsensus()
+	succeed := consensus.transform
+	consensus.transform = func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+		if failures.Load() > 0 {
+			failures.Add(-1)
+			return capabilities.CapabilityResponse{}, errors.New("transient consensus error")
+		}
+		return succeed(req)
+	}
+	return consensus
+}
+
+func TestEngine_RetriesFailedSteps(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	failures := &atomic.Int32{}
+	failures.Store(2)
+
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, mockFlakyConsensus(failures)))
+	require.NoError(t, reg.Add(ctx, mockTarget()))
+
+	eng, hooks := newTestEngine(t, reg, retryingWorkflow, func(c *Config) { c.clock = clockwork.NewRealClock() })
+
+	err := eng.Start(ctx)
+	require.NoError(t, err)
+	defer eng.Close()
+
+	eid := getExecutionId(t, eng, hooks)
+	state, err := eng.executionStates.Get(ctx, eid)
+	require.NoError(t, err)
+
+	assert.Equal(t, store.StatusCompleted, state.Status)
+	assert.Equal(t, store.StatusCompleted, state.Steps["evm_median"].Status)
+	assert.Equal(t, 3, state.Steps["evm_median"].Attempts)
+}
+
+func TestEngine_RedrivesDeadLetters(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	failures := &atomic.Int32{}
+	failures.Store(math.MaxInt32)
+
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, mockFlakyConsensus(failures)))
+	target := mockTarget()
+	require.NoError(t, reg.Add(ctx, target))
+
+	dbstore := store.NewDBStore(pgtest.NewSqlxDB(t), clockwork.NewFakeClock())
+	eng, hooks := newTestEngine(t, reg, retryingWorkflow, func(c *Config) {
+		c.Store = dbstore
+		c.clock = clockwork.NewRealClock()
+	})
+
+	err := eng.Start(ctx)
+	require.NoError(t, err)
+	defer eng.Close()
+
+	// the step is retried, then errors the execution
+	eid := getExecutionId(t, eng, hooks)
+	deadLetters, _, err := dbstore.GetDeadLetters(ctx, 0, 100)
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	assert.Equal(t, eid, deadLetters[0].ExecutionID)
+	assert.Equal(t, store.StatusErrored, deadLetters[0].Status)
+	assert.Equal(t, store.StatusErrored, deadLetters[0].Steps["evm_median"].Status)
+	assert.Equal(t, 3, deadLetters[0].Steps["evm_median"].Attempts)
+	assert.ErrorContains(t, deadLetters[0].Steps["evm_median"].Outputs.Err, "transient consensus error")
+
+	err = eng.Redrive(ctx, "not-an-execution")
+	assert.ErrorContains(t, err, "failed to get execution not-an-execution")
+
+	// once the failure is fixed, the execution can be redriven to completion
+	failures.Store(0)
+	require.NoError(t, eng.Redrive(ctx, eid))
+
+	assert.Equal(t, eid, getExecutionId(t, eng, hooks))
+	<-target.response
+	state, err := dbstore.Get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCompleted, state.Status)
+	assert.Equal(t, store.StatusCompleted, state.Steps["evm_median"].Status)
+	assert.Equal(t, 1, state.Steps["evm_median"].Attempts)
+
+	deadLetters, _, err = dbstore.GetDeadLetters(ctx, 0, 100)
+	require.NoError(t, err)
+	assert.Empty(t, deadLetters)
+
+	err = eng.Redrive(ctx, eid)
+	assert.ErrorContains(t, err, "only errored or timed out executions can be redriven")
+}
+
+func TestEngine_RerunsFromStep(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger, _ := mockTrigger(t)
+	failures := &atomic.Int32{}
+
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, mockFlakyConsensus(failures)))
+	target := mockTarget()
+	require.NoError(t, reg.Add(ctx, target))
+
+	dbstore := store.NewDBStore(pgtest.NewSqlxDB(t), clockwork.NewFakeClock())
+	eng, hooks := newTestEngine(t, reg, retryingWorkflow, func(c *Config) {
+		c.Store = dbstore
+		c.clock = clockwork.NewRealClock()
+	})
+
+	err := eng.Start(ctx)
+	require.NoError(t, err)
+	defer eng.Close()
+
+	eid := getExecutionId(t, eng, hooks)
+	<-target.response
+	state, err := dbstore.Get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCompleted, state.Status)
+
+	err = eng.RerunFromStep(ctx, eid, "trigger")
+	assert.ErrorContains(t, err, "a new execution must be triggered instead")
+	err = eng.RerunFromStep(ctx, eid, "not-a-step")
+	assert.ErrorContains(t, err, "has no step not-a-step")
+
+	// the consensus step fails once when rerun, and is retried
+	failures.Store(1)
+	require.NoError(t, eng.RerunFromStep(ctx, eid, "evm_median"))
+
+	// the step depending on the rerun step is executed again
+	assert.Equal(t, eid, getExecutionId(t, eng, hooks))
+	<-target.response
+	state, err = dbstore.Get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCompleted, state.Status)
+	assert.NotNil(t, state.FinishedAt)
+	assert.Equal(t, store.StatusCompleted, state.Steps["evm_median"].Status)
+	assert.Equal(t, 2, state.Steps["evm_median"].Attempts)
+	assert.Equal(t, store.StatusCompleted, state.Steps["write_polygon-testnet-mumbai"].Status)
+	assert.Equal(t, store.StatusCompleted, state.Steps["trigger"].Status)
+}
@@ -3,8 +3,11 @@ package workflows
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/dominikbraun/graph"
+	"github.com/jpillora/backoff"
+	"sigs.k8s.io/yaml"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
@@ -80,6 +83,143 @@ type step struct {
 	workflows.Vertex
 	capability capabilities.CallbackCapability
 	config     *values.Map
+	policy     stepPolicy
+}
+
+const (
+	maxStepRetries       = 100
+	defaultBackoffMin    = time.Second
+	defaultBackoffMax    = 30 * time.Second
+	defaultBackoffFactor = 2
+)
+
+// stepPolicy controls how the engine executes a step.
+type stepPolicy struct {
+	// timeout bounds a single attempt at executing the step. 0 means the attempt is only bounded by the execution.
+	timeout time.Duration
+	// retries is the number of times a failed step is retried before it is marked as errored.
+	retries int
+	// backoffMin, backoffMax and backoffFactor define the exponential backoff between retries.
+	backoffMin    time.Duration
+	backoffMax    time.Duration
+	backoffFactor float64
+}
+
+func (p stepPolicy) newBackoff() *backoff.Backoff {
+	return &backoff.Backoff{Min: p.backoffMin, Max: p.backoffMax, Factor: p.backoffFactor}
+}
+
+// stepPolicyYaml holds the execution policy fields of a step in the workflow YAML, for example:
+//
+//	consensus:
+//	  - id: "offchain_reporting"
+//	    ref: "evm_median"
+//	    timeout: "30s"
+//	    retries: 3
+//	    backoff:
+//	      min: "1s"
+//	      max: "10s"
+//	      factor: 2
+type stepPolicyYaml struct {
+	ID      string `json:"id"`
+	Ref     string `json:"ref"`
+	Timeout string `json:"timeout"`
+	Retries int    `json:"retries"`
+	Backoff *struct {
+		Min    string  `json:"min"`
+		Max    string  `json:"max"`
+		Factor float64 `json:"factor"`
+	} `json:"backoff"`
+}
+
+type workflowPoliciesYaml struct {
+	Actions   []stepPolicyYaml `json:"actions"`
+	Consensus []stepPolicyYaml `json:"consensus"`
+	Targets   []stepPolicyYaml `json:"targets"`
+}
+
+func parseDuration(field, value, ref string) (time.Duration, error) {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q for step %s: %w", field, value, ref, err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("invalid %s %q for step %s: must not be negative", field, value, ref)
+	}
+	return d, nil
+}
+
+// ref returns the ref of the step, which defaults to its capability ID like in the dependency graph.
+func (s stepPolicyYaml) ref() string {
+	if s.Ref == "" {
+		return s.ID
+	}
+	return s.Ref
+}
+
+func (s stepPolicyYaml) toStepPolicy() (stepPolicy, error) {
+	ref := s.ref()
+
+	p := stepPolicy{
+		retries:       s.Retries,
+		backoffMin:    defaultBackoffMin,
+		backoffMax:    defaultBackoffMax,
+		backoffFactor: defaultBackoffFactor,
+	}
+	if p.retries < 0 || p.retries > maxStepRetries {
+		return p, fmt.Errorf("invalid retries %d for step %s: must be between 0 and %d", s.Retries, ref, maxStepRetries)
+	}
+
+	var err error
+	if s.Timeout != "" {
+		if p.timeout, err = parseDuration("timeout", s.Timeout, ref); err != nil {
+			return p, err
+		}
+	}
+
+	if s.Backoff == nil {
+		return p, nil
+	}
+	if s.Backoff.Min != "" {
+		if p.backoffMin, err = parseDuration("backoff min", s.Backoff.Min, ref); err != nil {
+			return p, err
+		}
+	}
+	if s.Backoff.Max != "" {
+		if p.backoffMax, err = parseDuration("backoff max", s.Backoff.Max, ref); err != nil {
+			return p, err
+		}
+	}
+	if p.backoffMin > p.backoffMax {
+		return p, fmt.Errorf("invalid backoff for step %s: min %s is greater than max %s", ref, p.backoffMin, p.backoffMax)
+	}
+	if s.Backoff.Factor != 0 {
+		if s.Backoff.Factor < 1 {
+			return p, fmt.Errorf("invalid backoff factor %v for step %s: must be at least 1", s.Backoff.Factor, ref)
+		}
+		p.backoffFactor = s.Backoff.Factor
+	}
+	return p, nil
+}
+
+// parseStepPolicies returns the execution policy of each step of the workflow, keyed by step ref.
+func parseStepPolicies(yamlWorkflow string) (map[string]stepPolicy, error) {
+	w := workflowPoliciesYaml{}
+	if err := yaml.Unmarshal([]byte(yamlWorkflow), &w); err != nil {
+		return nil, err
+	}
+
+	policies := map[string]stepPolicy{}
+	for _, steps := range [][]stepPolicyYaml{w.Actions, w.Consensus, w.Targets} {
+		for _, s := range steps {
+			p, err := s.toStepPolicy()
+			if err != nil {
+				return nil, err
+			}
+			policies[s.ref()] = p
+		}
+	}
+	return policies, nil
 }
 
 type triggerCapability struct {
@@ -93,7 +233,25 @@ func Parse(yamlWorkflow string) (*workflow, error) {
 	if err != nil {
 		return nil, err
 	}
-	return createWorkflow(wf2)
+	wf, err := createWorkflow(wf2)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := parseStepPolicies(yamlWorkflow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse step policies: %w", err)
+	}
+	err = wf.walkDo(workflows.KeywordTrigger, func(s *step) error {
+		if p, ok := policies[s.Ref]; ok {
+			s.policy = p
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return wf, nil
 }
 
 // createWorkflow converts a StaticWorkflow to an executable workflow
@@ -1,7 +1,9 @@
 package workflows
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -290,3 +292,106 @@ func TestParsesIntsCorrectly(t *testing.T) {
 
 	assert.Equal(t, int64(3600), n.Config["aggregation_config"].(map[string]any)["0x1111111111111111111100000000000000000000000000000000000000000000"].(map[string]any)["heartbeat"])
 }
+
+func TestParse_StepPolicies(t *testing.T) {
+	t.Parallel()
+	const workflowFmt = `
+triggers:
+  - id: "a-trigger"
+
+consensus:
+  - id: "a-consensus"
+    ref: "a-consensus"
+    inputs:
+      trigger_output: $(trigger.outputs)
+%s
+targets:
+  - id: "a-target"
+    inputs:
+      consensus_output: $(a-consensus.outputs)
+    timeout: "5s"
+`
+	testCases := []struct {
+		name     string
+		policy   string
+		expected stepPolicy
+		errMsg   string
+	}{
+		{
+			name:     "no policy",
+			expected: stepPolicy{backoffMin: time.Second, backoffMax: 30 * time.Second, backoffFactor: 2},
+		},
+		{
+			name: "retries with default backoff",
+			policy: `
+    timeout: "30s"
+    retries: 3
+`,
+			expected: stepPolicy{timeout: 30 * time.Second, retries: 3, backoffMin: time.Second, backoffMax: 30 * time.Second, backoffFactor: 2},
+		},
+		{
+			name: "retries with custom backoff",
+			policy: `
+    retries: 2
+    backoff:
+      min: "100ms"
+      max: "1s"
+      factor: 1.5
+`,
+			expected: stepPolicy{retries: 2, backoffMin: 100 * time.Millisecond, backoffMax: time.Second, backoffFactor: 1.5},
+		},
+		{
+			name: "invalid timeout",
+			policy: `
+    timeout: "soon"
+`,
+			errMsg: `invalid timeout "soon" for step a-consensus`,
+		},
+		{
+			name: "negative retries",
+			policy: `
+    retries: -1
+`,
+			errMsg: "invalid retries -1 for step a-consensus",
+		},
+		{
+			name: "backoff min greater than max",
+			policy: `
+    retries: 1
+    backoff:
+      min: "1m"
+      max: "1s"
+`,
+			errMsg: "invalid backoff for step a-consensus",
+		},
+		{
+			name: "backoff factor less than 1",
+			policy: `
+    retries: 1
+    backoff:
+      factor: 0.5
+`,
+			errMsg: "invalid backoff factor 0.5 for step a-consensus",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(st *testing.T) {
+			wf, err := Parse(fmt.Sprintf(workflowFmt, tc.policy))
+			if tc.errMsg != "" {
+				assert.ErrorContains(st, err, tc.errMsg)
+				return
+			}
+			require.NoError(st, err)
+
+			consensus, err := wf.Vertex("a-consensus")
+			require.NoError(st, err)
+			assert.Equal(st, tc.expected, consensus.policy)
+
+			// the target has no ref, so its policy is keyed by its ID
+			target, err := wf.Vertex("a-target")
+			require.NoError(st, err)
+			assert.Equal(st, 5*time.Second, target.policy.timeout)
+		})
+	}
+}
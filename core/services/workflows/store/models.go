@@ -1,6 +1,7 @@
 package store
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
@@ -13,6 +14,9 @@ const (
 	StatusCompleted = "completed"
 )
 
+// Statuses are the values of the workflow_status enum.
+var Statuses = []string{StatusStarted, StatusErrored, StatusTimeout, StatusCompleted}
+
 type StepOutput struct {
 	Err   error
 	Value values.Value
@@ -25,10 +29,44 @@ type WorkflowExecutionStep struct {
 
 	Inputs  *values.Map
 	Outputs StepOutput
+	// Attempts is the number of times the step was executed, including retries.
+	Attempts int
 
 	UpdatedAt *time.Time
 }
 
+// InputsJSON returns the inputs of the step encoded as JSON, or nil if the step has no inputs.
+func (s *WorkflowExecutionStep) InputsJSON() ([]byte, error) {
+	if s.Inputs == nil {
+		return nil, nil
+	}
+	return valueToJSON(s.Inputs)
+}
+
+// OutputsJSON returns the output value of the step encoded as JSON, or nil if the step has no output value.
+func (s *WorkflowExecutionStep) OutputsJSON() ([]byte, error) {
+	if s.Outputs.Value == nil {
+		return nil, nil
+	}
+	return valueToJSON(s.Outputs.Value)
+}
+
+func valueToJSON(v values.Value) ([]byte, error) {
+	unwrapped, err := values.Unwrap(v)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(unwrapped)
+}
+
+// ExecutionFilter restricts the executions returned by Store.List. Zero values match all executions.
+type ExecutionFilter struct {
+	WorkflowID    string
+	Statuses      []string
+	CreatedAfter  *time.Time // inclusive
+	CreatedBefore *time.Time // exclusive
+}
+
 type WorkflowExecution struct {
 	Steps       map[string]*WorkflowExecutionStep
 	ExecutionID string
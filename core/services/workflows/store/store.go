@@ -10,6 +10,12 @@ type Store interface {
 	UpdateStatus(ctx context.Context, executionID string, status string) error
 	Get(ctx context.Context, executionID string) (WorkflowExecution, error)
 	GetUnfinished(ctx context.Context, offset, limit int) ([]WorkflowExecution, error)
+	// GetDeadLetters returns a page of the executions that errored or timed out, most recently finished first,
+	// along with the total number of such executions.
+	GetDeadLetters(ctx context.Context, offset, limit int) ([]WorkflowExecution, int, error)
+	// List returns a page of the executions matching the filter without their steps, most recently created first,
+	// along with the total number of matching executions.
+	List(ctx context.Context, filter ExecutionFilter, offset, limit int) ([]WorkflowExecution, int, error)
 }
 
 var _ Store = (*InMemoryStore)(nil)
@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"google.golang.org/protobuf/proto"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/jonboulle/clockwork"
+	"github.com/lib/pq"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
@@ -45,11 +47,13 @@ type workflowStepRow struct {
 	OutputErr           *string    `db:"output_err"`
 	OutputValue         []byte     `db:"output_value"`
 	UpdatedAt           *time.Time `db:"updated_at"`
+	Attempts            int
 }
 
 // `UpdateStatus` updates the status of the given workflow execution
 func (d *DBStore) UpdateStatus(ctx context.Context, executionID string, status string) error {
-	sql := `UPDATE workflow_executions SET status = $1, updated_at = $2 WHERE id = $3`
+	// An execution that is started again, i.e. restarted, is no longer finished.
+	sql := `UPDATE workflow_executions SET status = $1, updated_at = $2, finished_at = NULL WHERE id = $3`
 
 	// If we're completing the workflow execution, let's also set a finished_at timestamp.
 	if status != StatusStarted {
@@ -156,6 +160,7 @@ func stepToState(step workflowStepRow) (*WorkflowExecutionStep, error) {
 			Err:   outputErr,
 			Value: outputs,
 		},
+		Attempts: step.Attempts,
 	}, nil
 }
 
@@ -175,6 +180,7 @@ func stateToStep(state *WorkflowExecutionStep) (workflowStepRow, error) {
 		Ref:                 state.Ref,
 		Status:              state.Status,
 		Inputs:              inpb,
+		Attempts:            state.Attempts,
 	}
 
 	if state.Outputs.Value != nil {
@@ -235,8 +241,8 @@ func (d *DBStore) upsertSteps(ctx context.Context, steps []workflowStepRow) erro
 
 	sql := `
 	INSERT INTO
-	workflow_steps(workflow_execution_id, ref, status, inputs, output_err, output_value, updated_at)
-	VALUES (:workflow_execution_id, :ref, :status, :inputs, :output_err, :output_value, :updated_at)
+	workflow_steps(workflow_execution_id, ref, status, inputs, output_err, output_value, updated_at, attempts)
+	VALUES (:workflow_execution_id, :ref, :status, :inputs, :output_err, :output_value, :updated_at, :attempts)
 	ON CONFLICT ON CONSTRAINT uniq_workflow_execution_id_ref
 	DO UPDATE SET
 		workflow_execution_id = EXCLUDED.workflow_execution_id,
@@ -245,7 +251,8 @@ func (d *DBStore) upsertSteps(ctx context.Context, steps []workflowStepRow) erro
 		inputs = EXCLUDED.inputs,
 		output_err = EXCLUDED.output_err,
 		output_value = EXCLUDED.output_value,
-		updated_at = EXCLUDED.updated_at;
+		updated_at = EXCLUDED.updated_at,
+		attempts = EXCLUDED.attempts;
 	`
 	stmt, args, err := sqlx.Named(sql, steps)
 	if err != nil {
@@ -279,7 +286,34 @@ func (d *DBStore) transact(ctx context.Context, fn func(*DBStore) error) error {
 }
 
 func (d *DBStore) GetUnfinished(ctx context.Context, offset, limit int) ([]WorkflowExecution, error) {
+	return d.getWithStatus(ctx, []string{StatusStarted}, "created_at", offset, limit)
+}
+
+func (d *DBStore) GetDeadLetters(ctx context.Context, offset, limit int) ([]WorkflowExecution, int, error) {
+	statuses := []string{StatusErrored, StatusTimeout}
+	var count int
+	if err := d.db.GetContext(ctx, &count, `SELECT count(*) FROM workflow_executions WHERE status = ANY($1::workflow_status[])`, pq.Array(statuses)); err != nil {
+		return nil, 0, fmt.Errorf("failed to count dead letters: %w", err)
+	}
+	executions, err := d.getWithStatus(ctx, statuses, "finished_at", offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	return executions, count, nil
+}
+
+// `getWithStatus` fetches a page of the executions in one of the given statuses along with their steps,
+// ordered by the given workflow_executions column, most recent first.
+// The executions are paginated before they are joined with their steps, and executions without steps are included.
+func (d *DBStore) getWithStatus(ctx context.Context, statuses []string, orderBy string, offset, limit int) ([]WorkflowExecution, error) {
 	sql := `
+	WITH executions AS (
+		SELECT * FROM workflow_executions
+		WHERE status = ANY($1::workflow_status[])
+		ORDER BY ` + orderBy + ` DESC, id
+		LIMIT $2
+		OFFSET $3
+	)
 	SELECT
 		workflow_steps.workflow_execution_id AS ws_workflow_execution_id,
 		workflow_steps.ref AS ws_ref,
@@ -288,29 +322,28 @@ func (d *DBStore) GetUnfinished(ctx context.Context, offset, limit int) ([]Workf
 		workflow_steps.output_err AS ws_output_err,
 		workflow_steps.output_value AS ws_output_value,
 		workflow_steps.updated_at AS ws_updated_at,
-		workflow_executions.id AS we_id,
-		workflow_executions.workflow_id AS we_workflow_id,
-		workflow_executions.status AS we_status,
-		workflow_executions.created_at AS we_created_at,
-		workflow_executions.updated_at AS we_updated_at,
-		workflow_executions.finished_at AS we_finished_at
-	FROM workflow_executions
-	JOIN workflow_steps
-	ON  workflow_steps.workflow_execution_id = workflow_executions.id
-	WHERE workflow_executions.status = $1
-	ORDER BY workflow_executions.created_at DESC
-	LIMIT $2
-	OFFSET $3
+		workflow_steps.attempts AS ws_attempts,
+		executions.id AS we_id,
+		executions.workflow_id AS we_workflow_id,
+		executions.status AS we_status,
+		executions.created_at AS we_created_at,
+		executions.updated_at AS we_updated_at,
+		executions.finished_at AS we_finished_at
+	FROM executions
+	LEFT JOIN workflow_steps
+	ON  workflow_steps.workflow_execution_id = executions.id
+	ORDER BY executions.` + orderBy + ` DESC, executions.id
 	`
 	joinRecords := []struct {
-		// WorkflowExecutionStep fields
-		WSWorkflowExecutionID string     `db:"ws_workflow_execution_id"`
-		WSRef                 string     `db:"ws_ref"`
-		WSStatus              string     `db:"ws_status"`
+		// WorkflowExecutionStep fields, NULL for executions without steps
+		WSWorkflowExecutionID *string    `db:"ws_workflow_execution_id"`
+		WSRef                 *string    `db:"ws_ref"`
+		WSStatus              *string    `db:"ws_status"`
 		WSInputs              []byte     `db:"ws_inputs"`
 		WSOutputErr           *string    `db:"ws_output_err"`
 		WSOutputValue         []byte     `db:"ws_output_value"`
 		WSUpdatedAt           *time.Time `db:"ws_updated_at"`
+		WSAttempts            *int       `db:"ws_attempts"`
 
 		// WorkflowExecution fields
 		WEID         string     `db:"we_id"`
@@ -320,19 +353,23 @@ func (d *DBStore) GetUnfinished(ctx context.Context, offset, limit int) ([]Workf
 		WEUpdatedAt  *time.Time `db:"we_updated_at"`
 		WEFinishedAt *time.Time `db:"we_finished_at"`
 	}{}
-	err := d.db.SelectContext(ctx, &joinRecords, sql, StatusStarted, limit, offset)
+	err := d.db.SelectContext(ctx, &joinRecords, sql, pq.Array(statuses), limit, offset)
 	if err != nil {
 		return []WorkflowExecution{}, err
 	}
 
-	idToExecutionState := map[string]*WorkflowExecution{}
+	states := []WorkflowExecution{}
+	idToIndex := map[string]int{}
 	for _, jr := range joinRecords {
-		var wid string
-		if jr.WEWorkflowID != nil {
-			wid = *jr.WEWorkflowID
-		}
-		if _, ok := idToExecutionState[jr.WEID]; !ok {
-			idToExecutionState[jr.WEID] = &WorkflowExecution{
+		i, ok := idToIndex[jr.WEID]
+		if !ok {
+			var wid string
+			if jr.WEWorkflowID != nil {
+				wid = *jr.WEWorkflowID
+			}
+			i = len(states)
+			idToIndex[jr.WEID] = i
+			states = append(states, WorkflowExecution{
 				ExecutionID: jr.WEID,
 				WorkflowID:  wid,
 				Status:      jr.WEStatus,
@@ -340,32 +377,86 @@ func (d *DBStore) GetUnfinished(ctx context.Context, offset, limit int) ([]Workf
 				CreatedAt:   jr.WECreatedAt,
 				UpdatedAt:   jr.WEUpdatedAt,
 				FinishedAt:  jr.WEFinishedAt,
-			}
+			})
+		}
+		if jr.WSRef == nil {
+			continue
 		}
 
 		state, err := stepToState(workflowStepRow{
-			WorkflowExecutionID: jr.WSWorkflowExecutionID,
-			Ref:                 jr.WSRef,
+			WorkflowExecutionID: *jr.WSWorkflowExecutionID,
+			Ref:                 *jr.WSRef,
 			OutputErr:           jr.WSOutputErr,
 			OutputValue:         jr.WSOutputValue,
 			Inputs:              jr.WSInputs,
-			Status:              jr.WSStatus,
+			Status:              *jr.WSStatus,
 			UpdatedAt:           jr.WSUpdatedAt,
+			Attempts:            *jr.WSAttempts,
 		})
 		if err != nil {
 			return nil, err
 		}
+		states[i].Steps[state.Ref] = state
+	}
 
-		es := idToExecutionState[jr.WEID]
-		es.Steps[state.Ref] = state
+	return states, nil
+}
+
+// `List` fetches a page of the executions matching the filter, without their steps,
+// most recently created first, along with the total number of matching executions.
+func (d *DBStore) List(ctx context.Context, filter ExecutionFilter, offset, limit int) ([]WorkflowExecution, int, error) {
+	var conds []string
+	var args []any
+	addCond := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if filter.WorkflowID != "" {
+		addCond("workflow_id = $%d", filter.WorkflowID)
+	}
+	if len(filter.Statuses) > 0 {
+		addCond("status = ANY($%d::workflow_status[])", pq.Array(filter.Statuses))
+	}
+	if filter.CreatedAfter != nil {
+		addCond("created_at >= $%d", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		addCond("created_at < $%d", *filter.CreatedBefore)
+	}
+	where := ""
+	if len(conds) > 0 {
+		where = " WHERE " + strings.Join(conds, " AND ")
 	}
 
-	states := []WorkflowExecution{}
-	for _, s := range idToExecutionState {
-		states = append(states, *s)
+	var count int
+	if err := d.db.GetContext(ctx, &count, `SELECT count(*) FROM workflow_executions`+where, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to count workflow executions: %w", err)
 	}
 
-	return states, nil
+	var rows []workflowExecutionRow
+	sql := `SELECT * FROM workflow_executions` + where +
+		fmt.Sprintf(` ORDER BY created_at DESC, id LIMIT $%d OFFSET $%d`, len(args)+1, len(args)+2)
+	if err := d.db.SelectContext(ctx, &rows, sql, append(args, limit, offset)...); err != nil {
+		return nil, 0, fmt.Errorf("failed to list workflow executions: %w", err)
+	}
+
+	executions := make([]WorkflowExecution, 0, len(rows))
+	for _, row := range rows {
+		var wid string
+		if row.WorkflowID != nil {
+			wid = *row.WorkflowID
+		}
+		executions = append(executions, WorkflowExecution{
+			ExecutionID: row.ID,
+			WorkflowID:  wid,
+			Status:      row.Status,
+			Steps:       map[string]*WorkflowExecutionStep{},
+			CreatedAt:   row.CreatedAt,
+			UpdatedAt:   row.UpdatedAt,
+			FinishedAt:  row.FinishedAt,
+		})
+	}
+	return executions, count, nil
 }
 
 func NewDBStore(ds sqlutil.DataSource, clock clockwork.Clock) *DBStore {
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/assert"
@@ -213,3 +214,140 @@ func Test_StoreDB_GetUnfinishedSteps(t *testing.T) {
 	states[0].CreatedAt = nil
 	assert.Equal(t, es, states[0])
 }
+
+func Test_StoreDB_GetDeadLetters(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	clock := clockwork.NewFakeClock()
+	store := &DBStore{db: db, clock: clock}
+
+	id := randomID()
+	stepOne := &WorkflowExecutionStep{
+		ExecutionID: id,
+		Ref:         "step1",
+		Status:      "completed",
+		Attempts:    1,
+	}
+	stepTwo := &WorkflowExecutionStep{
+		ExecutionID: id,
+		Ref:         "step2",
+		Status:      "errored",
+		Outputs:     StepOutput{Err: errors.New("some error")},
+		Attempts:    4,
+	}
+	es := WorkflowExecution{
+		Steps: map[string]*WorkflowExecutionStep{
+			"step1": stepOne,
+			"step2": stepTwo,
+		},
+		ExecutionID: id,
+		Status:      "started",
+	}
+	err := store.Add(tests.Context(t), &es)
+	require.NoError(t, err)
+	err = store.UpdateStatus(tests.Context(t), id, StatusErrored)
+	require.NoError(t, err)
+
+	for _, status := range []string{"started", "completed"} {
+		err = store.Add(tests.Context(t), &WorkflowExecution{
+			ExecutionID: randomID(),
+			Status:      status,
+			Steps:       map[string]*WorkflowExecutionStep{},
+		})
+		require.NoError(t, err)
+	}
+
+	// an execution which timed out before running any step, finished after the errored one
+	clock.Advance(time.Minute)
+	noStepsID := randomID()
+	err = store.Add(tests.Context(t), &WorkflowExecution{
+		ExecutionID: noStepsID,
+		Status:      StatusStarted,
+		Steps:       map[string]*WorkflowExecutionStep{},
+	})
+	require.NoError(t, err)
+	err = store.UpdateStatus(tests.Context(t), noStepsID, StatusTimeout)
+	require.NoError(t, err)
+
+	states, count, err := store.GetDeadLetters(tests.Context(t), 0, 100)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, count)
+	require.Len(t, states, 2)
+	assert.Equal(t, noStepsID, states[0].ExecutionID)
+	assert.Equal(t, StatusTimeout, states[0].Status)
+	assert.Empty(t, states[0].Steps)
+	assert.Equal(t, id, states[1].ExecutionID)
+	assert.Equal(t, StatusErrored, states[1].Status)
+	assert.NotNil(t, states[1].FinishedAt)
+	assert.Equal(t, stepTwo, states[1].Steps["step2"])
+
+	// pages are made of executions, each with all of its steps
+	states, count, err = store.GetDeadLetters(tests.Context(t), 1, 1)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, count)
+	require.Len(t, states, 1)
+	assert.Equal(t, id, states[0].ExecutionID)
+	assert.Len(t, states[0].Steps, 2)
+}
+
+func Test_StoreDB_List(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	clock := clockwork.NewFakeClock()
+	store := &DBStore{db: db, clock: clock}
+
+	start := clock.Now()
+	var ids []string
+	for _, status := range []string{StatusCompleted, StatusErrored, StatusStarted, StatusCompleted} {
+		id := randomID()
+		ids = append(ids, id)
+		err := store.Add(tests.Context(t), &WorkflowExecution{
+			ExecutionID: id,
+			Status:      status,
+			Steps: map[string]*WorkflowExecutionStep{
+				"step1": {ExecutionID: id, Ref: "step1", Status: StatusCompleted, Attempts: 1},
+			},
+		})
+		require.NoError(t, err)
+		clock.Advance(time.Minute)
+	}
+
+	t.Run("all executions are listed most recent first", func(t *testing.T) {
+		states, total, err := store.List(tests.Context(t), ExecutionFilter{}, 0, 100)
+		require.NoError(t, err)
+		assert.Equal(t, 4, total)
+		require.Len(t, states, 4)
+		for i, state := range states {
+			assert.Equal(t, ids[3-i], state.ExecutionID)
+			assert.Empty(t, state.Steps)
+		}
+	})
+
+	t.Run("executions are paginated", func(t *testing.T) {
+		states, total, err := store.List(tests.Context(t), ExecutionFilter{}, 1, 2)
+		require.NoError(t, err)
+		assert.Equal(t, 4, total)
+		require.Len(t, states, 2)
+		assert.Equal(t, ids[2], states[0].ExecutionID)
+		assert.Equal(t, ids[1], states[1].ExecutionID)
+	})
+
+	t.Run("executions are filtered by status", func(t *testing.T) {
+		states, total, err := store.List(tests.Context(t), ExecutionFilter{Statuses: []string{StatusErrored, StatusStarted}}, 0, 100)
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+		require.Len(t, states, 2)
+		assert.Equal(t, ids[2], states[0].ExecutionID)
+		assert.Equal(t, ids[1], states[1].ExecutionID)
+	})
+
+	t.Run("executions are filtered by creation time", func(t *testing.T) {
+		after, before := start.Add(time.Minute), start.Add(3*time.Minute)
+		states, total, err := store.List(tests.Context(t), ExecutionFilter{CreatedAfter: &after, CreatedBefore: &before}, 0, 100)
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+		require.Len(t, states, 2)
+		assert.Equal(t, ids[2], states[0].ExecutionID)
+		assert.Equal(t, ids[1], states[1].ExecutionID)
+	})
+}
@@ -3,6 +3,8 @@ package store
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
 	"sync"
 )
 
@@ -84,3 +86,55 @@ func (s *InMemoryStore) GetUnfinished(ctx context.Context, offset, limit int) ([
 
 	return states, nil
 }
+
+// GetDeadLetters gets the states for executions that are in an errored or timeout state
+// Offset and limit are ignored for the in-memory store.
+func (s *InMemoryStore) GetDeadLetters(ctx context.Context, offset, limit int) ([]WorkflowExecution, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	states := []WorkflowExecution{}
+	for _, s := range s.idToState {
+		if s.Status == StatusErrored || s.Status == StatusTimeout {
+			states = append(states, *s)
+		}
+	}
+
+	return states, len(states), nil
+}
+
+// List gets a page of the executions matching the filter, most recently created first,
+// along with the total number of matching executions.
+func (s *InMemoryStore) List(ctx context.Context, filter ExecutionFilter, offset, limit int) ([]WorkflowExecution, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	states := []WorkflowExecution{}
+	for _, s := range s.idToState {
+		if filter.WorkflowID != "" && s.WorkflowID != filter.WorkflowID {
+			continue
+		}
+		if len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, s.Status) {
+			continue
+		}
+		if filter.CreatedAfter != nil && (s.CreatedAt == nil || s.CreatedAt.Before(*filter.CreatedAfter)) {
+			continue
+		}
+		if filter.CreatedBefore != nil && (s.CreatedAt == nil || !s.CreatedAt.Before(*filter.CreatedBefore)) {
+			continue
+		}
+		states = append(states, *s)
+	}
+	sort.Slice(states, func(i, j int) bool {
+		if states[i].CreatedAt == nil || states[j].CreatedAt == nil {
+			return states[j].CreatedAt == nil && states[i].CreatedAt != nil
+		}
+		return states[i].CreatedAt.After(*states[j].CreatedAt)
+	})
+
+	total := len(states)
+	if offset >= total {
+		return []WorkflowExecution{}, total, nil
+	}
+	return states[offset:min(offset+limit, total)], total, nil
+}
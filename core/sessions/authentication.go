@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 
+	"gopkg.in/guregu/null.v4"
+
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 )
@@ -15,6 +17,7 @@ type AuthenticationProviderName string
 const (
 	LocalAuth AuthenticationProviderName = "local"
 	LDAPAuth  AuthenticationProviderName = "ldap"
+	OIDCAuth  AuthenticationProviderName = "oidc"
 )
 
 // ErrUserSessionExpired defines the error triggered when the user session has expired
@@ -62,6 +65,23 @@ type AuthenticationProvider interface {
 	Sessions(ctx context.Context, offset, limit int) ([]Session, error)
 	GetUserWebAuthn(ctx context.Context, email string) ([]WebAuthn, error)
 	SaveWebAuthn(ctx context.Context, token *WebAuthn) error
+	ListCustomRoles(ctx context.Context) ([]CustomRole, error)
+	CreateCustomRole(ctx context.Context, role *CustomRole) error
+	UpdateCustomRole(ctx context.Context, role *CustomRole) error
+	DeleteCustomRole(ctx context.Context, name string) error
+	SetCustomRole(ctx context.Context, email string, customRole null.String) (User, error)
+	SetAPITokenCustomRole(ctx context.Context, email string, customRole null.String) (User, error)
 
 	FindExternalInitiator(ctx context.Context, eia *auth.Token) (initiator *bridges.ExternalInitiator, err error)
 }
+
+// SingleSignOnProvider is implemented by the AuthenticationProviders which log users in by redirecting them to an
+// external identity provider, instead of with the credentials of a SessionRequest.
+type SingleSignOnProvider interface {
+	// BeginLogin returns the URL of the identity provider to redirect the user to, and the state which the identity
+	// provider passes back to the callback of the login. The state must be bound to the user agent.
+	BeginLogin(ctx context.Context) (redirectURL string, state string, err error)
+	// FinishLogin exchanges the authorization code the identity provider passed to the callback of the login started
+	// with state, and returns the ID of the new session of the user.
+	FinishLogin(ctx context.Context, state string, code string) (sessionID string, err error)
+}
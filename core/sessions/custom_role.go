@@ -0,0 +1,155 @@
+package sessions
+
+import (
+	"database/sql/driver"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// Permission allows a user to act on a kind of resource. Every permission is granted by a minimum UserRole, and can
+// be granted to users with a lower role by a CustomRole.
+type Permission string
+
+const (
+	PermissionBridgesWrite            Permission = "bridges:write"
+	PermissionExternalInitiatorsWrite Permission = "external_initiators:write"
+	PermissionFeedsManagersWrite      Permission = "feeds_managers:write"
+	PermissionForwardersWrite         Permission = "forwarders:write"
+	PermissionJobProposalsApprove     Permission = "job_proposals:approve"
+	PermissionJobsRun                 Permission = "jobs:run"
+	PermissionJobsWrite               Permission = "jobs:write"
+	PermissionKeysCreate              Permission = "keys:create"
+	PermissionKeysManage              Permission = "keys:manage"
+)
+
+// permissionRoles maps every permission to the minimum role which grants it.
+var permissionRoles = map[Permission]UserRole{
+	PermissionBridgesWrite:            UserRoleEdit,
+	PermissionExternalInitiatorsWrite: UserRoleEdit,
+	PermissionFeedsManagersWrite:      UserRoleEdit,
+	PermissionForwardersWrite:         UserRoleEdit,
+	PermissionJobProposalsApprove:     UserRoleEdit,
+	PermissionJobsRun:                 UserRoleRun,
+	PermissionJobsWrite:               UserRoleEdit,
+	PermissionKeysCreate:              UserRoleEdit,
+	PermissionKeysManage:              UserRoleAdmin,
+}
+
+// MinimumRole returns the minimum role which grants p.
+func (p Permission) MinimumRole() UserRole {
+	if role, ok := permissionRoles[p]; ok {
+		return role
+	}
+	return UserRoleAdmin
+}
+
+// AllPermissions returns all the permissions, sorted.
+func AllPermissions() []Permission {
+	perms := make([]Permission, 0, len(permissionRoles))
+	for p := range permissionRoles {
+		perms = append(perms, p)
+	}
+	sort.Slice(perms, func(i, j int) bool { return perms[i] < perms[j] })
+	return perms
+}
+
+// ParsePermissions validates the permissions of a CustomRole.
+func ParsePermissions(perms []string) (Permissions, error) {
+	if len(perms) == 0 {
+		return nil, pkgerrors.New("a custom role must have at least one permission")
+	}
+	parsed := make(Permissions, 0, len(perms))
+	for _, s := range perms {
+		p := Permission(strings.TrimSpace(s))
+		if _, ok := permissionRoles[p]; !ok {
+			return nil, pkgerrors.Errorf("invalid permission: %q. Allowed permissions: %s", s, AllPermissions())
+		}
+		if !slices.Contains(parsed, p) {
+			parsed = append(parsed, p)
+		}
+	}
+	return parsed, nil
+}
+
+// Permissions is a list of permissions, stored as a text array.
+type Permissions []Permission
+
+// Scan implements sql.Scanner.
+func (ps *Permissions) Scan(src interface{}) error {
+	var a pq.StringArray
+	if err := a.Scan(src); err != nil {
+		return err
+	}
+	if a == nil {
+		*ps = nil
+		return nil
+	}
+	*ps = make(Permissions, len(a))
+	for i, s := range a {
+		(*ps)[i] = Permission(s)
+	}
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (ps Permissions) Value() (driver.Value, error) {
+	a := make(pq.StringArray, len(ps))
+	for i, p := range ps {
+		a[i] = string(p)
+	}
+	return a.Value()
+}
+
+// CustomRole grants permissions to the users and API tokens it is assigned to, in addition to the ones granted by
+// their UserRole.
+type CustomRole struct {
+	Name        string
+	Permissions Permissions
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NewCustomRole validates the name and permissions of a new CustomRole.
+func NewCustomRole(name string, perms []string) (CustomRole, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return CustomRole{}, pkgerrors.New("a custom role must have a name")
+	}
+	if _, err := GetUserRole(name); err == nil {
+		return CustomRole{}, pkgerrors.Errorf("%q is a built-in role", name)
+	}
+	parsed, err := ParsePermissions(perms)
+	if err != nil {
+		return CustomRole{}, err
+	}
+	return CustomRole{Name: name, Permissions: parsed}, nil
+}
+
+// HasPermission returns whether u is granted p by its Role or its custom role.
+func (u *User) HasPermission(p Permission) bool {
+	if u.Role.grants(p.MinimumRole()) {
+		return true
+	}
+	return slices.Contains(u.Permissions, p)
+}
+
+var userRoleRanks = map[UserRole]int{
+	UserRoleView:  0,
+	UserRoleRun:   1,
+	UserRoleEdit:  2,
+	UserRoleAdmin: 3,
+}
+
+// grants returns whether r is at least role.
+func (r UserRole) grants(role UserRole) bool {
+	rank, ok := userRoleRanks[r]
+	if !ok {
+		return false
+	}
+	return rank >= userRoleRanks[role]
+}
@@ -0,0 +1,58 @@
+package sessions_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+func TestNewCustomRole(t *testing.T) {
+	t.Parallel()
+
+	role, err := sessions.NewCustomRole(" key_operator ", []string{"keys:create", " keys:manage", "keys:create"})
+	require.NoError(t, err)
+	assert.Equal(t, "key_operator", role.Name)
+	assert.Equal(t, sessions.Permissions{sessions.PermissionKeysCreate, sessions.PermissionKeysManage}, role.Permissions)
+
+	for _, test := range []struct {
+		name  string
+		perms []string
+	}{
+		{"", []string{"keys:create"}},
+		{"admin", []string{"keys:create"}},
+		{"key_operator", nil},
+		{"key_operator", []string{"keys:delete"}},
+	} {
+		_, err := sessions.NewCustomRole(test.name, test.perms)
+		assert.Error(t, err, "name %q, permissions %v", test.name, test.perms)
+	}
+}
+
+func TestUser_HasPermission(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		role        sessions.UserRole
+		permissions sessions.Permissions
+		permission  sessions.Permission
+		want        bool
+	}{
+		{sessions.UserRoleAdmin, nil, sessions.PermissionKeysManage, true},
+		{sessions.UserRoleEdit, nil, sessions.PermissionKeysManage, false},
+		{sessions.UserRoleEdit, nil, sessions.PermissionKeysCreate, true},
+		{sessions.UserRoleRun, nil, sessions.PermissionJobsRun, true},
+		{sessions.UserRoleRun, nil, sessions.PermissionJobsWrite, false},
+		{sessions.UserRoleView, nil, sessions.PermissionJobsRun, false},
+		{sessions.UserRoleView, sessions.Permissions{sessions.PermissionJobsRun}, sessions.PermissionJobsRun, true},
+		{sessions.UserRoleView, sessions.Permissions{sessions.PermissionJobsRun}, sessions.PermissionJobsWrite, false},
+		{sessions.UserRoleEdit, sessions.Permissions{sessions.PermissionKeysManage}, sessions.PermissionKeysManage, true},
+	}
+
+	for _, test := range tests {
+		user := sessions.User{Role: test.role, Permissions: test.permissions}
+		assert.Equal(t, test.want, user.HasPermission(test.permission), "role %s, permissions %v, permission %s", test.role, test.permissions, test.permission)
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"time"
 
 	"github.com/go-ldap/ldap/v3"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mathutil"
@@ -597,6 +598,36 @@ func (l *ldapAuthenticator) SaveWebAuthn(ctx context.Context, token *sessions.We
 	return sessions.ErrNotSupported
 }
 
+// ListCustomRoles is not supported for read only LDAP, LDAP users are granted roles by their groups
+func (l *ldapAuthenticator) ListCustomRoles(ctx context.Context) ([]sessions.CustomRole, error) {
+	return nil, sessions.ErrNotSupported
+}
+
+// CreateCustomRole is not supported for read only LDAP
+func (l *ldapAuthenticator) CreateCustomRole(ctx context.Context, role *sessions.CustomRole) error {
+	return sessions.ErrNotSupported
+}
+
+// UpdateCustomRole is not supported for read only LDAP
+func (l *ldapAuthenticator) UpdateCustomRole(ctx context.Context, role *sessions.CustomRole) error {
+	return sessions.ErrNotSupported
+}
+
+// DeleteCustomRole is not supported for read only LDAP
+func (l *ldapAuthenticator) DeleteCustomRole(ctx context.Context, name string) error {
+	return sessions.ErrNotSupported
+}
+
+// SetCustomRole is not supported for read only LDAP
+func (l *ldapAuthenticator) SetCustomRole(ctx context.Context, email string, customRole null.String) (sessions.User, error) {
+	return sessions.User{}, sessions.ErrNotSupported
+}
+
+// SetAPITokenCustomRole is not supported for read only LDAP
+func (l *ldapAuthenticator) SetAPITokenCustomRole(ctx context.Context, email string, customRole null.String) (sessions.User, error) {
+	return sessions.User{}, sessions.ErrNotSupported
+}
+
 // Sessions returns all sessions limited by the parameters.
 func (l *ldapAuthenticator) Sessions(ctx context.Context, offset, limit int) ([]sessions.Session, error) {
 	var sessions []sessions.Session
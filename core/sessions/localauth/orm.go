@@ -8,6 +8,7 @@ import (
 	"time"
 
 	pkgerrors "github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/mathutil"
@@ -44,15 +45,22 @@ func (o *orm) FindUser(ctx context.Context, email string) (sessions.User, error)
 	return o.findUser(ctx, email)
 }
 
-// FindUserByAPIToken will attempt to return an API user via the user's table token_key column.
+// FindUserByAPIToken will attempt to return an API user via the user's table token_key column, with the permissions
+// of the custom role of its API token, or of the user if the token has none.
 func (o *orm) FindUserByAPIToken(ctx context.Context, apiToken string) (user sessions.User, err error) {
-	sql := "SELECT * FROM users WHERE token_key = $1"
+	sql := `SELECT users.*, COALESCE(token_roles.permissions, user_roles.permissions) AS permissions FROM users
+	LEFT JOIN custom_roles user_roles ON user_roles.name = users.custom_role
+	LEFT JOIN custom_roles token_roles ON token_roles.name = users.token_custom_role
+	WHERE users.token_key = $1`
 	err = o.ds.GetContext(ctx, &user, sql, apiToken)
 	return
 }
 
+// findUser returns the user with the permissions of its custom role.
 func (o *orm) findUser(ctx context.Context, email string) (user sessions.User, err error) {
-	sql := "SELECT * FROM users WHERE lower(email) = lower($1)"
+	sql := `SELECT users.*, custom_roles.permissions FROM users
+	LEFT JOIN custom_roles ON custom_roles.name = users.custom_role
+	WHERE lower(users.email) = lower($1)`
 	err = o.ds.GetContext(ctx, &user, sql, email)
 	return
 }
@@ -347,6 +355,47 @@ func (o *orm) SaveWebAuthn(ctx context.Context, token *sessions.WebAuthn) error
 	return err
 }
 
+// ListCustomRoles returns all the custom roles, ordered by name.
+func (o *orm) ListCustomRoles(ctx context.Context) (roles []sessions.CustomRole, err error) {
+	sql := "SELECT * FROM custom_roles ORDER BY name ASC;"
+	err = o.ds.SelectContext(ctx, &roles, sql)
+	return
+}
+
+// CreateCustomRole creates a new custom role.
+func (o *orm) CreateCustomRole(ctx context.Context, role *sessions.CustomRole) error {
+	sql := "INSERT INTO custom_roles (name, permissions, created_at, updated_at) VALUES ($1, $2, now(), now()) RETURNING *"
+	return o.ds.GetContext(ctx, role, sql, role.Name, role.Permissions)
+}
+
+// UpdateCustomRole overwrites the permissions of a custom role. They apply to the next requests of the users it is
+// assigned to.
+func (o *orm) UpdateCustomRole(ctx context.Context, role *sessions.CustomRole) error {
+	sql := "UPDATE custom_roles SET permissions = $1, updated_at = now() WHERE name = $2 RETURNING *"
+	return o.ds.GetContext(ctx, role, sql, role.Permissions, role.Name)
+}
+
+// DeleteCustomRole deletes a custom role, and unassigns it from its users and API tokens.
+func (o *orm) DeleteCustomRole(ctx context.Context, name string) error {
+	var deleted string
+	return o.ds.GetContext(ctx, &deleted, "DELETE FROM custom_roles WHERE name = $1 RETURNING name", name)
+}
+
+// SetCustomRole assigns a custom role to the user specified by email, or unassigns it if customRole is null.
+func (o *orm) SetCustomRole(ctx context.Context, email string, customRole null.String) (user sessions.User, err error) {
+	sql := "UPDATE users SET custom_role = $1, updated_at = now() WHERE lower(email) = lower($2) RETURNING *"
+	err = o.ds.GetContext(ctx, &user, sql, customRole, email)
+	return
+}
+
+// SetAPITokenCustomRole assigns a custom role to the API token of the user specified by email, or unassigns it if
+// customRole is null.
+func (o *orm) SetAPITokenCustomRole(ctx context.Context, email string, customRole null.String) (user sessions.User, err error) {
+	sql := "UPDATE users SET token_custom_role = $1, updated_at = now() WHERE lower(email) = lower($2) RETURNING *"
+	err = o.ds.GetContext(ctx, &user, sql, customRole, email)
+	return
+}
+
 // Sessions returns all sessions limited by the parameters.
 func (o *orm) Sessions(ctx context.Context, offset, limit int) (sessions []sessions.Session, err error) {
 	sql := `SELECT * FROM sessions ORDER BY created_at, id LIMIT $1 OFFSET $2;`
@@ -1,6 +1,7 @@
 package localauth_test
 
 import (
+	"database/sql"
 	"encoding/json"
 	"testing"
 	"time"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/jmoiron/sqlx"
 
@@ -309,3 +311,72 @@ func TestOrm_GenerateAuthToken(t *testing.T) {
 	assert.Empty(t, dbUser.TokenSalt.ValueOrZero())
 	assert.Empty(t, dbUser.TokenHashedSecret.ValueOrZero())
 }
+
+func TestORM_CustomRoles(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	_, orm := setupORM(t)
+
+	user := cltest.MustRandomUser(t)
+	user.Role = sessions.UserRoleView
+	require.NoError(t, orm.CreateUser(ctx, &user))
+	token, err := orm.CreateAndSetAuthToken(ctx, &user)
+	require.NoError(t, err)
+
+	keys, err := sessions.NewCustomRole("keys", []string{"keys:create"})
+	require.NoError(t, err)
+	require.NoError(t, orm.CreateCustomRole(ctx, &keys))
+	jobs, err := sessions.NewCustomRole("jobs", []string{"jobs:run"})
+	require.NoError(t, err)
+	require.NoError(t, orm.CreateCustomRole(ctx, &jobs))
+	require.Error(t, orm.CreateCustomRole(ctx, &jobs))
+
+	roles, err := orm.ListCustomRoles(ctx)
+	require.NoError(t, err)
+	require.Len(t, roles, 2)
+	assert.Equal(t, "jobs", roles[0].Name)
+	assert.Equal(t, sessions.Permissions{sessions.PermissionKeysCreate}, roles[1].Permissions)
+
+	_, err = orm.SetCustomRole(ctx, user.Email, null.StringFrom("missing"))
+	require.Error(t, err)
+	_, err = orm.SetCustomRole(ctx, "missing@chainlink.test", null.StringFrom(keys.Name))
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	dbUser, err := orm.SetCustomRole(ctx, user.Email, null.StringFrom(keys.Name))
+	require.NoError(t, err)
+	assert.Equal(t, keys.Name, dbUser.CustomRole.String)
+
+	dbUser, err = orm.FindUser(ctx, user.Email)
+	require.NoError(t, err)
+	assert.True(t, dbUser.HasPermission(sessions.PermissionKeysCreate))
+	assert.False(t, dbUser.HasPermission(sessions.PermissionJobsRun))
+
+	// API tokens without a custom role have the permissions of the user
+	dbUser, err = orm.FindUserByAPIToken(ctx, token.AccessKey)
+	require.NoError(t, err)
+	assert.True(t, dbUser.HasPermission(sessions.PermissionKeysCreate))
+
+	_, err = orm.SetAPITokenCustomRole(ctx, user.Email, null.StringFrom(jobs.Name))
+	require.NoError(t, err)
+	dbUser, err = orm.FindUserByAPIToken(ctx, token.AccessKey)
+	require.NoError(t, err)
+	assert.False(t, dbUser.HasPermission(sessions.PermissionKeysCreate))
+	assert.True(t, dbUser.HasPermission(sessions.PermissionJobsRun))
+
+	keys.Permissions = sessions.Permissions{sessions.PermissionKeysCreate, sessions.PermissionKeysManage}
+	require.NoError(t, orm.UpdateCustomRole(ctx, &keys))
+	dbUser, err = orm.FindUser(ctx, user.Email)
+	require.NoError(t, err)
+	assert.True(t, dbUser.HasPermission(sessions.PermissionKeysManage))
+
+	missing := sessions.CustomRole{Name: "missing", Permissions: keys.Permissions}
+	require.ErrorIs(t, orm.UpdateCustomRole(ctx, &missing), sql.ErrNoRows)
+	require.ErrorIs(t, orm.DeleteCustomRole(ctx, missing.Name), sql.ErrNoRows)
+
+	require.NoError(t, orm.DeleteCustomRole(ctx, keys.Name))
+	dbUser, err = orm.FindUser(ctx, user.Email)
+	require.NoError(t, err)
+	assert.False(t, dbUser.CustomRole.Valid)
+	assert.False(t, dbUser.HasPermission(sessions.PermissionKeysCreate))
+}
@@ -10,6 +10,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	null "gopkg.in/guregu/null.v4"
+
 	sessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 )
 
@@ -94,6 +96,24 @@ func (_m *AuthenticationProvider) CreateAndSetAuthToken(ctx context.Context, use
 	return r0, r1
 }
 
+// CreateCustomRole provides a mock function with given fields: ctx, role
+func (_m *AuthenticationProvider) CreateCustomRole(ctx context.Context, role *sessions.CustomRole) error {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCustomRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sessions.CustomRole) error); ok {
+		r0 = rf(ctx, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateSession provides a mock function with given fields: ctx, sr
 func (_m *AuthenticationProvider) CreateSession(ctx context.Context, sr sessions.SessionRequest) (string, error) {
 	ret := _m.Called(ctx, sr)
@@ -158,6 +178,24 @@ func (_m *AuthenticationProvider) DeleteAuthToken(ctx context.Context, user *ses
 	return r0
 }
 
+// DeleteCustomRole provides a mock function with given fields: ctx, name
+func (_m *AuthenticationProvider) DeleteCustomRole(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCustomRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteUser provides a mock function with given fields: ctx, email
 func (_m *AuthenticationProvider) DeleteUser(ctx context.Context, email string) error {
 	ret := _m.Called(ctx, email)
@@ -310,6 +348,36 @@ func (_m *AuthenticationProvider) GetUserWebAuthn(ctx context.Context, email str
 	return r0, r1
 }
 
+// ListCustomRoles provides a mock function with given fields: ctx
+func (_m *AuthenticationProvider) ListCustomRoles(ctx context.Context) ([]sessions.CustomRole, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCustomRoles")
+	}
+
+	var r0 []sessions.CustomRole
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]sessions.CustomRole, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []sessions.CustomRole); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sessions.CustomRole)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListUsers provides a mock function with given fields: ctx
 func (_m *AuthenticationProvider) ListUsers(ctx context.Context) ([]sessions.User, error) {
 	ret := _m.Called(ctx)
@@ -388,6 +456,34 @@ func (_m *AuthenticationProvider) Sessions(ctx context.Context, offset int, limi
 	return r0, r1
 }
 
+// SetAPITokenCustomRole provides a mock function with given fields: ctx, email, customRole
+func (_m *AuthenticationProvider) SetAPITokenCustomRole(ctx context.Context, email string, customRole null.String) (sessions.User, error) {
+	ret := _m.Called(ctx, email, customRole)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAPITokenCustomRole")
+	}
+
+	var r0 sessions.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, null.String) (sessions.User, error)); ok {
+		return rf(ctx, email, customRole)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, null.String) sessions.User); ok {
+		r0 = rf(ctx, email, customRole)
+	} else {
+		r0 = ret.Get(0).(sessions.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, null.String) error); ok {
+		r1 = rf(ctx, email, customRole)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetAuthToken provides a mock function with given fields: ctx, user, token
 func (_m *AuthenticationProvider) SetAuthToken(ctx context.Context, user *sessions.User, token *auth.Token) error {
 	ret := _m.Called(ctx, user, token)
@@ -406,6 +502,34 @@ func (_m *AuthenticationProvider) SetAuthToken(ctx context.Context, user *sessio
 	return r0
 }
 
+// SetCustomRole provides a mock function with given fields: ctx, email, customRole
+func (_m *AuthenticationProvider) SetCustomRole(ctx context.Context, email string, customRole null.String) (sessions.User, error) {
+	ret := _m.Called(ctx, email, customRole)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCustomRole")
+	}
+
+	var r0 sessions.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, null.String) (sessions.User, error)); ok {
+		return rf(ctx, email, customRole)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, null.String) sessions.User); ok {
+		r0 = rf(ctx, email, customRole)
+	} else {
+		r0 = ret.Get(0).(sessions.User)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, null.String) error); ok {
+		r1 = rf(ctx, email, customRole)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetPassword provides a mock function with given fields: ctx, user, newPassword
 func (_m *AuthenticationProvider) SetPassword(ctx context.Context, user *sessions.User, newPassword string) error {
 	ret := _m.Called(ctx, user, newPassword)
@@ -442,6 +566,24 @@ func (_m *AuthenticationProvider) TestPassword(ctx context.Context, email string
 	return r0
 }
 
+// UpdateCustomRole provides a mock function with given fields: ctx, role
+func (_m *AuthenticationProvider) UpdateCustomRole(ctx context.Context, role *sessions.CustomRole) error {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCustomRole")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *sessions.CustomRole) error); ok {
+		r0 = rf(ctx, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateRole provides a mock function with given fields: ctx, email, newRole
 func (_m *AuthenticationProvider) UpdateRole(ctx context.Context, email string, newRole string) (sessions.User, error) {
 	ret := _m.Called(ctx, email, newRole)
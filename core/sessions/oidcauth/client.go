@@ -0,0 +1,360 @@
+package oidcauth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// maxResponseSize limits the size of the responses of the identity provider.
+	maxResponseSize = 1 << 20
+	// clockSkew is the tolerated difference between the clocks of the node and of the identity provider.
+	clockSkew = time.Minute
+	// jwksMinRefreshInterval rate limits the refreshes of the signing keys of the identity provider, which are
+	// triggered by ID tokens signed with unknown keys.
+	jwksMinRefreshInterval = time.Minute
+)
+
+// providerMetadata is the subset of the OpenID Provider Metadata used by the node.
+// See https://openid.net/specs/openid-connect-discovery-1_0.html#ProviderMetadata
+type providerMetadata struct {
+	Issuer                        string   `json:"issuer"`
+	AuthorizationEndpoint         string   `json:"authorization_endpoint"`
+	TokenEndpoint                 string   `json:"token_endpoint"`
+	JWKSURI                       string   `json:"jwks_uri"`
+	CodeChallengeMethodsSupported []string `json:"code_challenge_methods_supported"`
+}
+
+// idTokenClaims are the claims of an ID token used by the node.
+type idTokenClaims struct {
+	Subject string
+	Email   string
+	Groups  []string
+}
+
+// oidcClient implements the requests of the authorization code flow with PKCE to an OpenID Connect identity provider,
+// and the validation of the ID tokens it issues.
+type oidcClient struct {
+	httpClient   *http.Client
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	groupsClaim  string
+	metadata     providerMetadata
+	now          func() time.Time
+
+	keysMu      sync.Mutex
+	keys        map[string]crypto.PublicKey
+	keysFetched time.Time
+}
+
+// discover fetches the metadata of the identity provider identified by providerURL.
+func (o *oidcClient) discover(ctx context.Context, providerURL string) error {
+	issuer := strings.TrimSuffix(providerURL, "/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return err
+	}
+	var metadata providerMetadata
+	if err = o.doJSON(req, &metadata); err != nil {
+		return fmt.Errorf("failed to discover OpenID provider configuration: %w", err)
+	}
+	if strings.TrimSuffix(metadata.Issuer, "/") != issuer {
+		return fmt.Errorf("OpenID provider issuer %q does not match the configured ProviderURL %q", metadata.Issuer, providerURL)
+	}
+	if metadata.AuthorizationEndpoint == "" || metadata.TokenEndpoint == "" || metadata.JWKSURI == "" {
+		return errors.New("OpenID provider configuration is missing authorization_endpoint, token_endpoint or jwks_uri")
+	}
+	if len(metadata.CodeChallengeMethodsSupported) > 0 && !slices.Contains(metadata.CodeChallengeMethodsSupported, "S256") {
+		return errors.New("OpenID provider does not support the S256 PKCE code challenge method")
+	}
+	o.metadata = metadata
+	return nil
+}
+
+// authCodeURL returns the URL of the authorization endpoint which starts a login.
+func (o *oidcClient) authCodeURL(scopes []string, state, nonce, codeChallenge string) (string, error) {
+	u, err := url.Parse(o.metadata.AuthorizationEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid authorization_endpoint: %w", err)
+	}
+	scope := []string{"openid"}
+	for _, s := range scopes {
+		if s != "openid" {
+			scope = append(scope, s)
+		}
+	}
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", o.clientID)
+	q.Set("redirect_uri", o.redirectURL)
+	q.Set("scope", strings.Join(scope, " "))
+	q.Set("state", state)
+	q.Set("nonce", nonce)
+	q.Set("code_challenge", codeChallenge)
+	q.Set("code_challenge_method", "S256")
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// exchange exchanges an authorization code for the ID token of the user.
+func (o *oidcClient) exchange(ctx context.Context, code, codeVerifier string) (string, error) {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {o.redirectURL},
+		"client_id":     {o.clientID},
+		"code_verifier": {codeVerifier},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if o.clientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(o.clientID), url.QueryEscape(o.clientSecret))
+	}
+
+	var resp struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err = o.doJSON(req, &resp); err != nil {
+		if resp.Error != "" {
+			return "", fmt.Errorf("token request failed: %s: %s", resp.Error, resp.ErrorDescription)
+		}
+		return "", fmt.Errorf("token request failed: %w", err)
+	}
+	if resp.IDToken == "" {
+		return "", errors.New("token response has no id_token")
+	}
+	return resp.IDToken, nil
+}
+
+// verifyIDToken verifies the signature and the claims of an ID token issued for the login with nonce.
+// See https://openid.net/specs/openid-connect-core-1_0.html#IDTokenValidation
+func (o *oidcClient) verifyIDToken(ctx context.Context, rawIDToken, nonce string) (idTokenClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return o.signingKey(ctx, kid)
+	},
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithIssuer(o.metadata.Issuer),
+		jwt.WithAudience(o.clientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(clockSkew),
+		jwt.WithTimeFunc(o.now),
+	)
+	if err != nil {
+		return idTokenClaims{}, fmt.Errorf("invalid ID token: %w", err)
+	}
+
+	audience, err := claims.GetAudience()
+	if err != nil {
+		return idTokenClaims{}, err
+	}
+	if authorizedParty, _ := claims["azp"].(string); len(audience) > 1 && authorizedParty != o.clientID {
+		return idTokenClaims{}, fmt.Errorf("ID token authorized party %q is not client ID %q", authorizedParty, o.clientID)
+	}
+	if claimNonce, _ := claims["nonce"].(string); claimNonce != nonce {
+		return idTokenClaims{}, errors.New("ID token nonce does not match the login")
+	}
+	subject, err := claims.GetSubject()
+	if err != nil {
+		return idTokenClaims{}, err
+	}
+	if subject == "" {
+		return idTokenClaims{}, errors.New("ID token has no subject")
+	}
+	email, _ := claims["email"].(string)
+	if email == "" {
+		return idTokenClaims{}, errors.New("ID token has no email claim, check the requested Scopes")
+	}
+	if verified, ok := claims["email_verified"].(bool); (ok && !verified) || claims["email_verified"] == "false" {
+		return idTokenClaims{}, fmt.Errorf("email %s is not verified", email)
+	}
+
+	groups, err := stringOrStrings(claims[o.groupsClaim])
+	if err != nil {
+		return idTokenClaims{}, fmt.Errorf("malformed ID token claim %s: %w", o.groupsClaim, err)
+	}
+
+	return idTokenClaims{Subject: subject, Email: email, Groups: groups}, nil
+}
+
+// signingKey returns the key of the identity provider identified by kid, refreshing the keys if it is unknown.
+func (o *oidcClient) signingKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	o.keysMu.Lock()
+	defer o.keysMu.Unlock()
+
+	if key, ok := o.lookupKey(kid); ok {
+		return key, nil
+	}
+	if !o.keysFetched.IsZero() && o.now().Sub(o.keysFetched) < jwksMinRefreshInterval {
+		return nil, fmt.Errorf("ID token signed with unknown key %q", kid)
+	}
+	keys, err := o.fetchKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	o.keys = keys
+	o.keysFetched = o.now()
+	if key, ok := o.lookupKey(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("ID token signed with unknown key %q", kid)
+}
+
+// lookupKey returns the key identified by kid, or the only key if kid is empty. It must be called with keysMu held.
+func (o *oidcClient) lookupKey(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(o.keys) == 1 {
+		for _, key := range o.keys {
+			return key, true
+		}
+	}
+	key, ok := o.keys[kid]
+	return key, ok
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// fetchKeys fetches the signing keys of the identity provider. Unsupported keys are ignored.
+func (o *oidcClient) fetchKeys(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.metadata.JWKSURI, nil)
+	if err != nil {
+		return nil, err
+	}
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err = o.doJSON(req, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to fetch OpenID provider signing keys: %w", err)
+	}
+	keys := make(map[string]crypto.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		if key, err := jwk.publicKey(); err == nil {
+			keys[jwk.Kid] = key
+		}
+	}
+	return keys, nil
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", k.Kty)
+	}
+}
+
+// signingMethods are the algorithms accepted for the signatures of ID tokens. The keys of the identity provider are
+// restricted to RSA and EC keys by fetchKeys, so that a token cannot select an algorithm the key was not issued for.
+var signingMethods = []string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}
+
+// doJSON sends req and decodes the JSON response body into v. Error responses are decoded too, as the token
+// endpoint describes its errors in JSON.
+func (o *oidcClient) doJSON(req *http.Request, v interface{}) error {
+	req.Header.Set("Accept", "application/json")
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = json.Unmarshal(body, v)
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return json.Unmarshal(body, v)
+}
+
+// stringOrStrings decodes a claim which is either a string or an array of strings.
+func stringOrStrings(claim interface{}) ([]string, error) {
+	switch v := claim.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case []interface{}:
+		ss := make([]string, 0, len(v))
+		for _, e := range v {
+			s, ok := e.(string)
+			if !ok {
+				return nil, fmt.Errorf("unexpected %T in array of strings", e)
+			}
+			ss = append(ss, s)
+		}
+		return ss, nil
+	default:
+		return nil, fmt.Errorf("unexpected %T, expected a string or an array of strings", claim)
+	}
+}
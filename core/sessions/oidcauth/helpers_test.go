@@ -0,0 +1,69 @@
+package oidcauth
+
+import (
+	"time"
+)
+
+// Default identity provider group name mappings for test config and mocked ID tokens
+const (
+	NodeAdminsGroup   = "NodeAdmins"
+	NodeEditorsGroup  = "NodeEditors"
+	NodeRunnersGroup  = "NodeRunners"
+	NodeReadOnlyGroup = "NodeReadOnly"
+)
+
+// SetNow overrides the clock of the authenticator, so that the ID token and login expiries can be tested.
+func (o *oidcAuthenticator) SetNow(now func() time.Time) {
+	o.client.now = now
+}
+
+// Implements config.OIDC
+type TestConfig struct {
+	Provider string
+	Redirect string
+	Secret   string
+}
+
+func (t *TestConfig) ProviderURL() string {
+	return t.Provider
+}
+
+func (t *TestConfig) ClientID() string {
+	return "chainlink-node"
+}
+
+func (t *TestConfig) ClientSecret() string {
+	return t.Secret
+}
+
+func (t *TestConfig) RedirectURL() string {
+	return t.Redirect
+}
+
+func (t *TestConfig) Scopes() []string {
+	return []string{"email", "groups"}
+}
+
+func (t *TestConfig) GroupsClaim() string {
+	return "groups"
+}
+
+func (t *TestConfig) AdminUserGroup() string {
+	return NodeAdminsGroup
+}
+
+func (t *TestConfig) EditUserGroup() string {
+	return NodeEditorsGroup
+}
+
+func (t *TestConfig) RunUserGroup() string {
+	return NodeRunnersGroup
+}
+
+func (t *TestConfig) ReadUserGroup() string {
+	return NodeReadOnlyGroup
+}
+
+func (t *TestConfig) RequestTimeout() time.Duration {
+	return 5 * time.Second
+}
@@ -0,0 +1,296 @@
+/*
+The OIDC authentication package logs users in with the authorization code flow with PKCE of a configured
+OpenID Connect identity provider, as a single sign-on alternative to the local password authentication.
+
+A login is started by redirecting the user agent to the authorization endpoint of the identity provider, which
+redirects it back to the callback URL of the node with an authorization code. The code is exchanged for an ID token,
+whose signature, issuer, audience, expiry and nonce are verified before the session of the user is created.
+
+The role of the user is mapped from the groups listed in the configured groups claim of its ID token. When the user
+is a member of the groups of several roles, it is granted the highest one.
+
+This package relies on the local database tables of the local authentication provider:
+
+	users: OIDC users are created upon their first login, keyed by their subject identifier in oidc_subject. Their
+	role is updated on every login. They have no usable password.
+	sessions: Upon successful login, the web session of the user is created like for local users.
+
+Local users, such as the admin user created by the CLI, can still log in with their password. An OIDC user can not
+log in with the email of a local user.
+
+Changes to the groups of a user in the identity provider take effect on its next login, or when its session expires.
+*/
+package oidcauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/localauth"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const (
+	// loginTimeout is the time a user has to complete a login with the identity provider.
+	loginTimeout = 10 * time.Minute
+	// maxPendingLogins limits the memory used by the logins which were started, but not completed.
+	maxPendingLogins = 10_000
+)
+
+var (
+	ErrUnknownLoginState = errors.New("unknown or expired login state, please login again")
+	ErrUserNoOIDCGroups  = errors.New("user authenticated by the identity provider, but matching no role groups assigned")
+	ErrLocalUserConflict = errors.New("a local user with the same email already exists")
+	ErrTooManyLogins     = errors.New("too many pending logins, please try again later")
+)
+
+type pendingLogin struct {
+	codeVerifier string
+	nonce        string
+	expiresAt    time.Time
+}
+
+type oidcAuthenticator struct {
+	// AuthenticationProvider of the local users, which stores the sessions of the OIDC users too.
+	sessions.AuthenticationProvider
+
+	ds          sqlutil.DataSource
+	client      *oidcClient
+	config      config.OIDC
+	lggr        logger.Logger
+	auditLogger audit.AuditLogger
+
+	mu     sync.Mutex
+	logins map[string]pendingLogin
+}
+
+// oidcAuthenticator implements sessions.AuthenticationProvider and sessions.SingleSignOnProvider interfaces
+var _ sessions.AuthenticationProvider = (*oidcAuthenticator)(nil)
+var _ sessions.SingleSignOnProvider = (*oidcAuthenticator)(nil)
+
+func NewOIDCAuthenticator(
+	ds sqlutil.DataSource,
+	oidcCfg config.OIDC,
+	sessionDuration time.Duration,
+	dev bool,
+	lggr logger.Logger,
+	auditLogger audit.AuditLogger,
+) (*oidcAuthenticator, error) {
+	if oidcCfg.ProviderURL() == "" || oidcCfg.ClientID() == "" || oidcCfg.RedirectURL() == "" {
+		return nil, errors.New("OIDC ProviderURL, ClientID and RedirectURL config required")
+	}
+	// Ensure all RBAC role mappings to OIDC groups are defined, or error on startup
+	if oidcCfg.GroupsClaim() == "" || oidcCfg.AdminUserGroup() == "" || oidcCfg.EditUserGroup() == "" ||
+		oidcCfg.RunUserGroup() == "" || oidcCfg.ReadUserGroup() == "" {
+		return nil, errors.New("OIDC group mapping for all local RBAC roles required. Set GroupsClaim and the `_UserGroup` fields")
+	}
+	// If not chainlink dev and not https, error
+	if !dev && (!strings.HasPrefix(oidcCfg.ProviderURL(), "https://") || !strings.HasPrefix(oidcCfg.RedirectURL(), "https://")) {
+		return nil, errors.New("OIDC Authentication driver requires https ProviderURL and RedirectURL when running in Production mode")
+	}
+
+	lggr = lggr.Named("OIDCAuthenticationProvider")
+	o := &oidcAuthenticator{
+		AuthenticationProvider: localauth.NewORM(ds, sessionDuration, lggr, auditLogger),
+		ds:                     ds,
+		client: &oidcClient{
+			httpClient:   &http.Client{Timeout: oidcCfg.RequestTimeout()},
+			clientID:     oidcCfg.ClientID(),
+			clientSecret: oidcCfg.ClientSecret(),
+			redirectURL:  oidcCfg.RedirectURL(),
+			groupsClaim:  oidcCfg.GroupsClaim(),
+			now:          time.Now,
+		},
+		config:      oidcCfg,
+		lggr:        lggr,
+		auditLogger: auditLogger,
+		logins:      make(map[string]pendingLogin),
+	}
+
+	lggr.Infof("Discovering configuration of OIDC provider %s", oidcCfg.ProviderURL())
+	ctx, cancel := context.WithTimeout(context.Background(), oidcCfg.RequestTimeout())
+	defer cancel()
+	if err := o.client.discover(ctx, oidcCfg.ProviderURL()); err != nil {
+		return nil, fmt.Errorf("unable to discover OIDC provider: %w", err)
+	}
+	return o, nil
+}
+
+// BeginLogin starts a login with the identity provider.
+func (o *oidcAuthenticator) BeginLogin(ctx context.Context) (string, string, error) {
+	state, err := randomToken()
+	if err != nil {
+		return "", "", err
+	}
+	nonce, err := randomToken()
+	if err != nil {
+		return "", "", err
+	}
+	codeVerifier, err := randomToken()
+	if err != nil {
+		return "", "", err
+	}
+	challenge := sha256.Sum256([]byte(codeVerifier))
+
+	redirectURL, err := o.client.authCodeURL(o.config.Scopes(), state, nonce, base64.RawURLEncoding.EncodeToString(challenge[:]))
+	if err != nil {
+		return "", "", err
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	now := o.client.now()
+	if len(o.logins) >= maxPendingLogins {
+		for s, login := range o.logins {
+			if now.After(login.expiresAt) {
+				delete(o.logins, s)
+			}
+		}
+		if len(o.logins) >= maxPendingLogins {
+			return "", "", ErrTooManyLogins
+		}
+	}
+	o.logins[state] = pendingLogin{codeVerifier: codeVerifier, nonce: nonce, expiresAt: now.Add(loginTimeout)}
+	return redirectURL, state, nil
+}
+
+// FinishLogin completes the login started with state, creating or updating the user, and creating its session.
+func (o *oidcAuthenticator) FinishLogin(ctx context.Context, state string, code string) (string, error) {
+	o.mu.Lock()
+	login, ok := o.logins[state]
+	// A state can only be used once
+	delete(o.logins, state)
+	o.mu.Unlock()
+	if !ok || o.client.now().After(login.expiresAt) {
+		return "", ErrUnknownLoginState
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, o.config.RequestTimeout())
+	defer cancel()
+	rawIDToken, err := o.client.exchange(ctx, code, login.codeVerifier)
+	if err != nil {
+		o.lggr.Errorw("Failed to exchange OIDC authorization code", "err", err)
+		return "", errors.New("unable to complete login with the identity provider")
+	}
+	claims, err := o.client.verifyIDToken(ctx, rawIDToken, login.nonce)
+	if err != nil {
+		o.lggr.Errorw("Invalid OIDC ID token", "err", err)
+		return "", errors.New("unable to verify the identity of the user")
+	}
+	lggr := o.lggr.With("user", claims.Email, "subject", claims.Subject)
+
+	role, err := o.groupsToRole(claims.Groups)
+	if err != nil {
+		o.auditLogger.Audit(audit.AuthLoginFailedOIDC, map[string]interface{}{"email": claims.Email, "error": err})
+		return "", err
+	}
+
+	user, err := o.upsertUser(ctx, claims, role)
+	if err != nil {
+		if errors.Is(err, ErrLocalUserConflict) {
+			o.auditLogger.Audit(audit.AuthLoginFailedOIDC, map[string]interface{}{"email": claims.Email, "error": err})
+			return "", err
+		}
+		lggr.Errorw("Failed to save OIDC user", "err", err)
+		return "", errors.New("error saving user")
+	}
+
+	session := sessions.NewSession()
+	if _, err = o.ds.ExecContext(ctx, "INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now(), now())", session.ID, user.Email); err != nil {
+		lggr.Errorw("Failed to create session", "err", err)
+		return "", errors.New("error creating session")
+	}
+	lggr.Infow("OIDC user logged in", "role", user.Role)
+	o.auditLogger.Audit(audit.AuthLoginSuccessNo2FA, map[string]interface{}{"email": user.Email})
+	return session.ID, nil
+}
+
+// upsertUser creates the user identified by the claims of its ID token, or updates its role. The users which were
+// not created by the OIDC authentication provider are not updated.
+func (o *oidcAuthenticator) upsertUser(ctx context.Context, claims idTokenClaims, role sessions.UserRole) (sessions.User, error) {
+	email := strings.ToLower(claims.Email)
+	if err := sessions.ValidateEmail(email); err != nil {
+		return sessions.User{}, fmt.Errorf("invalid email: %w", err)
+	}
+
+	var user sessions.User
+	err := sqlutil.TransactDataSource(ctx, o.ds, nil, func(tx sqlutil.DataSource) error {
+		// The email of a user may change in the identity provider
+		var previousEmail string
+		err := tx.GetContext(ctx, &previousEmail, "SELECT email FROM users WHERE oidc_subject = $1 FOR UPDATE", claims.Subject)
+		if err == nil && previousEmail != email {
+			if _, err = tx.ExecContext(ctx, "DELETE FROM users WHERE email = $1", previousEmail); err != nil {
+				return err
+			}
+		} else if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		// OIDC users can not log in with a password
+		hashedPassword, err := utils.HashPassword(utils.NewSecret(utils.DefaultSecretSize))
+		if err != nil {
+			return err
+		}
+		err = tx.GetContext(ctx, &user, `INSERT INTO users (email, hashed_password, role, oidc_subject, created_at, updated_at)
+VALUES ($1, $2, $3, $4, now(), now())
+ON CONFLICT (email) DO UPDATE SET role = EXCLUDED.role, updated_at = now()
+WHERE users.oidc_subject = EXCLUDED.oidc_subject
+RETURNING *`, email, hashedPassword, role, claims.Subject)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrLocalUserConflict
+		}
+		return err
+	})
+	return user, err
+}
+
+// groupsToRole returns the highest role granted by groups.
+func (o *oidcAuthenticator) groupsToRole(groups []string) (sessions.UserRole, error) {
+	var admin, edit, run, read bool
+	for _, group := range groups {
+		switch group {
+		case o.config.AdminUserGroup():
+			admin = true
+		case o.config.EditUserGroup():
+			edit = true
+		case o.config.RunUserGroup():
+			run = true
+		case o.config.ReadUserGroup():
+			read = true
+		}
+	}
+	switch {
+	case admin:
+		return sessions.UserRoleAdmin, nil
+	case edit:
+		return sessions.UserRoleEdit, nil
+	case run:
+		return sessions.UserRoleRun, nil
+	case read:
+		return sessions.UserRoleView, nil
+	default:
+		return "", ErrUserNoOIDCGroups
+	}
+}
+
+func randomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
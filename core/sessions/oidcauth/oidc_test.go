@@ -0,0 +1,486 @@
+package oidcauth_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/oidcauth"
+)
+
+const (
+	testClientID    = "chainlink-node"
+	testRedirectURL = "http://localhost:6688/oidc/callback"
+)
+
+type authorizationCode struct {
+	challenge   string
+	redirectURI string
+	claims      map[string]interface{}
+}
+
+// mockProvider is a minimal OpenID Connect identity provider, which issues RS256 signed ID tokens.
+type mockProvider struct {
+	*httptest.Server
+	t *testing.T
+
+	mu           sync.Mutex
+	issuer       string
+	clientSecret string
+	kid          string
+	key          *rsa.PrivateKey
+	// signingKey signs the ID tokens instead of key when set
+	signingKey *rsa.PrivateKey
+	// hmacSecret signs the ID tokens with HS256 instead of key when set
+	hmacSecret []byte
+	codes      map[string]authorizationCode
+}
+
+func newMockProvider(t *testing.T) *mockProvider {
+	p := &mockProvider{t: t, kid: "key-1", key: newRSAKey(t), codes: make(map[string]authorizationCode)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.handleDiscovery)
+	mux.HandleFunc("/jwks", p.handleJWKS)
+	mux.HandleFunc("/token", p.handleToken)
+	p.Server = httptest.NewServer(mux)
+	t.Cleanup(p.Close)
+	p.issuer = p.URL
+	return p
+}
+
+func newRSAKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return key
+}
+
+func (p *mockProvider) handleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                           p.issuer,
+		"authorization_endpoint":           p.URL + "/authorize",
+		"token_endpoint":                   p.URL + "/token",
+		"jwks_uri":                         p.URL + "/jwks",
+		"code_challenge_methods_supported": []string{"plain", "S256"},
+	})
+}
+
+func (p *mockProvider) handleJWKS(w http.ResponseWriter, _ *http.Request) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"use": "sig",
+			"kid": p.kid,
+			"n":   base64.RawURLEncoding.EncodeToString(p.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.E)).Bytes()),
+		}},
+	})
+}
+
+func (p *mockProvider) handleToken(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if r.Method != http.MethodPost || r.ParseForm() != nil || r.PostForm.Get("grant_type") != "authorization_code" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+	if p.clientSecret != "" {
+		id, secret, ok := r.BasicAuth()
+		if !ok || id != testClientID || secret != p.clientSecret {
+			writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+			return
+		}
+	}
+	code, ok := p.codes[r.PostForm.Get("code")]
+	delete(p.codes, r.PostForm.Get("code"))
+	verifier := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if !ok || code.challenge != base64.RawURLEncoding.EncodeToString(verifier[:]) || code.redirectURI != r.PostForm.Get("redirect_uri") {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": "access-token",
+		"token_type":   "Bearer",
+		"id_token":     p.signIDToken(code.claims),
+	})
+}
+
+func (p *mockProvider) signIDToken(claims map[string]interface{}) string {
+	var token *jwt.Token
+	var key interface{}
+	switch {
+	case p.hmacSecret != nil:
+		token, key = jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims(claims)), p.hmacSecret
+	case p.signingKey != nil:
+		token, key = jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims(claims)), p.signingKey
+	default:
+		token, key = jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims(claims)), p.key
+	}
+	token.Header["kid"] = p.kid
+	signed, err := token.SignedString(key)
+	require.NoError(p.t, err)
+	return signed
+}
+
+// authorize simulates the login of a user at the authorization endpoint the node redirected to, and returns the state
+// and the authorization code passed back to the callback of the node. The claims override the default claims of the
+// ID token of the user, and are removed when nil.
+func (p *mockProvider) authorize(redirectURL string, email string, claims map[string]interface{}) (state string, code string) {
+	u, err := url.Parse(redirectURL)
+	require.NoError(p.t, err)
+	require.Equal(p.t, p.URL+"/authorize", u.Scheme+"://"+u.Host+u.Path)
+	q := u.Query()
+	require.Equal(p.t, "code", q.Get("response_type"))
+	require.Equal(p.t, testClientID, q.Get("client_id"))
+	require.Equal(p.t, "openid email groups", q.Get("scope"))
+	require.Equal(p.t, "S256", q.Get("code_challenge_method"))
+
+	now := time.Now()
+	idClaims := map[string]interface{}{
+		"iss":            p.issuer,
+		"sub":            "subject-" + email,
+		"aud":            testClientID,
+		"exp":            now.Add(5 * time.Minute).Unix(),
+		"iat":            now.Unix(),
+		"nonce":          q.Get("nonce"),
+		"email":          email,
+		"email_verified": true,
+	}
+	for k, v := range claims {
+		if v == nil {
+			delete(idClaims, k)
+		} else {
+			idClaims[k] = v
+		}
+	}
+
+	code = fmt.Sprintf("code-%d", cltest.NewRandomPositiveInt64())
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.codes[code] = authorizationCode{challenge: q.Get("code_challenge"), redirectURI: q.Get("redirect_uri"), claims: idClaims}
+	return q.Get("state"), code
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+type ssoAuthenticator interface {
+	sessions.AuthenticationProvider
+	sessions.SingleSignOnProvider
+	SetNow(now func() time.Time)
+}
+
+// Setup OIDC Auth authenticator
+func setupAuthenticationProvider(t *testing.T, p *mockProvider) ssoAuthenticator {
+	t.Helper()
+
+	cfg := oidcauth.TestConfig{Provider: p.URL, Redirect: testRedirectURL, Secret: p.clientSecret}
+	db := pgtest.NewSqlxDB(t)
+	oidcAuthProvider, err := oidcauth.NewOIDCAuthenticator(db, &cfg, time.Hour, true, logger.TestLogger(t), &audit.AuditLoggerService{})
+	require.NoError(t, err)
+	return oidcAuthProvider
+}
+
+func login(t *testing.T, p *mockProvider, a ssoAuthenticator, email string, claims map[string]interface{}) (string, error) {
+	t.Helper()
+	ctx := testutils.Context(t)
+
+	redirectURL, state, err := a.BeginLogin(ctx)
+	require.NoError(t, err)
+	returnedState, code := p.authorize(redirectURL, email, claims)
+	require.Equal(t, state, returnedState)
+	return a.FinishLogin(ctx, state, code)
+}
+
+func TestOIDCAuthenticator_Login(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	p := newMockProvider(t)
+	oidcAuthProvider := setupAuthenticationProvider(t, p)
+	email := cltest.MustRandomUser(t).Email
+
+	sessionID, err := login(t, p, oidcAuthProvider, email, map[string]interface{}{"groups": []string{"Unrelated", oidcauth.NodeRunnersGroup}})
+	require.NoError(t, err)
+	user, err := oidcAuthProvider.AuthorizedUserWithSession(ctx, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, email, user.Email)
+	assert.Equal(t, sessions.UserRoleRun, user.Role)
+	assert.Equal(t, "subject-"+email, user.OIDCSubject.String)
+
+	// OIDC users have no usable password
+	_, err = oidcAuthProvider.CreateSession(ctx, sessions.SessionRequest{Email: email, Password: ""})
+	require.Error(t, err)
+
+	// The role is updated on every login, and is the highest one granted by the groups
+	sessionID2, err := login(t, p, oidcAuthProvider, email, map[string]interface{}{"groups": []string{oidcauth.NodeReadOnlyGroup, oidcauth.NodeAdminsGroup, oidcauth.NodeEditorsGroup}})
+	require.NoError(t, err)
+	assert.NotEqual(t, sessionID, sessionID2)
+	user, err = oidcAuthProvider.AuthorizedUserWithSession(ctx, sessionID2)
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleAdmin, user.Role)
+
+	// A single group is accepted as a string
+	_, err = login(t, p, oidcAuthProvider, email, map[string]interface{}{"groups": oidcauth.NodeReadOnlyGroup})
+	require.NoError(t, err)
+	user, err = oidcAuthProvider.FindUser(ctx, email)
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleView, user.Role)
+
+	// The email of the user changed in the identity provider
+	newEmail := cltest.MustRandomUser(t).Email
+	_, err = login(t, p, oidcAuthProvider, newEmail, map[string]interface{}{"sub": "subject-" + email, "groups": []string{oidcauth.NodeEditorsGroup}})
+	require.NoError(t, err)
+	user, err = oidcAuthProvider.FindUser(ctx, newEmail)
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleEdit, user.Role)
+	_, err = oidcAuthProvider.FindUser(ctx, email)
+	require.Error(t, err)
+}
+
+func TestOIDCAuthenticator_Login_NoGroups(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	p := newMockProvider(t)
+	oidcAuthProvider := setupAuthenticationProvider(t, p)
+	email := cltest.MustRandomUser(t).Email
+
+	_, err := login(t, p, oidcAuthProvider, email, map[string]interface{}{"groups": []string{"Unrelated"}})
+	require.ErrorIs(t, err, oidcauth.ErrUserNoOIDCGroups)
+	_, err = login(t, p, oidcAuthProvider, email, nil)
+	require.ErrorIs(t, err, oidcauth.ErrUserNoOIDCGroups)
+
+	_, err = oidcAuthProvider.FindUser(ctx, email)
+	require.Error(t, err)
+}
+
+func TestOIDCAuthenticator_Login_LocalUserConflict(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	p := newMockProvider(t)
+	oidcAuthProvider := setupAuthenticationProvider(t, p)
+	localUser := cltest.MustRandomUser(t)
+	localUser.Role = sessions.UserRoleView
+	require.NoError(t, oidcAuthProvider.CreateUser(ctx, &localUser))
+
+	_, err := login(t, p, oidcAuthProvider, strings.ToUpper(localUser.Email), map[string]interface{}{"groups": []string{oidcauth.NodeAdminsGroup}})
+	require.ErrorIs(t, err, oidcauth.ErrLocalUserConflict)
+
+	user, err := oidcAuthProvider.FindUser(ctx, localUser.Email)
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleView, user.Role)
+	assert.False(t, user.OIDCSubject.Valid)
+
+	// Local users can still log in with their password
+	_, err = oidcAuthProvider.CreateSession(ctx, sessions.SessionRequest{Email: localUser.Email, Password: cltest.Password})
+	require.NoError(t, err)
+}
+
+func TestOIDCAuthenticator_Login_State(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	p := newMockProvider(t)
+	oidcAuthProvider := setupAuthenticationProvider(t, p)
+	email := cltest.MustRandomUser(t).Email
+	groups := map[string]interface{}{"groups": []string{oidcauth.NodeAdminsGroup}}
+
+	t.Run("unknown state", func(t *testing.T) {
+		_, err := oidcAuthProvider.FinishLogin(ctx, "unknown", "code")
+		require.ErrorIs(t, err, oidcauth.ErrUnknownLoginState)
+	})
+
+	t.Run("state used twice", func(t *testing.T) {
+		redirectURL, state, err := oidcAuthProvider.BeginLogin(ctx)
+		require.NoError(t, err)
+		_, code := p.authorize(redirectURL, email, groups)
+		_, err = oidcAuthProvider.FinishLogin(ctx, state, code)
+		require.NoError(t, err)
+		_, err = oidcAuthProvider.FinishLogin(ctx, state, code)
+		require.ErrorIs(t, err, oidcauth.ErrUnknownLoginState)
+	})
+
+	t.Run("expired state", func(t *testing.T) {
+		redirectURL, state, err := oidcAuthProvider.BeginLogin(ctx)
+		require.NoError(t, err)
+		_, code := p.authorize(redirectURL, email, groups)
+		oidcAuthProvider.SetNow(func() time.Time { return time.Now().Add(11 * time.Minute) })
+		defer oidcAuthProvider.SetNow(time.Now)
+		_, err = oidcAuthProvider.FinishLogin(ctx, state, code)
+		require.ErrorIs(t, err, oidcauth.ErrUnknownLoginState)
+	})
+
+	t.Run("code of another login", func(t *testing.T) {
+		redirectURL1, _, err := oidcAuthProvider.BeginLogin(ctx)
+		require.NoError(t, err)
+		_, state2, err := oidcAuthProvider.BeginLogin(ctx)
+		require.NoError(t, err)
+		_, code1 := p.authorize(redirectURL1, email, groups)
+
+		// The PKCE code verifier of the second login does not match the code challenge of the first one
+		_, err = oidcAuthProvider.FinishLogin(ctx, state2, code1)
+		require.ErrorContains(t, err, "unable to complete login with the identity provider")
+	})
+}
+
+func TestOIDCAuthenticator_Login_InvalidIDToken(t *testing.T) {
+	t.Parallel()
+
+	p := newMockProvider(t)
+	oidcAuthProvider := setupAuthenticationProvider(t, p)
+	email := cltest.MustRandomUser(t).Email
+
+	for _, tt := range []struct {
+		name   string
+		claims map[string]interface{}
+	}{
+		{"wrong nonce", map[string]interface{}{"nonce": "replayed"}},
+		{"wrong audience", map[string]interface{}{"aud": "another-client"}},
+		{"untrusted authorized party", map[string]interface{}{"aud": []string{"another-client", testClientID}, "azp": "another-client"}},
+		{"wrong issuer", map[string]interface{}{"iss": "https://attacker.example.com"}},
+		{"expired", map[string]interface{}{"exp": time.Now().Add(-2 * time.Minute).Unix()}},
+		{"issued in the future", map[string]interface{}{"iat": time.Now().Add(2 * time.Minute).Unix()}},
+		{"no subject", map[string]interface{}{"sub": nil}},
+		{"no email", map[string]interface{}{"email": nil}},
+		{"unverified email", map[string]interface{}{"email_verified": false}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.claims["groups"] = []string{oidcauth.NodeAdminsGroup}
+			_, err := login(t, p, oidcAuthProvider, email, tt.claims)
+			require.ErrorContains(t, err, "unable to verify the identity of the user")
+		})
+	}
+
+	t.Run("invalid signature", func(t *testing.T) {
+		p.mu.Lock()
+		p.signingKey = newRSAKey(t)
+		p.mu.Unlock()
+		defer func() {
+			p.mu.Lock()
+			p.signingKey = nil
+			p.mu.Unlock()
+		}()
+		_, err := login(t, p, oidcAuthProvider, email, map[string]interface{}{"groups": []string{oidcauth.NodeAdminsGroup}})
+		require.ErrorContains(t, err, "unable to verify the identity of the user")
+	})
+
+	t.Run("signed with the public key as HMAC secret", func(t *testing.T) {
+		p.mu.Lock()
+		p.hmacSecret = p.key.N.Bytes()
+		p.mu.Unlock()
+		defer func() {
+			p.mu.Lock()
+			p.hmacSecret = nil
+			p.mu.Unlock()
+		}()
+		_, err := login(t, p, oidcAuthProvider, email, map[string]interface{}{"groups": []string{oidcauth.NodeAdminsGroup}})
+		require.ErrorContains(t, err, "unable to verify the identity of the user")
+	})
+}
+
+func TestOIDCAuthenticator_Login_KeyRotation(t *testing.T) {
+	t.Parallel()
+
+	p := newMockProvider(t)
+	oidcAuthProvider := setupAuthenticationProvider(t, p)
+	email := cltest.MustRandomUser(t).Email
+	groups := map[string]interface{}{"groups": []string{oidcauth.NodeAdminsGroup}}
+
+	_, err := login(t, p, oidcAuthProvider, email, groups)
+	require.NoError(t, err)
+
+	p.mu.Lock()
+	p.kid, p.key = "key-2", newRSAKey(t)
+	p.mu.Unlock()
+
+	// The keys were fetched too recently to be refreshed
+	_, err = login(t, p, oidcAuthProvider, email, groups)
+	require.ErrorContains(t, err, "unable to verify the identity of the user")
+
+	// The rotated key is fetched by the next login with an ID token signed with an unknown key
+	oidcAuthProvider.SetNow(func() time.Time { return time.Now().Add(2 * time.Minute) })
+	_, err = login(t, p, oidcAuthProvider, email, groups)
+	require.NoError(t, err)
+}
+
+func TestOIDCAuthenticator_ClientSecret(t *testing.T) {
+	t.Parallel()
+
+	p := newMockProvider(t)
+	p.clientSecret = "s3cr3t"
+	oidcAuthProvider := setupAuthenticationProvider(t, p)
+	email := cltest.MustRandomUser(t).Email
+
+	_, err := login(t, p, oidcAuthProvider, email, map[string]interface{}{"groups": []string{oidcauth.NodeAdminsGroup}})
+	require.NoError(t, err)
+
+	p.mu.Lock()
+	p.clientSecret = "rotated"
+	p.mu.Unlock()
+	_, err = login(t, p, oidcAuthProvider, email, map[string]interface{}{"groups": []string{oidcauth.NodeAdminsGroup}})
+	require.ErrorContains(t, err, "unable to complete login with the identity provider")
+}
+
+func TestNewOIDCAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	newAuthenticator := func(cfg *oidcauth.TestConfig, dev bool) error {
+		_, err := oidcauth.NewOIDCAuthenticator(db, cfg, time.Hour, dev, logger.TestLogger(t), &audit.AuditLoggerService{})
+		return err
+	}
+
+	t.Run("discovers the provider", func(t *testing.T) {
+		p := newMockProvider(t)
+		require.NoError(t, newAuthenticator(&oidcauth.TestConfig{Provider: p.URL + "/", Redirect: testRedirectURL}, true))
+	})
+
+	t.Run("requires https in production", func(t *testing.T) {
+		p := newMockProvider(t)
+		err := newAuthenticator(&oidcauth.TestConfig{Provider: p.URL, Redirect: testRedirectURL}, false)
+		require.ErrorContains(t, err, "requires https")
+	})
+
+	t.Run("requires the configured issuer", func(t *testing.T) {
+		p := newMockProvider(t)
+		p.issuer = "https://another-issuer.example.com"
+		err := newAuthenticator(&oidcauth.TestConfig{Provider: p.URL, Redirect: testRedirectURL}, true)
+		require.ErrorContains(t, err, "does not match the configured ProviderURL")
+	})
+
+	t.Run("unreachable provider", func(t *testing.T) {
+		p := newMockProvider(t)
+		p.Close()
+		err := newAuthenticator(&oidcauth.TestConfig{Provider: p.URL, Redirect: testRedirectURL}, true)
+		require.ErrorContains(t, err, "unable to discover OIDC provider")
+	})
+}
@@ -21,6 +21,14 @@ type User struct {
 	TokenSalt         null.String
 	TokenHashedSecret null.String
 	UpdatedAt         time.Time
+	// CustomRole is the name of the custom role of the user, and TokenCustomRole the one of its API token.
+	CustomRole      null.String
+	TokenCustomRole null.String
+	// Permissions are the permissions of the custom role the user is authenticated with: the one of its API token
+	// when it has one and the user is authenticated by token, otherwise the one of the user.
+	Permissions Permissions
+	// OIDCSubject is the subject identifier of the users created by the OIDC authentication provider.
+	OIDCSubject null.String
 }
 
 type UserRole string
@@ -0,0 +1,6 @@
+-- +goose Up
+ALTER TABLE evm.txes ADD COLUMN priority smallint NOT NULL DEFAULT 0;
+CREATE INDEX idx_txes_unstarted_queue_heads ON evm.txes (evm_chain_id, from_address, priority, subject, value, created_at, id) WHERE state = 'unstarted'::eth_txes_state;
+-- +goose Down
+DROP INDEX IF EXISTS evm.idx_txes_unstarted_queue_heads;
+ALTER TABLE evm.txes DROP COLUMN priority;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE workflow_steps
+	ADD COLUMN attempts integer NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_workflow_executions_status_finished_at ON workflow_executions (status, finished_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_workflow_executions_status_finished_at;
+
+ALTER TABLE workflow_steps
+	DROP COLUMN attempts;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE custom_roles (
+	name text PRIMARY KEY,
+	permissions text[] NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	CONSTRAINT chk_name_not_empty CHECK (name <> '')
+);
+
+ALTER TABLE users
+	ADD COLUMN custom_role text REFERENCES custom_roles (name) ON DELETE SET NULL,
+	ADD COLUMN token_custom_role text REFERENCES custom_roles (name) ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users
+	DROP COLUMN custom_role,
+	DROP COLUMN token_custom_role;
+
+DROP TABLE custom_roles;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE feeds_managers ADD COLUMN auto_approval_policy text NOT NULL DEFAULT 'none'
+    CHECK (auto_approval_policy IN ('none', 'observation_source'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE feeds_managers DROP COLUMN auto_approval_policy;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE gateway_user_quotas(
+    don_id text NOT NULL,
+    sender text NOT NULL,
+    day date NOT NULL,
+    requests bigint NOT NULL CHECK (requests >= 0),
+    bytes bigint NOT NULL CHECK (bytes >= 0),
+    updated_at timestamp with time zone NOT NULL,
+    PRIMARY KEY(don_id, sender, day)
+);
+
+CREATE INDEX idx_gateway_user_quotas_day ON gateway_user_quotas (day);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS gateway_user_quotas;
+-- +goose StatementEnd
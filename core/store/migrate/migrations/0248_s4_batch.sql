@@ -0,0 +1,7 @@
+-- +goose Up
+
+ALTER TABLE "s4".shared ADD COLUMN batch BYTEA;
+
+-- +goose Down
+
+ALTER TABLE "s4".shared DROP COLUMN batch;
@@ -253,3 +253,27 @@ func RequiresAdminRole(handler func(*gin.Context)) func(*gin.Context) {
 		handler(c)
 	}
 }
+
+// RequiresPermission extracts the user object from the context, and asserts the user is granted permission, either by
+// its role or by its custom role. Users without it are rejected like the users without the minimum role granting it.
+func RequiresPermission(permission clsessions.Permission, handler func(*gin.Context)) func(*gin.Context) {
+	return func(c *gin.Context) {
+		user, ok := GetAuthenticatedUser(c)
+		if !ok {
+			c.Abort()
+			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
+			return
+		}
+		if !user.HasPermission(permission) {
+			c.Abort()
+			if permission.MinimumRole() == clsessions.UserRoleAdmin {
+				addForbiddenErrorHeaders(c, "admin", string(user.Role), user.Email)
+				jsonAPIError(c, http.StatusForbidden, errors.New("Forbidden"))
+				return
+			}
+			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
+			return
+		}
+		handler(c)
+	}
+}
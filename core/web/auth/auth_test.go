@@ -13,6 +13,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
@@ -222,6 +223,11 @@ var routesRolesMap = [...]routeRules{
 	{"POST", "/v2/users", false, false, false},
 	{"PATCH", "/v2/users", false, false, false},
 	{"DELETE", "/v2/users/MOCK", false, false, false},
+	{"PATCH", "/v2/users/custom_role", false, false, false},
+	{"GET", "/v2/custom_roles", false, false, false},
+	{"POST", "/v2/custom_roles", false, false, false},
+	{"PATCH", "/v2/custom_roles/MOCK", false, false, false},
+	{"DELETE", "/v2/custom_roles/MOCK", false, false, false},
 	{"PATCH", "/v2/user/password", true, true, true},
 	{"POST", "/v2/user/token", true, true, true},
 	{"POST", "/v2/user/token/delete", true, true, true},
@@ -292,6 +298,8 @@ var routesRolesMap = [...]routeRules{
 	{"DELETE", "/v2/keys/vrf/MOCK", false, false, false},
 	{"POST", "/v2/keys/vrf/import", false, false, false},
 	{"POST", "/v2/keys/vrf/export/MOCK", false, false, false},
+	{"POST", "/v2/keys/backup", false, false, false},
+	{"POST", "/v2/keys/restore", false, false, false},
 	{"GET", "/v2/jobs", true, true, true},
 	{"GET", "/v2/jobs/MOCK", true, true, true},
 	{"POST", "/v2/jobs", false, false, true},
@@ -507,6 +515,71 @@ func TestRBAC_Routemap_ViewOnly(t *testing.T) {
 	}
 }
 
+func TestRBAC_CustomRole(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	router := web.Router(t, app, nil)
+	ts := httptest.NewServer(router)
+	defer ts.Close()
+
+	provider := app.AuthenticationProvider()
+	role, err := sessions.NewCustomRole("key_operator", []string{"keys:create", "keys:manage"})
+	require.NoError(t, err)
+	require.NoError(t, provider.CreateCustomRole(ctx, &role))
+
+	u := &cltest.User{Role: sessions.UserRoleView}
+	client := app.NewHTTPClient(u)
+	_, err = provider.SetCustomRole(ctx, u.Email, null.StringFrom(role.Name))
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		verb       string
+		path       string
+		wantStatus int
+	}{
+		// granted by the custom role
+		{"POST", "/v2/keys/csa", 0},
+		{"POST", "/v2/keys/eth/import", 0},
+		{"DELETE", "/v2/keys/p2p/MOCK", 0},
+		// not granted by the custom role
+		{"POST", "/v2/jobs", http.StatusUnauthorized},
+		{"POST", "/v2/bridge_types", http.StatusUnauthorized},
+		{"POST", "/v2/transfers", http.StatusForbidden},
+		{"GET", "/v2/custom_roles", http.StatusForbidden},
+	} {
+		t.Run(tt.verb+" "+tt.path, func(t *testing.T) {
+			var resp *http.Response
+			var cleanup func()
+			switch tt.verb {
+			case "POST":
+				resp, cleanup = client.Post(tt.path, nil)
+			case "DELETE":
+				resp, cleanup = client.Delete(tt.path)
+			case "GET":
+				resp, cleanup = client.Get(tt.path)
+			default:
+				t.Fatalf("Unknown HTTP verb %s\n", tt.verb)
+			}
+			defer cleanup()
+
+			if tt.wantStatus == 0 {
+				assert.NotEqual(t, http.StatusUnauthorized, resp.StatusCode)
+				assert.NotEqual(t, http.StatusForbidden, resp.StatusCode)
+			} else {
+				assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			}
+		})
+	}
+
+	// the permissions of the custom role are loaded on every request
+	require.NoError(t, provider.DeleteCustomRole(ctx, role.Name))
+	resp, cleanup := client.Post("/v2/keys/csa", nil)
+	defer cleanup()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
 func mustRequest(t *testing.T, method, url string, body io.Reader) *http.Request {
 	ctx := testutils.Context(t)
 	req, err := http.NewRequestWithContext(ctx, method, url, body)
@@ -0,0 +1,135 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgconn"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	clsession "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// CustomRolesController manages the custom roles which grant permissions to
+// users and API tokens in addition to their role.
+type CustomRolesController struct {
+	App chainlink.Application
+}
+
+// CustomRoleRequest defines the request to create or update a custom role.
+type CustomRoleRequest struct {
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+// Index lists all custom roles.
+func (crc *CustomRolesController) Index(c *gin.Context) {
+	roles, err := crc.App.AuthenticationProvider().ListCustomRoles(c.Request.Context())
+	if err != nil {
+		if errors.Is(err, clsession.ErrNotSupported) {
+			jsonAPIError(c, http.StatusBadRequest, errUnsupportedForAuth)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewCustomRoleResources(roles), "custom_roles")
+}
+
+// Create creates a new custom role.
+func (crc *CustomRolesController) Create(c *gin.Context) {
+	var request CustomRoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	role, err := clsession.NewCustomRole(request.Name, request.Permissions)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if err = crc.App.AuthenticationProvider().CreateCustomRole(c.Request.Context(), &role); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			jsonAPIError(c, http.StatusBadRequest, errors.Errorf("custom role %s already exists", role.Name))
+			return
+		}
+		if errors.Is(err, clsession.ErrNotSupported) {
+			jsonAPIError(c, http.StatusBadRequest, errUnsupportedForAuth)
+			return
+		}
+		crc.App.GetLogger().Errorw("Error creating custom role", "err", err)
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("error creating custom role"))
+		return
+	}
+
+	crc.App.GetAuditLogger().Audit(audit.CustomRoleCreated, map[string]interface{}{
+		"name":        role.Name,
+		"permissions": role.Permissions,
+	})
+
+	jsonAPIResponseWithStatus(c, presenters.NewCustomRoleResource(role), "custom_role", http.StatusCreated)
+}
+
+// Update replaces the permissions of a custom role. Users and API tokens the
+// role is assigned to are granted the new permissions on their next request.
+func (crc *CustomRolesController) Update(c *gin.Context) {
+	var request CustomRoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	role, err := clsession.NewCustomRole(c.Param("name"), request.Permissions)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if err = crc.App.AuthenticationProvider().UpdateCustomRole(c.Request.Context(), &role); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("custom role not found"))
+			return
+		}
+		if errors.Is(err, clsession.ErrNotSupported) {
+			jsonAPIError(c, http.StatusBadRequest, errUnsupportedForAuth)
+			return
+		}
+		crc.App.GetLogger().Errorw("Error updating custom role", "err", err)
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("error updating custom role"))
+		return
+	}
+
+	crc.App.GetAuditLogger().Audit(audit.CustomRoleUpdated, map[string]interface{}{
+		"name":        role.Name,
+		"permissions": role.Permissions,
+	})
+
+	jsonAPIResponse(c, presenters.NewCustomRoleResource(role), "custom_role")
+}
+
+// Delete deletes a custom role, and unassigns it from its users and API tokens.
+func (crc *CustomRolesController) Delete(c *gin.Context) {
+	name := c.Param("name")
+	if err := crc.App.AuthenticationProvider().DeleteCustomRole(c.Request.Context(), name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("custom role not found"))
+			return
+		}
+		if errors.Is(err, clsession.ErrNotSupported) {
+			jsonAPIError(c, http.StatusBadRequest, errUnsupportedForAuth)
+			return
+		}
+		crc.App.GetLogger().Errorw("Error deleting custom role", "err", err)
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("error deleting custom role"))
+		return
+	}
+
+	crc.App.GetAuditLogger().Audit(audit.CustomRoleDeleted, map[string]interface{}{"name": name})
+
+	jsonAPIResponseWithStatus(c, nil, "custom_role", http.StatusNoContent)
+}
@@ -238,6 +238,62 @@ func (ekc *ETHKeysController) Import(c *gin.Context) {
 	})
 }
 
+// ImportRemoteETHKeyRequest defines the request to import a key held by an external signer.
+type ImportRemoteETHKeyRequest struct {
+	Address   common.Address `json:"address"`
+	SignerURL string         `json:"signerURL"`
+}
+
+// ImportRemote imports a key whose private key is held by an external signer
+// speaking the eth_signTransaction JSON-RPC method, such as web3signer.
+// Example:
+//
+//	"<application>/keys/evm/import_remote"
+func (ekc *ETHKeysController) ImportRemote(c *gin.Context) {
+	ethKeyStore := ekc.app.GetKeyStore().Eth()
+
+	var request ImportRemoteETHKeyRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Address == (common.Address{}) || request.SignerURL == "" {
+		jsonAPIError(c, http.StatusBadRequest, errors.New("address and signerURL are required"))
+		return
+	}
+	cid := c.Query("evmChainID")
+	chain, ok := ekc.getChain(c, cid)
+	if !ok {
+		return
+	}
+
+	key, err := ethKeyStore.ImportRemote(c.Request.Context(), request.Address, request.SignerURL, chain.ID())
+	if err != nil {
+		if errors.Is(err, keystore.ErrKeyExists) {
+			jsonAPIError(c, http.StatusConflict, err)
+			return
+		}
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	state, err := ethKeyStore.GetState(c.Request.Context(), key.ID(), chain.ID())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Set("key", key)
+	c.Set("state", state)
+	c.Status(http.StatusCreated)
+
+	ekc.app.GetAuditLogger().Audit(audit.KeyImported, map[string]interface{}{
+		"type":   "ethereum",
+		"id":     key.ID(),
+		"remote": true,
+	})
+}
+
 func (ekc *ETHKeysController) Export(c *gin.Context) {
 	defer ekc.app.GetLogger().ErrorIfFn(c.Request.Body.Close, "Error closing Export request body")
 
@@ -1,14 +1,18 @@
 package web_test
 
 import (
+	"bytes"
+	"fmt"
 	"math/big"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	commontxmmocks "github.com/smartcontractkit/chainlink/v2/common/txmgr/types/mocks"
 	commonmocks "github.com/smartcontractkit/chainlink/v2/common/types/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
@@ -252,6 +256,56 @@ func TestETHKeysController_CreateSuccess(t *testing.T) {
 	assert.Equal(t, "115792089237316195423570985008687907853269984665640564039457584007913129639935", balance.MaxGasPriceWei.String())
 }
 
+func TestETHKeysController_ImportRemote(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].BalanceMonitor.Enabled = ptr(false)
+	})
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	app := cltest.NewApplicationWithConfigAndKey(t, config, ethClient)
+
+	sub := commonmocks.NewSubscription(t)
+	cltest.MockApplicationEthCalls(t, app, ethClient, sub)
+	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).Return(big.NewInt(100), nil)
+	ethClient.On("LINKBalance", mock.Anything, mock.Anything, mock.Anything).Return(assets.NewLinkFromJuels(42), nil)
+
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	address := testutils.NewAddress()
+	signer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":["%s"]}`, address.Hex())
+	}))
+	defer signer.Close()
+
+	client := app.NewHTTPClient(nil)
+	importURL := url.URL{Path: "/v2/keys/evm/import_remote"}
+	query := importURL.Query()
+	query.Set("evmChainID", cltest.FixtureChainID.String())
+	importURL.RawQuery = query.Encode()
+
+	body := fmt.Sprintf(`{"address":"%s","signerURL":"%s"}`, address.Hex(), signer.URL)
+	resp, cleanup := client.Post(importURL.String(), bytes.NewBufferString(body))
+	defer cleanup()
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var key webpresenters.ETHKeyResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &key))
+	assert.Equal(t, address.Hex(), key.Address)
+	assert.True(t, key.Remote)
+
+	stored, err := app.KeyStore.Eth().Get(ctx, address.Hex())
+	require.NoError(t, err)
+	assert.True(t, stored.IsRemote())
+
+	// The signer does not hold the key
+	body = fmt.Sprintf(`{"address":"%s","signerURL":"%s"}`, testutils.NewAddress().Hex(), signer.URL)
+	resp2, cleanup2 := client.Post(importURL.String(), bytes.NewBufferString(body))
+	defer cleanup2()
+	cltest.AssertServerResponse(t, resp2, http.StatusBadRequest)
+}
+
 func TestETHKeysController_ChainSuccess_UpdateNonce(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -412,6 +466,7 @@ func TestETHKeysController_ChainSuccess_ResetWithAbandon(t *testing.T) {
 	subject := uuid.New()
 	strategy := commontxmmocks.NewTxStrategy(t)
 	strategy.On("Subject").Return(uuid.NullUUID{UUID: subject, Valid: true})
+	strategy.On("Priority").Return(txmgrtypes.TxPriorityNormal)
 	strategy.On("PruneQueue", mock.Anything, mock.AnythingOfType("*txmgr.evmTxStore")).Return(nil, nil)
 	_, err := chain.TxManager().CreateTransaction(testutils.Context(t), txmgr.TxRequest{
 		FromAddress:    addr,
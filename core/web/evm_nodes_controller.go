@@ -2,6 +2,7 @@ package web
 
 import (
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
@@ -9,6 +10,14 @@ import (
 func NewEVMNodesController(app chainlink.Application) NodesController {
 	scopedNodeStatuser := NewNetworkScopedNodeStatuser(app.GetRelayers(), types.NetworkEVM)
 
+	newResource := func(status types.NodeStatus) presenters.EVMNodeResource {
+		r := presenters.NewEVMNodeResource(status)
+		if latency, ok := legacyevm.NodeLatency(app.GetRelayers().LegacyEVMChains(), status.ChainID, status.Name); ok {
+			r = r.WithLatency(latency)
+		}
+		return r
+	}
+
 	return newNodesController[presenters.EVMNodeResource](
-		scopedNodeStatuser, ErrEVMNotEnabled, presenters.NewEVMNodeResource, app.GetAuditLogger())
+		scopedNodeStatuser, ErrEVMNotEnabled, newResource, app.GetAuditLogger())
 }
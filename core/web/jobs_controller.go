@@ -26,10 +26,13 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -133,6 +136,71 @@ func (jc *JobsController) Create(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
 }
 
+// SimulateJobRequest represents a request to simulate the pipeline of a job: either of
+// the job with JobID, or of the job TOML spec which doesn't have to be created.
+type SimulateJobRequest struct {
+	JobID    string                 `json:"jobID"`
+	TOML     string                 `json:"toml"`
+	Vars     map[string]interface{} `json:"vars"`
+	Fixtures pipeline.Fixtures      `json:"fixtures"`
+}
+
+// Simulate executes the pipeline of a job without side effects and returns
+// the resulting run, which isn't saved. Users allowed to run jobs can simulate
+// existing jobs, while simulating a TOML spec requires permission to write jobs.
+// Example:
+// "POST <application>/jobs/simulate"
+func (jc *JobsController) Simulate(c *gin.Context) {
+	request := SimulateJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	var jb job.Job
+	var err error
+	switch {
+	case request.JobID != "" && request.TOML != "":
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("only one of jobID and toml can be set"))
+		return
+	case request.JobID != "":
+		if err = jb.SetID(request.JobID); err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jb, err = jc.App.JobORM().FindJob(c.Request.Context(), jb.ID)
+		if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("Job not found"))
+			return
+		} else if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	case request.TOML != "":
+		// The http, bridge and ethcall tasks of the spec are executed, so simulating a spec which is not a job
+		// requires the same permission as creating one.
+		if user, ok := auth.GetAuthenticatedUser(c); !ok || !user.HasPermission(clsessions.PermissionJobsWrite) {
+			jsonAPIError(c, http.StatusForbidden, errors.New("simulating a job spec requires permission to write jobs, only existing jobs can be simulated by jobID"))
+			return
+		}
+		if jb, err = job.ValidatedSimulationSpec(request.TOML); err != nil {
+			jsonAPIError(c, http.StatusBadRequest, err)
+			return
+		}
+	default:
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("either jobID or toml must be set"))
+		return
+	}
+
+	run, _, err := jc.App.SimulateJobV2(c.Request.Context(), jb, request.Vars, request.Fixtures)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineRunResource(*run, jc.App.GetLogger()), "pipelineRun")
+}
+
 // Delete hard deletes a job spec.
 // Example:
 // "DELETE <application>/specs/:ID"
@@ -36,6 +36,8 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/tomlutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
@@ -504,6 +506,71 @@ func TestJobsController_Create_WebhookSpec(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestJobsController_Simulate(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(nil)
+
+	body, err := json.Marshal(web.SimulateJobRequest{
+		TOML: `
+type = "webhook"
+schemaVersion = 1
+observationSource = """
+ds    [type=http method=GET url="https://example.invalid"];
+parse [type=jsonparse path="price"];
+ds -> parse;
+"""
+`,
+		Fixtures: pipeline.Fixtures{"ds": {Value: `{"price": 42}`}},
+	})
+	require.NoError(t, err)
+	response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+	defer cleanup()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+
+	resource := presenters.PipelineRunResource{}
+	err = web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource)
+	require.NoError(t, err)
+	require.Len(t, resource.Outputs, 1)
+	assert.Equal(t, "42", *resource.Outputs[0])
+	assert.Len(t, resource.TaskRuns, 2)
+
+	jobs, count, err := app.JobORM().FindJobs(testutils.Context(t), 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, jobs)
+	assert.Zero(t, count)
+
+	body, err = json.Marshal(web.SimulateJobRequest{JobID: "999999"})
+	require.NoError(t, err)
+	response, cleanup = client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+	defer cleanup()
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+
+	t.Run("run users cannot simulate job specs", func(t *testing.T) {
+		runClient := app.NewHTTPClient(&cltest.User{Role: sessions.UserRoleRun})
+		body, err := json.Marshal(web.SimulateJobRequest{
+			TOML: `
+type = "webhook"
+schemaVersion = 1
+observationSource = """
+ds [type=http method=GET url="http://169.254.169.254/latest/meta-data"];
+"""
+`,
+		})
+		require.NoError(t, err)
+		response, cleanup := runClient.Post("/v2/jobs/simulate", bytes.NewReader(body))
+		defer cleanup()
+		assert.Equal(t, http.StatusForbidden, response.StatusCode)
+
+		body, err = json.Marshal(web.SimulateJobRequest{JobID: "999999"})
+		require.NoError(t, err)
+		response, cleanup = runClient.Post("/v2/jobs/simulate", bytes.NewReader(body))
+		defer cleanup()
+		assert.Equal(t, http.StatusNotFound, response.StatusCode)
+	})
+}
+
 //go:embed webhook-spec-template.yml
 var webhookSpecTemplate string
 
@@ -0,0 +1,98 @@
+package web
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// KeystoreBackupController backs up and restores all the keys of the keystore at once.
+type KeystoreBackupController struct {
+	App chainlink.Application
+}
+
+// Backup returns a backup of all the keys and eth key states, encrypted with the newpassword query param.
+// Example:
+// "POST <application>/keys/backup?newpassword=..."
+func (ctrl *KeystoreBackupController) Backup(c *gin.Context) {
+	defer ctrl.App.GetLogger().ErrorIfFn(c.Request.Body.Close, "Error closing Backup request body")
+
+	newPassword := c.Query("newpassword")
+	if newPassword == "" {
+		jsonAPIError(c, http.StatusBadRequest, errors.New("newpassword is required"))
+		return
+	}
+
+	bytes, err := ctrl.App.GetKeyStore().Backup(c.Request.Context(), newPassword)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctrl.App.GetAuditLogger().Audit(audit.KeystoreBackedUp, map[string]interface{}{})
+	c.Data(http.StatusOK, MediaType, bytes)
+}
+
+// Restore adds the keys and eth key states of a backup encrypted with the oldpassword query param to the keystore,
+// and reports them. With the dryRun query param, it only reports what would be restored.
+// Example:
+// "POST <application>/keys/restore?oldpassword=...&dryRun=true"
+func (ctrl *KeystoreBackupController) Restore(c *gin.Context) {
+	defer ctrl.App.GetLogger().ErrorIfFn(c.Request.Body.Close, "Error closing Restore request body")
+
+	bytes, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	dryRun := false
+	if dryRunStr, ok := c.GetQuery("dryRun"); ok {
+		dryRun, err = strconv.ParseBool(dryRunStr)
+		if err != nil {
+			jsonAPIError(c, http.StatusBadRequest, errors.Wrap(err, "invalid dryRun"))
+			return
+		}
+	}
+
+	report, err := ctrl.App.GetKeyStore().Restore(c.Request.Context(), bytes, c.Query("oldpassword"), dryRun)
+	if errors.Is(err, keystore.ErrRestoreConflicts) {
+		jsonAPIError(c, http.StatusConflict, restoreConflictsError(report))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if !dryRun {
+		ctrl.App.GetAuditLogger().Audit(audit.KeystoreRestored, map[string]interface{}{
+			"backupCreatedAt": report.CreatedAt,
+		})
+	}
+	jsonAPIResponse(c, presenters.NewKeystoreRestoreResource(report), "keystoreRestore")
+}
+
+// restoreConflictsError lists the keys and eth key states of a backup which conflict with the keystore.
+func restoreConflictsError(report keystore.RestoreReport) error {
+	var conflicts []string
+	for _, k := range report.Keys {
+		if k.Status == keystore.RestoreStatusConflict {
+			conflicts = append(conflicts, fmt.Sprintf("%s key %s", k.Type, k.ID))
+		}
+	}
+	for _, s := range report.EthKeyStates {
+		if s.Status == keystore.RestoreStatusConflict {
+			conflicts = append(conflicts, fmt.Sprintf("state of eth key %s on chain %s", s.Address, s.EVMChainID.String()))
+		}
+	}
+	return errors.Errorf("%s: %s", keystore.ErrRestoreConflicts, strings.Join(conflicts, ", "))
+}
@@ -0,0 +1,79 @@
+package web_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestKeystoreBackupController_BackupRestore(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+	key, err := app.GetKeyStore().P2P().Create(ctx)
+	require.NoError(t, err)
+
+	response, cleanup := client.Post("/v2/keys/backup", nil)
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+	response, cleanup = client.Post("/v2/keys/backup?newpassword=b4ckup-p4ssw0rd", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	backup, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+
+	restoredApp := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, restoredApp.Start(ctx))
+	restoredClient := restoredApp.NewHTTPClient(nil)
+
+	restore := func(t *testing.T, query string, status int) presenters.KeystoreRestoreResource {
+		response, cleanup := restoredClient.Post("/v2/keys/restore?"+query, bytes.NewReader(backup))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, status)
+		var resource presenters.KeystoreRestoreResource
+		if status == http.StatusOK {
+			require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		}
+		return resource
+	}
+	requireP2PKey := func(t *testing.T, resource presenters.KeystoreRestoreResource) {
+		assert.False(t, resource.HasConflicts)
+		assert.Contains(t, resource.Keys, keystore.RestoredKey{Type: "P2P", ID: key.ID(), Status: keystore.RestoreStatusAdded})
+	}
+
+	t.Run("invalid requests", func(t *testing.T) {
+		restore(t, "oldpassword=wrong", http.StatusBadRequest)
+		restore(t, "oldpassword=b4ckup-p4ssw0rd&dryRun=maybe", http.StatusBadRequest)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		resource := restore(t, "oldpassword=b4ckup-p4ssw0rd&dryRun=true", http.StatusOK)
+		assert.True(t, resource.DryRun)
+		requireP2PKey(t, resource)
+		_, err := restoredApp.GetKeyStore().P2P().Get(key.PeerID())
+		require.Error(t, err)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		resource := restore(t, "oldpassword=b4ckup-p4ssw0rd", http.StatusOK)
+		assert.False(t, resource.DryRun)
+		requireP2PKey(t, resource)
+		restoredKey, err := restoredApp.GetKeyStore().P2P().Get(key.PeerID())
+		require.NoError(t, err)
+		assert.Equal(t, key.Raw(), restoredKey.Raw())
+	})
+}
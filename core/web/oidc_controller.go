@@ -0,0 +1,99 @@
+package web
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+const (
+	// oidcStateCookie binds a login to the user agent which started it. Unlike the session cookie, it is sent with
+	// the cross-site redirect of the identity provider to the callback.
+	oidcStateCookie = "oidc_state"
+	oidcCookiePath  = "/oidc"
+	// oidcStateCookieMaxAge is the time a user has to log in with the identity provider, in seconds.
+	oidcStateCookieMaxAge = 600
+)
+
+var errSingleSignOnDisabled = errors.New("single sign-on is not enabled, AuthenticationMethod must be 'oidc'")
+
+// OIDCController logs users in with the OpenID Connect identity provider of the node.
+type OIDCController struct {
+	App chainlink.Application
+}
+
+// Login redirects the user agent to the identity provider to log in.
+func (oc *OIDCController) Login(c *gin.Context) {
+	sso, ok := oc.App.AuthenticationProvider().(clsessions.SingleSignOnProvider)
+	if !ok {
+		jsonAPIError(c, http.StatusNotFound, errSingleSignOnDisabled)
+		return
+	}
+
+	redirectURL, state, err := sso.BeginLogin(c.Request.Context())
+	if err != nil {
+		oc.App.GetLogger().Errorw("Error starting OIDC login", "err", err)
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("error starting login"))
+		return
+	}
+
+	oc.setStateCookie(c, state, oidcStateCookieMaxAge)
+	c.Redirect(http.StatusFound, redirectURL)
+}
+
+// Callback completes the login with the authorization code the identity provider redirected the user agent with,
+// and creates the session of the user.
+func (oc *OIDCController) Callback(c *gin.Context) {
+	defer oc.App.WakeSessionReaper()
+
+	sso, ok := oc.App.AuthenticationProvider().(clsessions.SingleSignOnProvider)
+	if !ok {
+		jsonAPIError(c, http.StatusNotFound, errSingleSignOnDisabled)
+		return
+	}
+
+	stateCookie, cookieErr := c.Cookie(oidcStateCookie)
+	oc.setStateCookie(c, "", -1)
+	if idpErr := c.Query("error"); idpErr != "" {
+		jsonAPIError(c, http.StatusUnauthorized, fmt.Errorf("login failed at the identity provider: %s %s", idpErr, c.Query("error_description")))
+		return
+	}
+	state := c.Query("state")
+	if cookieErr != nil || state == "" || subtle.ConstantTimeCompare([]byte(stateCookie), []byte(state)) != 1 {
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("login state does not match, please login again"))
+		return
+	}
+
+	sid, err := sso.FinishLogin(c.Request.Context(), state, c.Query("code"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	if err := saveSessionID(sessions.Default(c), sid); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, multierr.Append(errors.New("unable to save session id"), err))
+		return
+	}
+
+	c.Redirect(http.StatusFound, "/")
+}
+
+func (oc *OIDCController) setStateCookie(c *gin.Context, state string, maxAge int) {
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    state,
+		Path:     oidcCookiePath,
+		MaxAge:   maxAge,
+		Secure:   oc.App.GetConfig().WebServer().SecureCookies(),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
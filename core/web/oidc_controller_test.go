@@ -0,0 +1,194 @@
+package web_test
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+)
+
+// oidcProvider is an OpenID Connect identity provider which logs in a single user, a member of NodeReadOnly.
+type oidcProvider struct {
+	*httptest.Server
+	key *rsa.PrivateKey
+
+	mu    sync.Mutex
+	nonce string
+}
+
+func newOIDCProvider(t *testing.T) *oidcProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	p := &oidcProvider{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 p.URL,
+			"authorization_endpoint": p.URL + "/authorize",
+			"token_endpoint":         p.URL + "/token",
+			"jwks_uri":               p.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "key-1",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("code") != "valid-code" {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		p.mu.Lock()
+		nonce := p.nonce
+		p.mu.Unlock()
+		header, _ := json.Marshal(map[string]string{"alg": "RS256", "kid": "key-1"})
+		payload, _ := json.Marshal(map[string]interface{}{
+			"iss":    p.URL,
+			"sub":    "oidc-user",
+			"aud":    "chainlink-node",
+			"exp":    time.Now().Add(time.Minute).Unix(),
+			"nonce":  nonce,
+			"email":  "oidc-user@chainlink.test",
+			"groups": []string{"NodeReadOnly"},
+		})
+		signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+		digest := sha256.Sum256([]byte(signed))
+		signature, _ := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+		_ = json.NewEncoder(w).Encode(map[string]string{"id_token": signed + "." + base64.RawURLEncoding.EncodeToString(signature)})
+	})
+	p.Server = httptest.NewServer(mux)
+	t.Cleanup(p.Close)
+	return p
+}
+
+func setupOIDCApplication(t *testing.T, p *oidcProvider) *cltest.TestApplication {
+	config := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.Insecure.DevWebServer = ptr(true)
+		c.WebServer.AuthenticationMethod = ptr("oidc")
+		c.WebServer.OIDC.ProviderURL = commonconfig.MustParseURL(p.URL)
+		c.WebServer.OIDC.ClientID = ptr("chainlink-node")
+		c.WebServer.OIDC.RedirectURL = commonconfig.MustParseURL("http://localhost:6688/oidc/callback")
+	})
+	app := cltest.NewApplicationWithConfig(t, config)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	return app
+}
+
+func noRedirectClient() *http.Client {
+	return &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+}
+
+func TestOIDCController_Disabled(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	resp, err := noRedirectClient().Get(app.Server.URL + "/oidc/login")
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, resp.Body.Close()) }()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestOIDCController_Login(t *testing.T) {
+	t.Parallel()
+
+	p := newOIDCProvider(t)
+	app := setupOIDCApplication(t, p)
+	client := noRedirectClient()
+
+	resp, err := client.Get(app.Server.URL + "/oidc/login")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+	location, err := url.Parse(resp.Header.Get("Location"))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(location.String(), p.URL+"/authorize?"))
+	state := location.Query().Get("state")
+	p.mu.Lock()
+	p.nonce = location.Query().Get("nonce")
+	p.mu.Unlock()
+
+	var stateCookie *http.Cookie
+	for _, c := range resp.Cookies() {
+		if c.Name == "oidc_state" {
+			stateCookie = c
+		}
+	}
+	require.NotNil(t, stateCookie)
+	assert.Equal(t, state, stateCookie.Value)
+	assert.Equal(t, http.SameSiteLaxMode, stateCookie.SameSite)
+	assert.True(t, stateCookie.HttpOnly)
+
+	callback := func(t *testing.T, query string, cookie *http.Cookie) *http.Response {
+		req, err := http.NewRequestWithContext(testutils.Context(t), http.MethodGet, app.Server.URL+"/oidc/callback?"+query, nil)
+		require.NoError(t, err)
+		if cookie != nil {
+			req.AddCookie(cookie)
+		}
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return resp
+	}
+
+	t.Run("without state cookie", func(t *testing.T) {
+		resp := callback(t, url.Values{"state": {state}, "code": {"valid-code"}}.Encode(), nil)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		assert.Nil(t, web.FindSessionCookie(resp.Cookies()))
+	})
+
+	t.Run("error of the identity provider", func(t *testing.T) {
+		resp := callback(t, url.Values{"state": {state}, "error": {"access_denied"}}.Encode(), stateCookie)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		assert.Nil(t, web.FindSessionCookie(resp.Cookies()))
+	})
+
+	t.Run("success", func(t *testing.T) {
+		resp := callback(t, url.Values{"state": {state}, "code": {"valid-code"}}.Encode(), stateCookie)
+		require.Equal(t, http.StatusFound, resp.StatusCode)
+		assert.Equal(t, "/", resp.Header.Get("Location"))
+		sessionCookie := web.FindSessionCookie(resp.Cookies())
+		require.NotNil(t, sessionCookie)
+
+		req, err := http.NewRequestWithContext(testutils.Context(t), http.MethodGet, app.Server.URL+"/v2/bridge_types", nil)
+		require.NoError(t, err)
+		req.AddCookie(sessionCookie)
+		resp, err = client.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		// The state was used
+		resp = callback(t, url.Values{"state": {state}, "code": {"valid-code"}}.Encode(), stateCookie)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+}
@@ -21,6 +21,8 @@ type ETHKeyResource struct {
 	CreatedAt      time.Time          `json:"createdAt"`
 	UpdatedAt      time.Time          `json:"updatedAt"`
 	MaxGasPriceWei *big.Big           `json:"maxGasPriceWei"`
+	// Remote is true for the keys held by an external signer.
+	Remote bool `json:"remote,omitempty"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -48,6 +50,7 @@ func NewETHKeyResource(k ethkey.KeyV2, state ethkey.State, opts ...NewETHKeyOpti
 		Disabled:    state.Disabled,
 		CreatedAt:   state.CreatedAt,
 		UpdatedAt:   state.UpdatedAt,
+		Remote:      k.IsRemote(),
 	}
 
 	for _, opt := range opts {
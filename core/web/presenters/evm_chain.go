@@ -1,6 +1,10 @@
 package presenters
 
-import "github.com/smartcontractkit/chainlink-common/pkg/types"
+import (
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+)
 
 // EVMChainResource is an EVM chain JSONAPI resource.
 type EVMChainResource struct {
@@ -24,6 +28,15 @@ func NewEVMChainResource(chain types.ChainStatus) EVMChainResource {
 // EVMNodeResource is an EVM node JSONAPI resource.
 type EVMNodeResource struct {
 	NodeResource
+	// Latency is only set for nodes of a running chain which have been polled at least once.
+	Latency *EVMNodeLatencyResource `json:"latency,omitempty"`
+}
+
+// EVMNodeLatencyResource holds the poll latency averages of an EVM node, as used by the LowestLatency selection mode.
+type EVMNodeLatencyResource struct {
+	RTT       string  `json:"rtt"`
+	ErrorRate float64 `json:"errorRate"`
+	Score     float64 `json:"score"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -41,3 +54,13 @@ func NewEVMNodeResource(node types.NodeStatus) EVMNodeResource {
 		Config:  node.Config,
 	}}
 }
+
+// WithLatency returns a copy of r which includes the latency averages of the node.
+func (r EVMNodeResource) WithLatency(latency commonclient.NodeLatency) EVMNodeResource {
+	r.Latency = &EVMNodeLatencyResource{
+		RTT:       latency.RTT.String(),
+		ErrorRate: latency.ErrorRate,
+		Score:     latency.Score(),
+	}
+	return r
+}
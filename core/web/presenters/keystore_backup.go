@@ -0,0 +1,36 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+)
+
+// KeystoreRestoreResource represents the report of a keystore backup restore JSONAPI resource.
+type KeystoreRestoreResource struct {
+	JAID
+	Version      int                            `json:"version"`
+	CreatedAt    time.Time                      `json:"createdAt"`
+	DryRun       bool                           `json:"dryRun"`
+	HasConflicts bool                           `json:"hasConflicts"`
+	Keys         []keystore.RestoredKey         `json:"keys"`
+	EthKeyStates []keystore.RestoredEthKeyState `json:"ethKeyStates"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (KeystoreRestoreResource) GetName() string {
+	return "keystoreRestores"
+}
+
+// NewKeystoreRestoreResource constructs a new KeystoreRestoreResource, identified by the creation time of the backup.
+func NewKeystoreRestoreResource(report keystore.RestoreReport) *KeystoreRestoreResource {
+	return &KeystoreRestoreResource{
+		JAID:         NewJAID(report.CreatedAt.Format(time.RFC3339)),
+		Version:      report.Version,
+		CreatedAt:    report.CreatedAt,
+		DryRun:       report.DryRun,
+		HasConflicts: report.HasConflicts(),
+		Keys:         report.Keys,
+		EthKeyStates: report.EthKeyStates,
+	}
+}
@@ -3,12 +3,15 @@ package presenters
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 )
 
 func TestNodeResource(t *testing.T) {
@@ -90,3 +93,41 @@ func TestNodeResource(t *testing.T) {
 		assert.JSONEq(t, expected, string(b))
 	}
 }
+
+func TestEVMNodeResource_WithLatency(t *testing.T) {
+	status := types.NodeStatus{
+		ChainID: "1",
+		Name:    "primary",
+		Config:  "cfg",
+		State:   "Alive",
+	}
+
+	b, err := jsonapi.Marshal(NewEVMNodeResource(status))
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "latency")
+
+	latency := commonclient.NodeLatency{RTT: 150 * time.Millisecond, ErrorRate: 0.25, Samples: 4}
+	r := NewEVMNodeResource(status).WithLatency(latency)
+	require.NotNil(t, r.Latency)
+	assert.Equal(t, "150ms", r.Latency.RTT)
+	assert.Equal(t, 0.25, r.Latency.ErrorRate)
+	assert.Equal(t, latency.Score(), r.Latency.Score)
+
+	b, err = jsonapi.Marshal(r)
+	require.NoError(t, err)
+	expected := fmt.Sprintf(`
+	{
+	  "data":{
+		  "type":"evm_node",
+		  "id":"1/primary",
+		  "attributes":{
+			 "chainID":"1",
+			 "name":"primary",
+			 "config":"cfg",
+			 "state":"Alive",
+			 "latency":{"rtt":"150ms","errorRate":0.25,"score":%v}
+		  }
+	  }
+	}`, latency.Score())
+	assert.JSONEq(t, expected, string(b))
+}
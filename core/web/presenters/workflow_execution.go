@@ -0,0 +1,94 @@
+package presenters
+
+import (
+	"sort"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+// WorkflowExecutionResource represents an execution of a workflow job
+type WorkflowExecutionResource struct {
+	JAID
+	WorkflowID string                          `json:"workflowID"`
+	Status     string                          `json:"status"`
+	CreatedAt  *time.Time                      `json:"createdAt"`
+	UpdatedAt  *time.Time                      `json:"updatedAt"`
+	FinishedAt *time.Time                      `json:"finishedAt"`
+	Steps      []WorkflowExecutionStepResource `json:"steps"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r WorkflowExecutionResource) GetName() string {
+	return "workflowExecution"
+}
+
+func NewWorkflowExecutionResource(execution store.WorkflowExecution, lggr logger.Logger) WorkflowExecutionResource {
+	lggr = lggr.Named("WorkflowExecutionResource")
+	steps := []WorkflowExecutionStepResource{}
+	for _, step := range execution.Steps {
+		steps = append(steps, NewWorkflowExecutionStepResource(step, lggr))
+	}
+	sort.Slice(steps, func(i, j int) bool {
+		return steps[i].Ref < steps[j].Ref
+	})
+
+	return WorkflowExecutionResource{
+		JAID:       NewJAID(execution.ExecutionID),
+		WorkflowID: execution.WorkflowID,
+		Status:     execution.Status,
+		CreatedAt:  execution.CreatedAt,
+		UpdatedAt:  execution.UpdatedAt,
+		FinishedAt: execution.FinishedAt,
+		Steps:      steps,
+	}
+}
+
+func NewWorkflowExecutionResources(executions []store.WorkflowExecution, lggr logger.Logger) []WorkflowExecutionResource {
+	var out []WorkflowExecutionResource
+
+	for _, execution := range executions {
+		out = append(out, NewWorkflowExecutionResource(execution, lggr))
+	}
+
+	return out
+}
+
+// WorkflowExecutionStepResource represents a step of a workflow execution.
+// Inputs and Outputs are JSON encoded.
+type WorkflowExecutionStepResource struct {
+	Ref       string     `json:"ref"`
+	Status    string     `json:"status"`
+	Attempts  int        `json:"attempts"`
+	Inputs    *string    `json:"inputs"`
+	Outputs   *string    `json:"outputs"`
+	Error     *string    `json:"error"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+func NewWorkflowExecutionStepResource(step *store.WorkflowExecutionStep, lggr logger.Logger) WorkflowExecutionStepResource {
+	r := WorkflowExecutionStepResource{
+		Ref:       step.Ref,
+		Status:    step.Status,
+		Attempts:  step.Attempts,
+		UpdatedAt: step.UpdatedAt,
+	}
+	if inputs, err := step.InputsJSON(); err != nil {
+		lggr.Errorw("Failed to encode step inputs", "ref", step.Ref, "err", err)
+	} else if inputs != nil {
+		s := string(inputs)
+		r.Inputs = &s
+	}
+	if outputs, err := step.OutputsJSON(); err != nil {
+		lggr.Errorw("Failed to encode step outputs", "ref", step.Ref, "err", err)
+	} else if outputs != nil {
+		s := string(outputs)
+		r.Outputs = &s
+	}
+	if step.Outputs.Err != nil {
+		s := step.Outputs.Err.Error()
+		r.Error = &s
+	}
+	return r
+}
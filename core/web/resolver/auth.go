@@ -54,6 +54,18 @@ func authenticateUserIsAdmin(ctx context.Context) error {
 	return nil
 }
 
+// Authenticates the user from the session cookie and asserts it is granted permission, by its role or its custom role.
+func authenticateUserHasPermission(ctx context.Context, permission sessions.Permission) error {
+	session, ok := auth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return unauthorizedError{}
+	}
+	if !session.User.HasPermission(permission) {
+		return RoleNotPermittedErr{session.User.Role}
+	}
+	return nil
+}
+
 type unauthorizedError struct{}
 
 func (e unauthorizedError) Error() string {
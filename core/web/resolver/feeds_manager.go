@@ -11,6 +11,38 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
 
+// AutoApprovalPolicy defines the enum values for GQL
+type AutoApprovalPolicy string
+
+const (
+	// revive:disable
+	AutoApprovalPolicyNone              AutoApprovalPolicy = "NONE"
+	AutoApprovalPolicyObservationSource AutoApprovalPolicy = "OBSERVATION_SOURCE"
+	// revive:enable
+)
+
+// ToAutoApprovalPolicy converts the feeds auto approval policy into the enum
+// value.
+func ToAutoApprovalPolicy(p feeds.AutoApprovalPolicy) AutoApprovalPolicy {
+	switch p {
+	case feeds.AutoApprovalPolicyObservationSource:
+		return AutoApprovalPolicyObservationSource
+	default:
+		return AutoApprovalPolicyNone
+	}
+}
+
+// FromAutoApprovalPolicy converts the enum value into the feeds auto approval
+// policy.
+func FromAutoApprovalPolicy(p AutoApprovalPolicy) feeds.AutoApprovalPolicy {
+	switch p {
+	case AutoApprovalPolicyObservationSource:
+		return feeds.AutoApprovalPolicyObservationSource
+	default:
+		return feeds.AutoApprovalPolicyNone
+	}
+}
+
 // FeedsManagerResolver resolves the FeedsManager type.
 type FeedsManagerResolver struct {
 	mgr feeds.FeedsManager
@@ -49,6 +81,11 @@ func (r *FeedsManagerResolver) PublicKey() string {
 	return r.mgr.PublicKey.String()
 }
 
+// AutoApprovalPolicy resolves the feed managers's auto approval policy field.
+func (r *FeedsManagerResolver) AutoApprovalPolicy() AutoApprovalPolicy {
+	return ToAutoApprovalPolicy(r.mgr.AutoApprovalPolicy)
+}
+
 func (r *FeedsManagerResolver) JobProposals(ctx context.Context) ([]*JobProposalResolver, error) {
 	jps, err := loader.GetJobProposalsByFeedsManagerID(ctx, stringutils.FromInt64(r.mgr.ID))
 	if err != nil {
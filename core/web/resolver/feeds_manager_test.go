@@ -5,11 +5,15 @@ import (
 	"database/sql"
 	"testing"
 
+	"github.com/graph-gophers/graphql-go"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 )
 
 func Test_FeedsManagers(t *testing.T) {
@@ -437,3 +441,102 @@ func Test_UpdateFeedsManager(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func Test_UpdateFeedsManagerAutoApprovalPolicy(t *testing.T) {
+	var (
+		mgrID = int64(1)
+
+		mutation = `
+			mutation UpdateFeedsManagerAutoApprovalPolicy($id: ID!, $input: UpdateFeedsManagerAutoApprovalPolicyInput!) {
+				updateFeedsManagerAutoApprovalPolicy(id: $id, input: $input) {
+					... on UpdateFeedsManagerSuccess {
+						feedsManager {
+							id
+							autoApprovalPolicy
+						}
+					}
+					... on NotFoundError {
+						message
+						code
+					}
+				}
+			}`
+		variables = map[string]interface{}{
+			"id": "1",
+			"input": map[string]interface{}{
+				"policy": "OBSERVATION_SOURCE",
+			},
+		}
+	)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "updateFeedsManagerAutoApprovalPolicy"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+				f.Mocks.feedsSvc.On("UpdateManagerAutoApprovalPolicy", mock.Anything, mgrID, feeds.AutoApprovalPolicyObservationSource).Return(nil)
+				f.Mocks.feedsSvc.On("GetManager", mock.Anything, mgrID).Return(&feeds.FeedsManager{
+					ID:                 mgrID,
+					AutoApprovalPolicy: feeds.AutoApprovalPolicyObservationSource,
+				}, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"updateFeedsManagerAutoApprovalPolicy": {
+					"feedsManager": {
+						"id": "1",
+						"autoApprovalPolicy": "OBSERVATION_SOURCE"
+					}
+				}
+			}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+				f.Mocks.feedsSvc.On("UpdateManagerAutoApprovalPolicy", mock.Anything, mgrID, feeds.AutoApprovalPolicyObservationSource).Return(sql.ErrNoRows)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+			{
+				"updateFeedsManagerAutoApprovalPolicy": {
+					"message": "feeds manager not found",
+					"code": "NOT_FOUND"
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func Test_UpdateFeedsManagerAutoApprovalPolicy_RequiresJobProposalsApprove(t *testing.T) {
+	t.Parallel()
+
+	f := setupFramework(t)
+	r := &Resolver{App: f.App}
+	args := struct {
+		ID    graphql.ID
+		Input *updateFeedsManagerAutoApprovalPolicyInput
+	}{
+		ID:    "1",
+		Input: &updateFeedsManagerAutoApprovalPolicyInput{Policy: AutoApprovalPolicyObservationSource},
+	}
+
+	// a custom role which may edit feeds managers, but not approve the job proposals which the policy would approve
+	user := clsessions.User{
+		Email:       "gqltester@chain.link",
+		Role:        clsessions.UserRoleView,
+		Permissions: clsessions.Permissions{clsessions.PermissionFeedsManagersWrite},
+	}
+	ctx := auth.WithGQLAuthenticatedSession(testutils.Context(t), user, "gqltesterSession")
+
+	_, err := r.UpdateFeedsManagerAutoApprovalPolicy(ctx, args)
+	require.Equal(t, RoleNotPermittedErr{clsessions.UserRoleView}, err)
+}
@@ -1,10 +1,14 @@
 package resolver
 
 import (
+	"context"
+	"strconv"
+
 	"github.com/graph-gophers/graphql-go"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
+	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
 
 // SpecStatus defines the enum values for GQL
@@ -96,6 +100,120 @@ func (r *JobProposalSpecResolver) UpdatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.UpdatedAt}
 }
 
+// Diff resolves to the difference between the spec and the approved spec of
+// the job proposal. It is null if the job proposal has no approved spec, or if
+// the spec is the approved one.
+func (r *JobProposalSpecResolver) Diff(ctx context.Context) (*JobProposalSpecDiffResolver, error) {
+	specs, err := loader.GetSpecsByJobProposalID(ctx, strconv.FormatInt(r.spec.JobProposalID, 10))
+	if err != nil {
+		return nil, err
+	}
+
+	var approved *feeds.JobProposalSpec
+	for i := range specs {
+		if specs[i].Status == feeds.SpecStatusApproved {
+			approved = &specs[i]
+		}
+	}
+
+	if approved == nil || approved.ID == r.spec.ID {
+		return nil, nil
+	}
+
+	diff, err := feeds.DiffSpecs(approved.Definition, r.spec.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewJobProposalSpecDiff(diff), nil
+}
+
+// SpecChangeType defines the enum values for GQL
+type SpecChangeType string
+
+const (
+	// revive:disable
+	SpecChangeTypeAdded    SpecChangeType = "ADDED"
+	SpecChangeTypeRemoved  SpecChangeType = "REMOVED"
+	SpecChangeTypeModified SpecChangeType = "MODIFIED"
+	// revive:enable
+)
+
+// ToSpecChangeType converts the feeds spec change type into the enum value.
+func ToSpecChangeType(t feeds.SpecChangeType) SpecChangeType {
+	switch t {
+	case feeds.SpecChangeTypeAdded:
+		return SpecChangeTypeAdded
+	case feeds.SpecChangeTypeRemoved:
+		return SpecChangeTypeRemoved
+	default:
+		return SpecChangeTypeModified
+	}
+}
+
+// JobProposalSpecDiffResolver resolves the Job Proposal Spec Diff type.
+type JobProposalSpecDiffResolver struct {
+	diff *feeds.SpecDiff
+}
+
+// NewJobProposalSpecDiff creates a new JobProposalSpecDiffResolver.
+func NewJobProposalSpecDiff(diff *feeds.SpecDiff) *JobProposalSpecDiffResolver {
+	return &JobProposalSpecDiffResolver{diff: diff}
+}
+
+// Changes resolves to the changed fields of the spec.
+func (r *JobProposalSpecDiffResolver) Changes() []*SpecFieldChangeResolver {
+	resolvers := []*SpecFieldChangeResolver{}
+	for _, change := range r.diff.Changes {
+		resolvers = append(resolvers, &SpecFieldChangeResolver{change: change})
+	}
+
+	return resolvers
+}
+
+// AddedBridges resolves to the bridges which the spec uses, but the approved
+// spec does not.
+func (r *JobProposalSpecDiffResolver) AddedBridges() []string {
+	return append([]string{}, r.diff.AddedBridges...)
+}
+
+// RemovedBridges resolves to the bridges which the approved spec uses, but the
+// spec does not.
+func (r *JobProposalSpecDiffResolver) RemovedBridges() []string {
+	return append([]string{}, r.diff.RemovedBridges...)
+}
+
+// OnlyObservationSourceChanged resolves to whether the observationSource is the
+// only changed field, with the same bridges.
+func (r *JobProposalSpecDiffResolver) OnlyObservationSourceChanged() bool {
+	return r.diff.OnlyObservationSourceChanged()
+}
+
+// SpecFieldChangeResolver resolves the Spec Field Change type.
+type SpecFieldChangeResolver struct {
+	change feeds.SpecFieldChange
+}
+
+// Path resolves to the dotted path of the changed field.
+func (r *SpecFieldChangeResolver) Path() string {
+	return r.change.Path
+}
+
+// Type resolves to the type of the change.
+func (r *SpecFieldChangeResolver) Type() SpecChangeType {
+	return ToSpecChangeType(r.change.Type)
+}
+
+// OldValue resolves to the value of the field in the approved spec.
+func (r *SpecFieldChangeResolver) OldValue() *string {
+	return r.change.OldValue.Ptr()
+}
+
+// NewValue resolves to the value of the field in the spec.
+func (r *SpecFieldChangeResolver) NewValue() *string {
+	return r.change.NewValue.Ptr()
+}
+
 // -- ApproveJobProposal Mutation --
 
 // ApproveJobProposalSpecPayloadResolver resolves the spec payload.
@@ -464,3 +464,96 @@ func TestResolver_GetJobProposal_Spec(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_GetJobProposal_SpecDiff(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetJobProposal {
+			jobProposal(id: "1") {
+				... on JobProposal {
+					specs {
+						id
+						diff {
+							changes {
+								path
+								type
+								oldValue
+								newValue
+							}
+							addedBridges
+							removedBridges
+							onlyObservationSourceChanged
+						}
+					}
+				}
+			}
+		}`
+
+	jpID := int64(1)
+	specs := []feeds.JobProposalSpec{
+		{
+			ID:            100,
+			Definition:    "name = 'spec'\nobservationSource = 'ds [type=bridge name=\"a\"];'",
+			Status:        feeds.SpecStatusApproved,
+			JobProposalID: jpID,
+			Version:       1,
+		},
+		{
+			ID:            101,
+			Definition:    "name = 'spec'\nobservationSource = 'ds [type=bridge name=\"b\"];'\nmaxTaskDuration = '10s'",
+			Status:        feeds.SpecStatusPending,
+			JobProposalID: jpID,
+			Version:       2,
+		},
+	}
+	result := `
+		{
+			"jobProposal": {
+				"specs": [{
+					"id": "100",
+					"diff": null
+				}, {
+					"id": "101",
+					"diff": {
+						"changes": [{
+							"path": "maxTaskDuration",
+							"type": "ADDED",
+							"oldValue": null,
+							"newValue": "10s"
+						}, {
+							"path": "observationSource",
+							"type": "MODIFIED",
+							"oldValue": "ds [type=bridge name=\"a\"];",
+							"newValue": "ds [type=bridge name=\"b\"];"
+						}],
+						"addedBridges": ["b"],
+						"removedBridges": ["a"],
+						"onlyObservationSourceChanged": false
+					}
+				}]
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.feedsSvc.On("GetJobProposal", mock.Anything, jpID).Return(&feeds.JobProposal{
+					ID:             jpID,
+					Status:         feeds.JobProposalStatusApproved,
+					FeedsManagerID: 1,
+				}, nil)
+				f.Mocks.feedsSvc.
+					On("ListSpecsByJobProposalIDs", mock.Anything, []int64{jpID}).
+					Return(specs, nil)
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+			},
+			query:  query,
+			result: result,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -267,3 +267,59 @@ func (r *RunJobCannotRunErrorResolver) Code() ErrorCode {
 func (r *RunJobCannotRunErrorResolver) Message() string {
 	return r.message
 }
+
+// -- SimulateJob Mutation --
+
+// SimulatedJobRunResolver resolves a job run which was simulated. It isn't
+// saved, so it has neither an ID nor a job.
+type SimulatedJobRunResolver struct {
+	*JobRunResolver
+}
+
+func NewSimulatedJobRun(run pipeline.Run, app chainlink.Application) *SimulatedJobRunResolver {
+	return &SimulatedJobRunResolver{JobRunResolver: NewJobRun(run, app)}
+}
+
+type SimulateJobPayloadResolver struct {
+	run       *pipeline.Run
+	app       chainlink.Application
+	inputErrs map[string]string
+	NotFoundErrorUnionType
+}
+
+func NewSimulateJobPayload(run *pipeline.Run, app chainlink.Application, inputErrs map[string]string, err error) *SimulateJobPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job not found"}
+
+	return &SimulateJobPayloadResolver{run: run, app: app, inputErrs: inputErrs, NotFoundErrorUnionType: e}
+}
+
+func (r *SimulateJobPayloadResolver) ToSimulateJobSuccess() (*SimulateJobSuccessResolver, bool) {
+	if r.err != nil || r.inputErrs != nil {
+		return nil, false
+	}
+
+	return &SimulateJobSuccessResolver{run: *r.run, app: r.app}, true
+}
+
+func (r *SimulateJobPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs == nil {
+		return nil, false
+	}
+
+	var errs []*InputErrorResolver
+
+	for path, message := range r.inputErrs {
+		errs = append(errs, NewInputError(path, message))
+	}
+
+	return NewInputErrors(errs), true
+}
+
+type SimulateJobSuccessResolver struct {
+	run pipeline.Run
+	app chainlink.Application
+}
+
+func (r *SimulateJobSuccessResolver) JobRun() *SimulatedJobRunResolver {
+	return NewSimulatedJobRun(r.run, r.app)
+}
@@ -399,3 +399,142 @@ func TestResolver_RunJob(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_SimulateJob(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation SimulateJob($input: SimulateJobInput!) {
+			simulateJob(input: $input) {
+				... on SimulateJobSuccess {
+					jobRun {
+						allErrors
+						fatalErrors
+						outputs
+						status
+						taskRuns {
+							dotID
+							type
+							output
+							error
+						}
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	toml := `
+type="webhook"
+schemaVersion=1
+observationSource="""
+ds [type=http method=GET url="https://example.invalid"]
+"""
+`
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"TOML":     toml,
+			"vars":     `{"jobRun": {"requestBody": "{}"}}`,
+			"fixtures": `{"ds": {"value": "1"}}`,
+		},
+	}
+
+	outputs := jsonserializable.JSONSerializable{}
+	err := outputs.UnmarshalJSON([]byte(`["1"]`))
+	require.NoError(t, err)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "simulateJob"),
+		{
+			name:          "success with a TOML spec",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("SimulateJobV2", mock.Anything, mock.MatchedBy(func(jb job.Job) bool {
+					return jb.Type == job.Webhook && jb.PipelineSpec != nil && jb.Pipeline.Source == jb.PipelineSpec.DotDagSource
+				}), map[string]interface{}{
+					"jobRun": map[string]interface{}{"requestBody": "{}"},
+				}, pipeline.Fixtures{"ds": {Value: "1"}}).Return(&pipeline.Run{
+					AllErrors:   pipeline.RunErrors{null.String{}},
+					FatalErrors: pipeline.RunErrors{null.String{}},
+					Outputs:     outputs,
+					State:       pipeline.RunStatusCompleted,
+					PipelineTaskRuns: []pipeline.TaskRun{{
+						Type:   pipeline.TaskTypeHTTP,
+						DotID:  "ds",
+						Output: jsonserializable.JSONSerializable{Val: "1", Valid: true},
+					}},
+				}, pipeline.TaskRunResults{}, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"simulateJob": {
+						"jobRun": {
+							"allErrors": [],
+							"fatalErrors": [],
+							"outputs": ["\"1\""],
+							"status": "COMPLETED",
+							"taskRuns": [{
+								"dotID": "ds",
+								"type": "http",
+								"output": "\"1\"",
+								"error": null
+							}]
+						}
+					}
+				}`,
+		},
+		{
+			name:          "not found job",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindJob", mock.Anything, int32(1)).Return(job.Job{}, sql.ErrNoRows)
+			},
+			query: mutation,
+			variables: map[string]interface{}{
+				"input": map[string]interface{}{"id": "1"},
+			},
+			result: `
+				{
+					"simulateJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}`,
+		},
+		{
+			name:          "input errors",
+			authenticated: true,
+			query:         mutation,
+			variables: map[string]interface{}{
+				"input": map[string]interface{}{
+					"TOML":     toml,
+					"fixtures": `["ds"]`,
+				},
+			},
+			result: `
+				{
+					"simulateJob": {
+						"errors": [{
+							"path": "fixtures",
+							"message": "fixtures must be a JSON object: json: cannot unmarshal array into Go value of type pipeline.Fixtures",
+							"code": "INVALID_INPUT"
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
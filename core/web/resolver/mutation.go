@@ -36,9 +36,11 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
@@ -59,7 +61,7 @@ type createBridgeInput struct {
 
 // CreateBridge creates a new bridge.
 func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBridgeInput }) (*CreateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionBridgesWrite); err != nil {
 		return nil, err
 	}
 
@@ -109,7 +111,7 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 }
 
 func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysCreate); err != nil {
 		return nil, err
 	}
 
@@ -133,7 +135,7 @@ func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolv
 func (r *Resolver) DeleteCSAKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteCSAKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysManage); err != nil {
 		return nil, err
 	}
 
@@ -175,7 +177,7 @@ type createFeedsManagerChainConfigInput struct {
 func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struct {
 	Input *createFeedsManagerChainConfigInput
 }) (*CreateFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionFeedsManagersWrite); err != nil {
 		return nil, err
 	}
 
@@ -256,7 +258,7 @@ func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struc
 func (r *Resolver) DeleteFeedsManagerChainConfig(ctx context.Context, args struct {
 	ID string
 }) (*DeleteFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionFeedsManagersWrite); err != nil {
 		return nil, err
 	}
 
@@ -311,7 +313,7 @@ func (r *Resolver) UpdateFeedsManagerChainConfig(ctx context.Context, args struc
 	ID    string
 	Input *updateFeedsManagerChainConfigInput
 }) (*UpdateFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionFeedsManagersWrite); err != nil {
 		return nil, err
 	}
 
@@ -391,7 +393,7 @@ type createFeedsManagerInput struct {
 func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 	Input *createFeedsManagerInput
 }) (*CreateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionFeedsManagersWrite); err != nil {
 		return nil, err
 	}
 
@@ -444,7 +446,7 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input updateBridgeInput
 }) (*UpdateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionBridgesWrite); err != nil {
 		return nil, err
 	}
 
@@ -512,7 +514,7 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input *updateFeedsManagerInput
 }) (*UpdateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionFeedsManagersWrite); err != nil {
 		return nil, err
 	}
 
@@ -556,8 +558,54 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 	return NewUpdateFeedsManagerPayload(mgr, nil, nil), nil
 }
 
+type updateFeedsManagerAutoApprovalPolicyInput struct {
+	Policy AutoApprovalPolicy
+}
+
+func (r *Resolver) UpdateFeedsManagerAutoApprovalPolicy(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input *updateFeedsManagerAutoApprovalPolicyInput
+}) (*UpdateFeedsManagerPayloadResolver, error) {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionFeedsManagersWrite); err != nil {
+		return nil, err
+	}
+	// specs auto approved by the policy are approved on behalf of the user who set it
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionJobProposalsApprove); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt64(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	feedsService := r.App.GetFeedsService()
+
+	if err = feedsService.UpdateManagerAutoApprovalPolicy(ctx, id, FromAutoApprovalPolicy(args.Input.Policy)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewUpdateFeedsManagerPayload(nil, err, nil), nil
+		}
+
+		return nil, err
+	}
+
+	mgr, err := feedsService.GetManager(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewUpdateFeedsManagerPayload(nil, err, nil), nil
+		}
+
+		return nil, err
+	}
+
+	mgrj, _ := json.Marshal(mgr)
+	r.App.GetAuditLogger().Audit(audit.FeedsManUpdated, map[string]interface{}{"mgrj": mgrj})
+
+	return NewUpdateFeedsManagerPayload(mgr, nil, nil), nil
+}
+
 func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysCreate); err != nil {
 		return nil, err
 	}
 
@@ -577,7 +625,7 @@ func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundleP
 func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 	ID string
 }) (*DeleteOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysManage); err != nil {
 		return nil, err
 	}
 
@@ -596,7 +644,7 @@ func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionBridgesWrite); err != nil {
 		return nil, err
 	}
 
@@ -632,7 +680,7 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysCreate); err != nil {
 		return nil, err
 	}
 
@@ -656,7 +704,7 @@ func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolv
 func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteP2PKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysManage); err != nil {
 		return nil, err
 	}
 
@@ -682,7 +730,7 @@ func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysCreate); err != nil {
 		return nil, err
 	}
 
@@ -704,7 +752,7 @@ func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolv
 func (r *Resolver) DeleteVRFKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteVRFKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysManage); err != nil {
 		return nil, err
 	}
 
@@ -729,7 +777,7 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 	ID    graphql.ID
 	Force *bool
 }) (*ApproveJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionJobProposalsApprove); err != nil {
 		return nil, err
 	}
 
@@ -768,7 +816,7 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*CancelJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionJobProposalsApprove); err != nil {
 		return nil, err
 	}
 
@@ -799,11 +847,42 @@ func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 	return NewCancelJobProposalSpecPayload(spec, err), nil
 }
 
+// RerunWorkflowExecution executes a workflow execution again from the given step. Without a step, an errored or
+// timed out execution is resumed from the steps that did not complete.
+func (r *Resolver) RerunWorkflowExecution(ctx context.Context, args struct {
+	ID   graphql.ID
+	Step *string
+}) (*RerunWorkflowExecutionPayloadResolver, error) {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionJobsRun); err != nil {
+		return nil, err
+	}
+
+	svc := r.App.WorkflowExecutionService()
+	executionID := string(args.ID)
+	var err error
+	if args.Step == nil {
+		err = svc.Redrive(ctx, executionID)
+	} else {
+		err = svc.RerunFromStep(ctx, executionID, *args.Step)
+	}
+	if err != nil {
+		return NewRerunWorkflowExecutionPayload(nil, err), nil
+	}
+
+	execution, err := svc.GetExecution(ctx, executionID)
+	if err != nil {
+		return nil, err
+	}
+
+	r.App.GetAuditLogger().Audit(audit.WorkflowExecutionRerun, map[string]interface{}{"executionID": executionID, "step": args.Step})
+	return NewRerunWorkflowExecutionPayload(&execution, nil), nil
+}
+
 // RejectJobProposalSpec rejects the job proposal spec.
 func (r *Resolver) RejectJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RejectJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionJobProposalsApprove); err != nil {
 		return nil, err
 	}
 
@@ -839,7 +918,7 @@ func (r *Resolver) UpdateJobProposalSpecDefinition(ctx context.Context, args str
 	ID    graphql.ID
 	Input *struct{ Definition string }
 }) (*UpdateJobProposalSpecDefinitionPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionJobProposalsApprove); err != nil {
 		return nil, err
 	}
 
@@ -1004,7 +1083,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 		TOML string
 	}
 }) (*CreateJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionJobsWrite); err != nil {
 		return nil, err
 	}
 
@@ -1076,7 +1155,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionJobsWrite); err != nil {
 		return nil, err
 	}
 
@@ -1110,7 +1189,7 @@ func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DismissJobErrorPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionJobsWrite); err != nil {
 		return nil, err
 	}
 
@@ -1144,7 +1223,7 @@ func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 func (r *Resolver) RunJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RunJobPayloadResolver, error) {
-	if err := authenticateUserCanRun(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionJobsRun); err != nil {
 		return nil, err
 	}
 
@@ -1171,6 +1250,78 @@ func (r *Resolver) RunJob(ctx context.Context, args struct {
 	return NewRunJobPayload(&plnRun, r.App, nil), nil
 }
 
+// SimulateJob executes the pipeline of an existing job, or of a job TOML spec, without side
+// effects and without saving the run.
+func (r *Resolver) SimulateJob(ctx context.Context, args struct {
+	Input struct {
+		ID       *graphql.ID
+		TOML     *string
+		Vars     *string
+		Fixtures *string
+	}
+}) (*SimulateJobPayloadResolver, error) {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionJobsRun); err != nil {
+		return nil, err
+	}
+
+	inputErrs := map[string]string{}
+	var jb job.Job
+	switch {
+	case args.Input.ID != nil && args.Input.TOML != nil:
+		inputErrs["input"] = "only one of id and TOML can be set"
+	case args.Input.ID != nil:
+		jobID, err := stringutils.ToInt32(string(*args.Input.ID))
+		if err != nil {
+			return nil, err
+		}
+
+		jb, err = r.App.JobORM().FindJob(ctx, jobID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return NewSimulateJobPayload(nil, r.App, nil, err), nil
+			}
+
+			return nil, err
+		}
+	case args.Input.TOML != nil:
+		// The http, bridge and ethcall tasks of the spec are executed, so simulating a spec which is not a job
+		// requires the same permission as creating one.
+		if err := authenticateUserHasPermission(ctx, sessions.PermissionJobsWrite); err != nil {
+			return nil, err
+		}
+		var err error
+		jb, err = job.ValidatedSimulationSpec(*args.Input.TOML)
+		if err != nil {
+			inputErrs["TOML spec"] = errors.Wrap(err, "failed to parse TOML").Error()
+		}
+	default:
+		inputErrs["input"] = "either id or TOML must be set"
+	}
+
+	var vars map[string]interface{}
+	if args.Input.Vars != nil {
+		if err := json.Unmarshal([]byte(*args.Input.Vars), &vars); err != nil {
+			inputErrs["vars"] = errors.Wrap(err, "vars must be a JSON object").Error()
+		}
+	}
+	var fixtures pipeline.Fixtures
+	if args.Input.Fixtures != nil {
+		if err := json.Unmarshal([]byte(*args.Input.Fixtures), &fixtures); err != nil {
+			inputErrs["fixtures"] = errors.Wrap(err, "fixtures must be a JSON object").Error()
+		}
+	}
+	if len(inputErrs) > 0 {
+		return NewSimulateJobPayload(nil, r.App, inputErrs, nil), nil
+	}
+
+	run, _, err := r.App.SimulateJobV2(ctx, jb, vars, fixtures)
+	if err != nil {
+		return NewSimulateJobPayload(nil, r.App, map[string]string{"input": err.Error()}, nil), nil
+	}
+
+	return NewSimulateJobPayload(run, r.App, nil, nil), nil
+}
+
 func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 	Level LogLevel
 }) (*SetGlobalLogLevelPayloadResolver, error) {
@@ -1200,7 +1351,7 @@ func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 	ChainType OCR2ChainType
 }) (*CreateOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysCreate); err != nil {
 		return nil, err
 	}
 
@@ -1228,7 +1379,7 @@ func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 func (r *Resolver) DeleteOCR2KeyBundle(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authenticateUserHasPermission(ctx, sessions.PermissionKeysManage); err != nil {
 		return nil, err
 	}
 
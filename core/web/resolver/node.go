@@ -9,14 +9,17 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	evmtoml "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
 
 // NodeResolver resolves the Node type.
 type NodeResolver struct {
-	node   evmtoml.Node
-	status types.NodeStatus
+	node    evmtoml.Node
+	status  types.NodeStatus
+	latency *commonclient.NodeLatency
 }
 
 func NewNode(status types.NodeStatus) (nr *NodeResolver, warn error) {
@@ -37,6 +40,15 @@ func NewNodes(nodes []types.NodeStatus) (resolvers []*NodeResolver, warns error)
 	return
 }
 
+// withLatencies sets the poll latency averages of the running EVM nodes on the resolvers.
+func withLatencies(chains legacyevm.LegacyChainContainer, resolvers ...*NodeResolver) {
+	for _, nr := range resolvers {
+		if latency, ok := legacyevm.NodeLatency(chains, nr.status.ChainID, nr.status.Name); ok {
+			nr.latency = &latency
+		}
+	}
+}
+
 func orZero[P any](s *P) P {
 	if s == nil {
 		var zero P
@@ -86,6 +98,14 @@ func (r *NodeResolver) Order() *int32 {
 	return r.node.Order
 }
 
+// Latency resolves the node's poll latency averages, which are only known for running EVM nodes.
+func (r *NodeResolver) Latency() *NodeLatencyResolver {
+	if r.latency == nil {
+		return nil
+	}
+	return &NodeLatencyResolver{latency: *r.latency}
+}
+
 // Chain resolves the node's chain object field.
 func (r *NodeResolver) Chain(ctx context.Context) (*ChainResolver, error) {
 	chain, err := loader.GetChainByID(ctx, r.status.ChainID)
@@ -96,6 +116,26 @@ func (r *NodeResolver) Chain(ctx context.Context) (*ChainResolver, error) {
 	return NewChain(*chain), nil
 }
 
+// NodeLatencyResolver resolves the NodeLatency type.
+type NodeLatencyResolver struct {
+	latency commonclient.NodeLatency
+}
+
+// RTT resolves the moving average of the poll round-trip time.
+func (r *NodeLatencyResolver) RTT() string {
+	return r.latency.RTT.String()
+}
+
+// ErrorRate resolves the moving average of the poll error rate.
+func (r *NodeLatencyResolver) ErrorRate() float64 {
+	return r.latency.ErrorRate
+}
+
+// Score resolves the latency score used by the LowestLatency selection mode.
+func (r *NodeLatencyResolver) Score() float64 {
+	return r.latency.Score()
+}
+
 // -- Node Query --
 
 type NodePayloadResolver struct {
@@ -3,12 +3,15 @@ package resolver
 import (
 	"context"
 	"testing"
+	"time"
 
 	gqlerrors "github.com/graph-gophers/graphql-go/errors"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/loop"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	chainlinkmocks "github.com/smartcontractkit/chainlink/v2/core/services/chainlink/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/web/testutils"
 )
@@ -76,6 +79,58 @@ func TestResolver_Nodes(t *testing.T) {
 				}
 			}`,
 		},
+		{
+			name:          "success with latency",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.ethClient.On("NodeLatencies").Return(map[string]commonclient.NodeLatency{
+					"node-name": {RTT: 150 * time.Millisecond, ErrorRate: 0.5, Samples: 3},
+				})
+				f.Mocks.chain.On("Client").Return(f.Mocks.ethClient)
+				f.Mocks.legacyEVMChains.On("Get", "1").Return(f.Mocks.chain, nil)
+				f.App.On("GetRelayers").Return(&chainlinkmocks.FakeRelayerChainInteroperators{
+					EVMChains: f.Mocks.legacyEVMChains,
+					Nodes: []types.NodeStatus{
+						{
+							ChainID: "1",
+							Name:    "node-name",
+							Config:  "Name='node-name'\nOrder=11\nHTTPURL='http://some-url'\nWSURL='ws://some-url'",
+							State:   "alive",
+						},
+					},
+				})
+			},
+			query: `
+				query GetNodes {
+					nodes {
+						results {
+							name
+							latency {
+								rtt
+								errorRate
+							}
+						}
+						metadata {
+							total
+						}
+					}
+				}`,
+			result: `
+			{
+				"nodes": {
+					"results": [{
+						"name": "node-name",
+						"latency": {
+							"rtt": "150ms",
+							"errorRate": 0.5
+						}
+					}],
+					"metadata": {
+						"total": 1
+					}
+				}
+			}`,
+		},
 		{
 			name:          "generic error",
 			authenticated: true,
@@ -16,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
 	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 )
 
@@ -260,6 +261,7 @@ func (r *Resolver) Node(ctx context.Context, args struct{ ID graphql.ID }) (*Nod
 				if err2 != nil {
 					return nil, err2
 				}
+				withLatencies(r.App.GetRelayers().LegacyEVMChains(), npr.nr)
 				return npr, nil
 			}
 		}
@@ -363,6 +365,7 @@ func (r *Resolver) Nodes(ctx context.Context, args struct {
 	if warn != nil {
 		r.App.GetLogger().Warnw("Error creating NodesPayloadResolver", "err", warn)
 	}
+	withLatencies(r.App.GetRelayers().LegacyEVMChains(), npr.nrs...)
 	return npr, nil
 }
 
@@ -575,3 +578,86 @@ func (r *Resolver) OCR2KeyBundles(ctx context.Context) (*OCR2KeyBundlesPayloadRe
 
 	return NewOCR2KeyBundlesPayload(ekbs), nil
 }
+
+// WorkflowExecution retrieves a workflow execution along with the inputs and outputs of its steps.
+func (r *Resolver) WorkflowExecution(ctx context.Context, args struct{ ID graphql.ID }) (*WorkflowExecutionPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	execution, err := r.App.WorkflowExecutionService().GetExecution(ctx, string(args.ID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewWorkflowExecutionPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewWorkflowExecutionPayload(&execution, nil), nil
+}
+
+// WorkflowDeadLetters retrieves a paginated list of the executions of all workflows that errored or timed out,
+// along with their steps, most recently finished first.
+func (r *Resolver) WorkflowDeadLetters(ctx context.Context, args struct {
+	Offset *int32
+	Limit  *int32
+}) (*WorkflowExecutionsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	executions, count, err := r.App.WorkflowExecutionService().DeadLetters(ctx, pageOffset(args.Offset), pageLimit(args.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWorkflowExecutionsPayload(executions, int32(count)), nil
+}
+
+// WorkflowExecutions retrieves a paginated list of the executions of a workflow job, most recent first.
+func (r *Resolver) WorkflowExecutions(ctx context.Context, args struct {
+	JobID         graphql.ID
+	Statuses      *[]WorkflowExecutionStatus
+	CreatedAfter  *graphql.Time
+	CreatedBefore *graphql.Time
+	Offset        *int32
+	Limit         *int32
+}) (*WorkflowExecutionsPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	jobID, err := stringutils.ToInt32(string(args.JobID))
+	if err != nil {
+		return nil, err
+	}
+
+	j, err := r.App.JobORM().FindJob(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if j.WorkflowSpec == nil {
+		return nil, fmt.Errorf("job %d is not a workflow job", jobID)
+	}
+
+	filter := store.ExecutionFilter{WorkflowID: j.WorkflowSpec.WorkflowID}
+	if args.Statuses != nil {
+		for _, status := range *args.Statuses {
+			filter.Statuses = append(filter.Statuses, FromWorkflowExecutionStatus(status))
+		}
+	}
+	if args.CreatedAfter != nil {
+		filter.CreatedAfter = &args.CreatedAfter.Time
+	}
+	if args.CreatedBefore != nil {
+		filter.CreatedBefore = &args.CreatedBefore.Time
+	}
+
+	executions, count, err := r.App.WorkflowExecutionService().ListExecutions(ctx, filter, pageOffset(args.Offset), pageLimit(args.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWorkflowExecutionsPayload(executions, int32(count)), nil
+}
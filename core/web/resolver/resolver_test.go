@@ -27,6 +27,7 @@ import (
 	keystoreMocks "github.com/smartcontractkit/chainlink/v2/core/services/keystore/mocks"
 	pipelineMocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
 	webhookmocks "github.com/smartcontractkit/chainlink/v2/core/services/webhook/mocks"
+	workflowsMocks "github.com/smartcontractkit/chainlink/v2/core/services/workflows/mocks"
 	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 	authProviderMocks "github.com/smartcontractkit/chainlink/v2/core/sessions/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
@@ -59,6 +60,7 @@ type mocks struct {
 	balM                 *evmORMMocks.BalanceMonitor
 	txmStore             *evmtxmgrmocks.EvmTxStore
 	auditLogger          *audit.AuditLoggerService
+	workflowExecutionSvc *workflowsMocks.ExecutionService
 }
 
 // gqlTestFramework is a framework wrapper containing the objects needed to run
@@ -114,6 +116,7 @@ func setupFramework(t *testing.T) *gqlTestFramework {
 		balM:                 evmORMMocks.NewBalanceMonitor(t),
 		txmStore:             evmtxmgrmocks.NewEvmTxStore(t),
 		auditLogger:          &audit.AuditLoggerService{},
+		workflowExecutionSvc: workflowsMocks.NewExecutionService(t),
 	}
 
 	lggr := logger.TestLogger(t)
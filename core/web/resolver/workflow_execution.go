@@ -0,0 +1,237 @@
+package resolver
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+type WorkflowExecutionStatus string
+
+const (
+	WorkflowExecutionStatusStarted   WorkflowExecutionStatus = "STARTED"
+	WorkflowExecutionStatusErrored   WorkflowExecutionStatus = "ERRORED"
+	WorkflowExecutionStatusTimeout   WorkflowExecutionStatus = "TIMEOUT"
+	WorkflowExecutionStatusCompleted WorkflowExecutionStatus = "COMPLETED"
+)
+
+// FromWorkflowExecutionStatus converts the GQL status to the status stored by the workflow engine.
+func FromWorkflowExecutionStatus(status WorkflowExecutionStatus) string {
+	return strings.ToLower(string(status))
+}
+
+// ToWorkflowExecutionStatus converts the status stored by the workflow engine to the GQL status.
+func ToWorkflowExecutionStatus(status string) WorkflowExecutionStatus {
+	return WorkflowExecutionStatus(strings.ToUpper(status))
+}
+
+// WorkflowExecutionResolver resolves the workflow execution type.
+type WorkflowExecutionResolver struct {
+	execution store.WorkflowExecution
+}
+
+func NewWorkflowExecution(execution store.WorkflowExecution) *WorkflowExecutionResolver {
+	return &WorkflowExecutionResolver{execution: execution}
+}
+
+func NewWorkflowExecutions(executions []store.WorkflowExecution) []*WorkflowExecutionResolver {
+	var resolvers []*WorkflowExecutionResolver
+
+	for _, execution := range executions {
+		resolvers = append(resolvers, NewWorkflowExecution(execution))
+	}
+
+	return resolvers
+}
+
+func (r *WorkflowExecutionResolver) ID() graphql.ID {
+	return graphql.ID(r.execution.ExecutionID)
+}
+
+func (r *WorkflowExecutionResolver) WorkflowID() string {
+	return r.execution.WorkflowID
+}
+
+func (r *WorkflowExecutionResolver) Status() WorkflowExecutionStatus {
+	return ToWorkflowExecutionStatus(r.execution.Status)
+}
+
+func (r *WorkflowExecutionResolver) CreatedAt() *graphql.Time {
+	return optionalTime(r.execution.CreatedAt)
+}
+
+func (r *WorkflowExecutionResolver) UpdatedAt() *graphql.Time {
+	return optionalTime(r.execution.UpdatedAt)
+}
+
+func (r *WorkflowExecutionResolver) FinishedAt() *graphql.Time {
+	return optionalTime(r.execution.FinishedAt)
+}
+
+// Steps resolves the steps of the execution, ordered by ref.
+func (r *WorkflowExecutionResolver) Steps() []*WorkflowExecutionStepResolver {
+	resolvers := []*WorkflowExecutionStepResolver{}
+	for _, step := range r.execution.Steps {
+		resolvers = append(resolvers, &WorkflowExecutionStepResolver{step: step})
+	}
+	sort.Slice(resolvers, func(i, j int) bool {
+		return resolvers[i].step.Ref < resolvers[j].step.Ref
+	})
+
+	return resolvers
+}
+
+// WorkflowExecutionStepResolver resolves the workflow execution step type.
+type WorkflowExecutionStepResolver struct {
+	step *store.WorkflowExecutionStep
+}
+
+func (r *WorkflowExecutionStepResolver) Ref() string {
+	return r.step.Ref
+}
+
+func (r *WorkflowExecutionStepResolver) Status() WorkflowExecutionStatus {
+	return ToWorkflowExecutionStatus(r.step.Status)
+}
+
+func (r *WorkflowExecutionStepResolver) Attempts() int32 {
+	return int32(r.step.Attempts)
+}
+
+func (r *WorkflowExecutionStepResolver) Inputs() (*string, error) {
+	inputs, err := r.step.InputsJSON()
+	if err != nil || inputs == nil {
+		return nil, err
+	}
+
+	s := string(inputs)
+	return &s, nil
+}
+
+func (r *WorkflowExecutionStepResolver) Outputs() (*string, error) {
+	outputs, err := r.step.OutputsJSON()
+	if err != nil || outputs == nil {
+		return nil, err
+	}
+
+	s := string(outputs)
+	return &s, nil
+}
+
+func (r *WorkflowExecutionStepResolver) Error() *string {
+	if r.step.Outputs.Err == nil {
+		return nil
+	}
+
+	s := r.step.Outputs.Err.Error()
+	return &s
+}
+
+func (r *WorkflowExecutionStepResolver) UpdatedAt() *graphql.Time {
+	return optionalTime(r.step.UpdatedAt)
+}
+
+// -- WorkflowExecution Query --
+
+type WorkflowExecutionPayloadResolver struct {
+	execution *store.WorkflowExecution
+	NotFoundErrorUnionType
+}
+
+func NewWorkflowExecutionPayload(execution *store.WorkflowExecution, err error) *WorkflowExecutionPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "workflow execution not found"}
+
+	return &WorkflowExecutionPayloadResolver{execution: execution, NotFoundErrorUnionType: e}
+}
+
+func (r *WorkflowExecutionPayloadResolver) ToWorkflowExecution() (*WorkflowExecutionResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return NewWorkflowExecution(*r.execution), true
+}
+
+// -- WorkflowExecutions Query --
+
+// WorkflowExecutionsPayloadResolver resolves a page of workflow executions
+type WorkflowExecutionsPayloadResolver struct {
+	executions []store.WorkflowExecution
+	total      int32
+}
+
+func NewWorkflowExecutionsPayload(executions []store.WorkflowExecution, total int32) *WorkflowExecutionsPayloadResolver {
+	return &WorkflowExecutionsPayloadResolver{executions: executions, total: total}
+}
+
+// Results returns the workflow executions.
+func (r *WorkflowExecutionsPayloadResolver) Results() []*WorkflowExecutionResolver {
+	return NewWorkflowExecutions(r.executions)
+}
+
+// Metadata returns the pagination metadata.
+func (r *WorkflowExecutionsPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
+
+// -- RerunWorkflowExecution Mutation --
+
+type RerunWorkflowExecutionPayloadResolver struct {
+	execution *store.WorkflowExecution
+	NotFoundErrorUnionType
+}
+
+func NewRerunWorkflowExecutionPayload(execution *store.WorkflowExecution, err error) *RerunWorkflowExecutionPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "workflow execution not found"}
+
+	return &RerunWorkflowExecutionPayloadResolver{execution: execution, NotFoundErrorUnionType: e}
+}
+
+func (r *RerunWorkflowExecutionPayloadResolver) ToRerunWorkflowExecutionSuccess() (*RerunWorkflowExecutionSuccessResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return &RerunWorkflowExecutionSuccessResolver{execution: *r.execution}, true
+}
+
+func (r *RerunWorkflowExecutionPayloadResolver) ToRerunWorkflowExecutionError() (*RerunWorkflowExecutionErrorResolver, bool) {
+	if r.err == nil || isNotFoundError(r.err) {
+		return nil, false
+	}
+
+	return &RerunWorkflowExecutionErrorResolver{message: r.err.Error(), code: ErrorCodeUnprocessable}, true
+}
+
+type RerunWorkflowExecutionSuccessResolver struct {
+	execution store.WorkflowExecution
+}
+
+func (r *RerunWorkflowExecutionSuccessResolver) WorkflowExecution() *WorkflowExecutionResolver {
+	return NewWorkflowExecution(r.execution)
+}
+
+type RerunWorkflowExecutionErrorResolver struct {
+	message string
+	code    ErrorCode
+}
+
+func (r *RerunWorkflowExecutionErrorResolver) Message() string {
+	return r.message
+}
+
+func (r *RerunWorkflowExecutionErrorResolver) Code() ErrorCode {
+	return r.code
+}
+
+func optionalTime(t *time.Time) *graphql.Time {
+	if t == nil {
+		return nil
+	}
+
+	return &graphql.Time{Time: *t}
+}
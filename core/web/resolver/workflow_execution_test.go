@@ -0,0 +1,407 @@
+package resolver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+func newTestWorkflowExecution(t *testing.T, f *gqlTestFramework) store.WorkflowExecution {
+	inputs, err := values.NewMap(map[string]any{"feedID": "0x1111"})
+	require.NoError(t, err)
+	outputs, err := values.Wrap(map[string]any{"price": 100})
+	require.NoError(t, err)
+
+	ts := f.Timestamp()
+	return store.WorkflowExecution{
+		ExecutionID: "exec-1",
+		WorkflowID:  "workflow-1",
+		Status:      store.StatusErrored,
+		CreatedAt:   &ts,
+		UpdatedAt:   &ts,
+		FinishedAt:  &ts,
+		Steps: map[string]*store.WorkflowExecutionStep{
+			"trigger": {
+				ExecutionID: "exec-1",
+				Ref:         "trigger",
+				Status:      store.StatusCompleted,
+				Inputs:      inputs,
+				Outputs:     store.StepOutput{Value: outputs},
+				Attempts:    1,
+				UpdatedAt:   &ts,
+			},
+			"consensus": {
+				ExecutionID: "exec-1",
+				Ref:         "consensus",
+				Status:      store.StatusErrored,
+				Outputs:     store.StepOutput{Err: errors.New("no quorum")},
+				Attempts:    3,
+				UpdatedAt:   &ts,
+			},
+		},
+	}
+}
+
+func TestResolver_WorkflowExecutions(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetWorkflowExecutions($jobID: ID!, $statuses: [WorkflowExecutionStatus!], $createdAfter: Time) {
+			workflowExecutions(jobID: $jobID, statuses: $statuses, createdAfter: $createdAfter, offset: 1, limit: 10) {
+				results {
+					id
+					workflowID
+					status
+					createdAt
+					finishedAt
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"jobID":        "1",
+		"statuses":     []string{"ERRORED", "TIMEOUT"},
+		"createdAfter": "2021-01-01T00:00:00Z",
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "workflowExecutions"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				execution := newTestWorkflowExecution(t, f)
+				execution.Steps = map[string]*store.WorkflowExecutionStep{}
+
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindJob", mock.Anything, int32(1)).Return(job.Job{
+					ID:           1,
+					Type:         job.Workflow,
+					WorkflowSpec: &job.WorkflowSpec{WorkflowID: "workflow-1"},
+				}, nil)
+				f.App.On("WorkflowExecutionService").Return(f.Mocks.workflowExecutionSvc)
+				f.Mocks.workflowExecutionSvc.On("ListExecutions", mock.Anything, mock.MatchedBy(func(filter store.ExecutionFilter) bool {
+					return filter.WorkflowID == "workflow-1" &&
+						len(filter.Statuses) == 2 && filter.Statuses[0] == store.StatusErrored && filter.Statuses[1] == store.StatusTimeout &&
+						filter.CreatedAfter != nil && filter.CreatedAfter.Equal(f.Timestamp()) &&
+						filter.CreatedBefore == nil
+				}), 1, 10).Return([]store.WorkflowExecution{execution}, 2, nil)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"workflowExecutions": {
+						"results": [{
+							"id": "exec-1",
+							"workflowID": "workflow-1",
+							"status": "ERRORED",
+							"createdAt": "2021-01-01T00:00:00Z",
+							"finishedAt": "2021-01-01T00:00:00Z"
+						}],
+						"metadata": {
+							"total": 2
+						}
+					}
+				}`,
+		},
+		{
+			name:          "not a workflow job",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindJob", mock.Anything, int32(1)).Return(job.Job{
+					ID:   1,
+					Type: job.Cron,
+				}, nil)
+			},
+			query:     query,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: errors.New("job 1 is not a workflow job"),
+					Path:          []interface{}{"workflowExecutions"},
+					Message:       "job 1 is not a workflow job",
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_WorkflowDeadLetters(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetWorkflowDeadLetters {
+			workflowDeadLetters(offset: 1, limit: 10) {
+				results {
+					id
+					status
+					steps {
+						ref
+						status
+						attempts
+						error
+					}
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "workflowDeadLetters"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				execution := newTestWorkflowExecution(t, f)
+				delete(execution.Steps, "trigger")
+
+				f.App.On("WorkflowExecutionService").Return(f.Mocks.workflowExecutionSvc)
+				f.Mocks.workflowExecutionSvc.On("DeadLetters", mock.Anything, 1, 10).Return([]store.WorkflowExecution{execution}, 2, nil)
+			},
+			query: query,
+			result: `
+				{
+					"workflowDeadLetters": {
+						"results": [{
+							"id": "exec-1",
+							"status": "ERRORED",
+							"steps": [{
+								"ref": "consensus",
+								"status": "ERRORED",
+								"attempts": 3,
+								"error": "no quorum"
+							}]
+						}],
+						"metadata": {
+							"total": 2
+						}
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_WorkflowExecution(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetWorkflowExecution($id: ID!) {
+			workflowExecution(id: $id) {
+				... on WorkflowExecution {
+					id
+					status
+					steps {
+						ref
+						status
+						attempts
+						inputs
+						outputs
+						error
+						updatedAt
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "exec-1",
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "workflowExecution"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("WorkflowExecutionService").Return(f.Mocks.workflowExecutionSvc)
+				f.Mocks.workflowExecutionSvc.On("GetExecution", mock.Anything, "exec-1").Return(newTestWorkflowExecution(t, f), nil)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"workflowExecution": {
+						"id": "exec-1",
+						"status": "ERRORED",
+						"steps": [{
+							"ref": "consensus",
+							"status": "ERRORED",
+							"attempts": 3,
+							"inputs": null,
+							"outputs": null,
+							"error": "no quorum",
+							"updatedAt": "2021-01-01T00:00:00Z"
+						}, {
+							"ref": "trigger",
+							"status": "COMPLETED",
+							"attempts": 1,
+							"inputs": "{\"feedID\":\"0x1111\"}",
+							"outputs": "{\"price\":100}",
+							"error": null,
+							"updatedAt": "2021-01-01T00:00:00Z"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("WorkflowExecutionService").Return(f.Mocks.workflowExecutionSvc)
+				f.Mocks.workflowExecutionSvc.On("GetExecution", mock.Anything, "exec-1").Return(store.WorkflowExecution{}, sql.ErrNoRows)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"workflowExecution": {
+						"code": "NOT_FOUND",
+						"message": "workflow execution not found"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_RerunWorkflowExecution(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation RerunWorkflowExecution($id: ID!, $step: String) {
+			rerunWorkflowExecution(id: $id, step: $step) {
+				... on RerunWorkflowExecutionSuccess {
+					workflowExecution {
+						id
+						status
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+				... on RerunWorkflowExecutionError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id":   "exec-1",
+		"step": "consensus",
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "rerunWorkflowExecution"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				execution := newTestWorkflowExecution(t, f)
+				execution.Status = store.StatusStarted
+
+				f.App.On("WorkflowExecutionService").Return(f.Mocks.workflowExecutionSvc)
+				f.Mocks.workflowExecutionSvc.On("RerunFromStep", mock.Anything, "exec-1", "consensus").Return(nil)
+				f.Mocks.workflowExecutionSvc.On("GetExecution", mock.Anything, "exec-1").Return(execution, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"rerunWorkflowExecution": {
+						"workflowExecution": {
+							"id": "exec-1",
+							"status": "STARTED"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "success without a step redrives the execution",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				execution := newTestWorkflowExecution(t, f)
+				execution.Status = store.StatusStarted
+
+				f.App.On("WorkflowExecutionService").Return(f.Mocks.workflowExecutionSvc)
+				f.Mocks.workflowExecutionSvc.On("Redrive", mock.Anything, "exec-1").Return(nil)
+				f.Mocks.workflowExecutionSvc.On("GetExecution", mock.Anything, "exec-1").Return(execution, nil)
+			},
+			query:     mutation,
+			variables: map[string]interface{}{"id": "exec-1"},
+			result: `
+				{
+					"rerunWorkflowExecution": {
+						"workflowExecution": {
+							"id": "exec-1",
+							"status": "STARTED"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("WorkflowExecutionService").Return(f.Mocks.workflowExecutionSvc)
+				f.Mocks.workflowExecutionSvc.On("RerunFromStep", mock.Anything, "exec-1", "consensus").
+					Return(fmt.Errorf("failed to get execution exec-1: %w", sql.ErrNoRows))
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"rerunWorkflowExecution": {
+						"code": "NOT_FOUND",
+						"message": "workflow execution not found"
+					}
+				}`,
+		},
+		{
+			name:          "cannot rerun",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("WorkflowExecutionService").Return(f.Mocks.workflowExecutionSvc)
+				f.Mocks.workflowExecutionSvc.On("RerunFromStep", mock.Anything, "exec-1", "consensus").
+					Return(errors.New("cannot rerun execution exec-1: it is still running"))
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"rerunWorkflowExecution": {
+						"code": "UNPROCESSABLE",
+						"message": "cannot rerun execution exec-1: it is still running"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
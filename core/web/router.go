@@ -38,6 +38,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 	"github.com/smartcontractkit/chainlink/v2/core/web/resolver"
@@ -211,6 +212,9 @@ func sessionRoutes(app chainlink.Application, r *gin.RouterGroup) {
 	))
 	sc := NewSessionsController(app)
 	unauth.POST("/sessions", sc.Create)
+	oc := OIDCController{app}
+	unauth.GET("/oidc/login", oc.Login)
+	unauth.GET("/oidc/callback", oc.Callback)
 	auth := r.Group("/", auth.Authenticate(app.AuthenticationProvider(), auth.AuthenticateBySession))
 	auth.DELETE("/sessions", sc.Destroy)
 }
@@ -246,26 +250,33 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/users", auth.RequiresAdminRole(uc.Index))
 		authv2.POST("/users", auth.RequiresAdminRole(uc.Create))
 		authv2.PATCH("/users", auth.RequiresAdminRole(uc.UpdateRole))
+		authv2.PATCH("/users/custom_role", auth.RequiresAdminRole(uc.UpdateCustomRole))
 		authv2.DELETE("/users/:email", auth.RequiresAdminRole(uc.Delete))
 		authv2.PATCH("/user/password", uc.UpdatePassword)
 		authv2.POST("/user/token", uc.NewAPIToken)
 		authv2.POST("/user/token/delete", uc.DeleteAPIToken)
 
+		crc := CustomRolesController{app}
+		authv2.GET("/custom_roles", auth.RequiresAdminRole(crc.Index))
+		authv2.POST("/custom_roles", auth.RequiresAdminRole(crc.Create))
+		authv2.PATCH("/custom_roles/:name", auth.RequiresAdminRole(crc.Update))
+		authv2.DELETE("/custom_roles/:name", auth.RequiresAdminRole(crc.Delete))
+
 		wa := NewWebAuthnController(app)
 		authv2.GET("/enroll_webauthn", wa.BeginRegistration)
 		authv2.POST("/enroll_webauthn", wa.FinishRegistration)
 
 		eia := ExternalInitiatorsController{app}
 		authv2.GET("/external_initiators", paginatedRequest(eia.Index))
-		authv2.POST("/external_initiators", auth.RequiresEditRole(eia.Create))
-		authv2.DELETE("/external_initiators/:Name", auth.RequiresEditRole(eia.Destroy))
+		authv2.POST("/external_initiators", auth.RequiresPermission(clsessions.PermissionExternalInitiatorsWrite, eia.Create))
+		authv2.DELETE("/external_initiators/:Name", auth.RequiresPermission(clsessions.PermissionExternalInitiatorsWrite, eia.Destroy))
 
 		bt := BridgeTypesController{app}
 		authv2.GET("/bridge_types", paginatedRequest(bt.Index))
-		authv2.POST("/bridge_types", auth.RequiresEditRole(bt.Create))
+		authv2.POST("/bridge_types", auth.RequiresPermission(clsessions.PermissionBridgesWrite, bt.Create))
 		authv2.GET("/bridge_types/:BridgeName", bt.Show)
-		authv2.PATCH("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Update))
-		authv2.DELETE("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Destroy))
+		authv2.PATCH("/bridge_types/:BridgeName", auth.RequiresPermission(clsessions.PermissionBridgesWrite, bt.Update))
+		authv2.DELETE("/bridge_types/:BridgeName", auth.RequiresPermission(clsessions.PermissionBridgesWrite, bt.Destroy))
 
 		ets := EVMTransfersController{app}
 		authv2.POST("/transfers", auth.RequiresAdminRole(ets.Create))
@@ -296,16 +307,16 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		csakc := CSAKeysController{app}
 		authv2.GET("/keys/csa", csakc.Index)
-		authv2.POST("/keys/csa", auth.RequiresEditRole(csakc.Create))
-		authv2.POST("/keys/csa/import", auth.RequiresAdminRole(csakc.Import))
-		authv2.POST("/keys/csa/export/:ID", auth.RequiresAdminRole(csakc.Export))
+		authv2.POST("/keys/csa", auth.RequiresPermission(clsessions.PermissionKeysCreate, csakc.Create))
+		authv2.POST("/keys/csa/import", auth.RequiresPermission(clsessions.PermissionKeysManage, csakc.Import))
+		authv2.POST("/keys/csa/export/:ID", auth.RequiresPermission(clsessions.PermissionKeysManage, csakc.Export))
 
 		ekc := NewETHKeysController(app)
 		authv2.GET("/keys/eth", ekc.Index)
-		authv2.POST("/keys/eth", auth.RequiresEditRole(ekc.Create))
-		authv2.DELETE("/keys/eth/:keyID", auth.RequiresAdminRole(ekc.Delete))
-		authv2.POST("/keys/eth/import", auth.RequiresAdminRole(ekc.Import))
-		authv2.POST("/keys/eth/export/:address", auth.RequiresAdminRole(ekc.Export))
+		authv2.POST("/keys/eth", auth.RequiresPermission(clsessions.PermissionKeysCreate, ekc.Create))
+		authv2.DELETE("/keys/eth/:keyID", auth.RequiresPermission(clsessions.PermissionKeysManage, ekc.Delete))
+		authv2.POST("/keys/eth/import", auth.RequiresPermission(clsessions.PermissionKeysManage, ekc.Import))
+		authv2.POST("/keys/eth/export/:address", auth.RequiresPermission(clsessions.PermissionKeysManage, ekc.Export))
 		// duplicated from above, with `evm` instead of `eth`
 		// legacy ones remain for backwards compatibility
 
@@ -316,32 +327,33 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		ethKeysGroup.Use(ekc.formatETHKeyResponse())
 		authv2.GET("/keys/evm", ekc.Index)
-		ethKeysGroup.POST("/keys/evm", auth.RequiresEditRole(ekc.Create))
-		ethKeysGroup.DELETE("/keys/evm/:address", auth.RequiresAdminRole(ekc.Delete))
-		ethKeysGroup.POST("/keys/evm/import", auth.RequiresAdminRole(ekc.Import))
-		authv2.POST("/keys/evm/export/:address", auth.RequiresAdminRole(ekc.Export))
-		ethKeysGroup.POST("/keys/evm/chain", auth.RequiresAdminRole(ekc.Chain))
+		ethKeysGroup.POST("/keys/evm", auth.RequiresPermission(clsessions.PermissionKeysCreate, ekc.Create))
+		ethKeysGroup.DELETE("/keys/evm/:address", auth.RequiresPermission(clsessions.PermissionKeysManage, ekc.Delete))
+		ethKeysGroup.POST("/keys/evm/import", auth.RequiresPermission(clsessions.PermissionKeysManage, ekc.Import))
+		ethKeysGroup.POST("/keys/evm/import_remote", auth.RequiresPermission(clsessions.PermissionKeysManage, ekc.ImportRemote))
+		authv2.POST("/keys/evm/export/:address", auth.RequiresPermission(clsessions.PermissionKeysManage, ekc.Export))
+		ethKeysGroup.POST("/keys/evm/chain", auth.RequiresPermission(clsessions.PermissionKeysManage, ekc.Chain))
 
 		ocrkc := OCRKeysController{app}
 		authv2.GET("/keys/ocr", ocrkc.Index)
-		authv2.POST("/keys/ocr", auth.RequiresEditRole(ocrkc.Create))
-		authv2.DELETE("/keys/ocr/:keyID", auth.RequiresAdminRole(ocrkc.Delete))
-		authv2.POST("/keys/ocr/import", auth.RequiresAdminRole(ocrkc.Import))
-		authv2.POST("/keys/ocr/export/:ID", auth.RequiresAdminRole(ocrkc.Export))
+		authv2.POST("/keys/ocr", auth.RequiresPermission(clsessions.PermissionKeysCreate, ocrkc.Create))
+		authv2.DELETE("/keys/ocr/:keyID", auth.RequiresPermission(clsessions.PermissionKeysManage, ocrkc.Delete))
+		authv2.POST("/keys/ocr/import", auth.RequiresPermission(clsessions.PermissionKeysManage, ocrkc.Import))
+		authv2.POST("/keys/ocr/export/:ID", auth.RequiresPermission(clsessions.PermissionKeysManage, ocrkc.Export))
 
 		ocr2kc := OCR2KeysController{app}
 		authv2.GET("/keys/ocr2", ocr2kc.Index)
-		authv2.POST("/keys/ocr2/:chainType", auth.RequiresEditRole(ocr2kc.Create))
-		authv2.DELETE("/keys/ocr2/:keyID", auth.RequiresAdminRole(ocr2kc.Delete))
-		authv2.POST("/keys/ocr2/import", auth.RequiresAdminRole(ocr2kc.Import))
-		authv2.POST("/keys/ocr2/export/:ID", auth.RequiresAdminRole(ocr2kc.Export))
+		authv2.POST("/keys/ocr2/:chainType", auth.RequiresPermission(clsessions.PermissionKeysCreate, ocr2kc.Create))
+		authv2.DELETE("/keys/ocr2/:keyID", auth.RequiresPermission(clsessions.PermissionKeysManage, ocr2kc.Delete))
+		authv2.POST("/keys/ocr2/import", auth.RequiresPermission(clsessions.PermissionKeysManage, ocr2kc.Import))
+		authv2.POST("/keys/ocr2/export/:ID", auth.RequiresPermission(clsessions.PermissionKeysManage, ocr2kc.Export))
 
 		p2pkc := P2PKeysController{app}
 		authv2.GET("/keys/p2p", p2pkc.Index)
-		authv2.POST("/keys/p2p", auth.RequiresEditRole(p2pkc.Create))
-		authv2.DELETE("/keys/p2p/:keyID", auth.RequiresAdminRole(p2pkc.Delete))
-		authv2.POST("/keys/p2p/import", auth.RequiresAdminRole(p2pkc.Import))
-		authv2.POST("/keys/p2p/export/:ID", auth.RequiresAdminRole(p2pkc.Export))
+		authv2.POST("/keys/p2p", auth.RequiresPermission(clsessions.PermissionKeysCreate, p2pkc.Create))
+		authv2.DELETE("/keys/p2p/:keyID", auth.RequiresPermission(clsessions.PermissionKeysManage, p2pkc.Delete))
+		authv2.POST("/keys/p2p/import", auth.RequiresPermission(clsessions.PermissionKeysManage, p2pkc.Import))
+		authv2.POST("/keys/p2p/export/:ID", auth.RequiresPermission(clsessions.PermissionKeysManage, p2pkc.Export))
 
 		for _, keys := range []struct {
 			path string
@@ -354,37 +366,48 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 			{"dkgencrypt", NewDKGEncryptKeysController(app)},
 		} {
 			authv2.GET("/keys/"+keys.path, keys.kc.Index)
-			authv2.POST("/keys/"+keys.path, auth.RequiresEditRole(keys.kc.Create))
-			authv2.DELETE("/keys/"+keys.path+"/:keyID", auth.RequiresAdminRole(keys.kc.Delete))
-			authv2.POST("/keys/"+keys.path+"/import", auth.RequiresAdminRole(keys.kc.Import))
-			authv2.POST("/keys/"+keys.path+"/export/:ID", auth.RequiresAdminRole(keys.kc.Export))
+			authv2.POST("/keys/"+keys.path, auth.RequiresPermission(clsessions.PermissionKeysCreate, keys.kc.Create))
+			authv2.DELETE("/keys/"+keys.path+"/:keyID", auth.RequiresPermission(clsessions.PermissionKeysManage, keys.kc.Delete))
+			authv2.POST("/keys/"+keys.path+"/import", auth.RequiresPermission(clsessions.PermissionKeysManage, keys.kc.Import))
+			authv2.POST("/keys/"+keys.path+"/export/:ID", auth.RequiresPermission(clsessions.PermissionKeysManage, keys.kc.Export))
 		}
 
 		vrfkc := VRFKeysController{app}
 		authv2.GET("/keys/vrf", vrfkc.Index)
-		authv2.POST("/keys/vrf", auth.RequiresEditRole(vrfkc.Create))
-		authv2.DELETE("/keys/vrf/:keyID", auth.RequiresAdminRole(vrfkc.Delete))
-		authv2.POST("/keys/vrf/import", auth.RequiresAdminRole(vrfkc.Import))
-		authv2.POST("/keys/vrf/export/:keyID", auth.RequiresAdminRole(vrfkc.Export))
+		authv2.POST("/keys/vrf", auth.RequiresPermission(clsessions.PermissionKeysCreate, vrfkc.Create))
+		authv2.DELETE("/keys/vrf/:keyID", auth.RequiresPermission(clsessions.PermissionKeysManage, vrfkc.Delete))
+		authv2.POST("/keys/vrf/import", auth.RequiresPermission(clsessions.PermissionKeysManage, vrfkc.Import))
+		authv2.POST("/keys/vrf/export/:keyID", auth.RequiresPermission(clsessions.PermissionKeysManage, vrfkc.Export))
+
+		kbc := KeystoreBackupController{app}
+		authv2.POST("/keys/backup", auth.RequiresPermission(clsessions.PermissionKeysManage, kbc.Backup))
+		authv2.POST("/keys/restore", auth.RequiresPermission(clsessions.PermissionKeysManage, kbc.Restore))
 
 		jc := JobsController{app}
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
-		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
-		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
-		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
+		authv2.POST("/jobs", auth.RequiresPermission(clsessions.PermissionJobsWrite, jc.Create))
+		authv2.POST("/jobs/simulate", auth.RequiresPermission(clsessions.PermissionJobsRun, jc.Simulate))
+		authv2.PUT("/jobs/:ID", auth.RequiresPermission(clsessions.PermissionJobsWrite, jc.Update))
+		authv2.DELETE("/jobs/:ID", auth.RequiresPermission(clsessions.PermissionJobsWrite, jc.Delete))
 
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
 
+		wec := WorkflowExecutionsController{app}
+		authv2.GET("/jobs/:ID/executions", paginatedRequest(wec.Index))
+		authv2.GET("/workflow_executions/dead_letters", paginatedRequest(wec.DeadLetters))
+		authv2.GET("/jobs/:ID/executions/:executionID", wec.Show)
+		authv2.POST("/jobs/:ID/executions/:executionID/rerun", auth.RequiresPermission(clsessions.PermissionJobsRun, wec.Rerun))
+
 		// FeaturesController
 		fc := FeaturesController{app}
 		authv2.GET("/features", fc.Index)
 
 		// PipelineJobSpecErrorsController
-		authv2.DELETE("/pipeline/job_spec_errors/:ID", auth.RequiresEditRole(psec.Destroy))
+		authv2.DELETE("/pipeline/job_spec_errors/:ID", auth.RequiresPermission(clsessions.PermissionJobsWrite, psec.Destroy))
 
 		lgc := LogController{app}
 		authv2.GET("/log", lgc.Get)
@@ -424,8 +447,8 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		efc := EVMForwardersController{app}
 		authv2.GET("/nodes/evm/forwarders", paginatedRequest(efc.Index))
-		authv2.POST("/nodes/evm/forwarders/track", auth.RequiresEditRole(efc.Track))
-		authv2.DELETE("/nodes/evm/forwarders/:fwdID", auth.RequiresEditRole(efc.Delete))
+		authv2.POST("/nodes/evm/forwarders/track", auth.RequiresPermission(clsessions.PermissionForwardersWrite, efc.Track))
+		authv2.DELETE("/nodes/evm/forwarders/:fwdID", auth.RequiresPermission(clsessions.PermissionForwardersWrite, efc.Delete))
 
 		buildInfo := BuildInfoController{app}
 		authv2.GET("/build_info", buildInfo.Show)
@@ -441,7 +464,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		auth.AuthenticateBySession,
 	))
 	userOrEI.GET("/ping", ping.Show)
-	userOrEI.POST("/jobs/:ID/runs", auth.RequiresRunRole(prc.Create))
+	userOrEI.POST("/jobs/:ID/runs", auth.RequiresPermission(clsessions.PermissionJobsRun, prc.Create))
 }
 
 // This is higher because it serves main.js and any static images. There are
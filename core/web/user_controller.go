@@ -1,6 +1,7 @@
 package web
 
 import (
+	"database/sql"
 	"net/http"
 	"strings"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgconn"
 	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
@@ -158,6 +160,73 @@ func (u *UserController) UpdateRole(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewUserResource(user), "user")
 }
 
+// UpdateCustomRole assigns a custom role to a specified API user, or to their
+// API token. An empty custom role unassigns it.
+func (u *UserController) UpdateCustomRole(c *gin.Context) {
+	ctx := c.Request.Context()
+	type updateCustomRoleRequest struct {
+		Email      string `json:"email"`
+		CustomRole string `json:"customRole"`
+		APIToken   bool   `json:"apiToken"`
+	}
+
+	var request updateCustomRoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	// Don't allow current admin user to edit self
+	sessionUser, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return
+	}
+	if strings.EqualFold(sessionUser.Email, request.Email) {
+		jsonAPIError(c, http.StatusBadRequest, errors.New("can not change state or permissions of current admin user"))
+		return
+	}
+	if request.Email == "" {
+		jsonAPIError(c, http.StatusBadRequest, errors.New("email flag is empty, must specify an email"))
+		return
+	}
+
+	customRole := null.NewString(request.CustomRole, request.CustomRole != "")
+	provider := u.App.AuthenticationProvider()
+	var user clsession.User
+	var err error
+	if request.APIToken {
+		user, err = provider.SetAPITokenCustomRole(ctx, request.Email, customRole)
+	} else {
+		user, err = provider.SetCustomRole(ctx, request.Email, customRole)
+	}
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			jsonAPIError(c, http.StatusBadRequest, errors.Errorf("custom role %s does not exist", request.CustomRole))
+			return
+		}
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusBadRequest, errors.Errorf("specified user not found: %s", request.Email))
+			return
+		}
+		if errors.Is(err, clsession.ErrNotSupported) {
+			jsonAPIError(c, http.StatusBadRequest, errUnsupportedForAuth)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, errors.Wrap(err, "error updating API user"))
+		return
+	}
+
+	u.App.GetAuditLogger().Audit(audit.CustomRoleAssigned, map[string]interface{}{
+		"user":       user.Email,
+		"customRole": request.CustomRole,
+		"apiToken":   request.APIToken,
+	})
+
+	jsonAPIResponse(c, presenters.NewUserResource(user), "user")
+}
+
 // Delete deletes an API user and any sessions by email
 func (u *UserController) Delete(c *gin.Context) {
 	ctx := c.Request.Context()
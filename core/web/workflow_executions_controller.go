@@ -0,0 +1,183 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// WorkflowExecutionsController manages the executions of workflow jobs.
+type WorkflowExecutionsController struct {
+	App chainlink.Application
+}
+
+// Index returns the executions of a workflow job, most recent first.
+// They can be filtered by a comma separated list of statuses and by the
+// RFC3339 time range [from, to) of their creation.
+// Example:
+// "GET <application>/jobs/:ID/executions?status=errored,timeout&from=2024-06-01T00:00:00Z"
+func (wec *WorkflowExecutionsController) Index(c *gin.Context, size, page, offset int) {
+	jb, ok := wec.findWorkflowJob(c)
+	if !ok {
+		return
+	}
+
+	filter := store.ExecutionFilter{WorkflowID: jb.WorkflowSpec.WorkflowID}
+	if status := c.Query("status"); status != "" {
+		filter.Statuses = strings.Split(status, ",")
+		for _, s := range filter.Statuses {
+			if !slices.Contains(store.Statuses, s) {
+				jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid status %q, must be one of: %s", s, strings.Join(store.Statuses, ", ")))
+				return
+			}
+		}
+	}
+	var err error
+	if filter.CreatedAfter, err = parseTimeParam(c, "from"); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if filter.CreatedBefore, err = parseTimeParam(c, "to"); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	executions, count, err := wec.App.WorkflowExecutionService().ListExecutions(c.Request.Context(), filter, offset, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := presenters.NewWorkflowExecutionResources(executions, wec.App.GetLogger())
+	paginatedResponse(c, "workflowExecution", size, page, res, count, err)
+}
+
+// DeadLetters returns the executions of all workflows that errored or timed out, along with their steps,
+// most recently finished first.
+// Example:
+// "GET <application>/workflow_executions/dead_letters"
+func (wec *WorkflowExecutionsController) DeadLetters(c *gin.Context, size, page, offset int) {
+	executions, count, err := wec.App.WorkflowExecutionService().DeadLetters(c.Request.Context(), offset, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := presenters.NewWorkflowExecutionResources(executions, wec.App.GetLogger())
+	paginatedResponse(c, "workflowExecution", size, page, res, count, err)
+}
+
+// Show returns an execution of a workflow job along with the inputs and outputs of its steps.
+// Example:
+// "GET <application>/jobs/:ID/executions/:executionID"
+func (wec *WorkflowExecutionsController) Show(c *gin.Context) {
+	execution, ok := wec.findExecution(c)
+	if !ok {
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewWorkflowExecutionResource(execution, wec.App.GetLogger()), "workflowExecution")
+}
+
+// Rerun executes an execution of a workflow job again from the given step.
+// Without a step, an errored or timed out execution is resumed from the steps that did not complete.
+// Example:
+// "POST <application>/jobs/:ID/executions/:executionID/rerun?step=consensus"
+func (wec *WorkflowExecutionsController) Rerun(c *gin.Context) {
+	ctx := c.Request.Context()
+	execution, ok := wec.findExecution(c)
+	if !ok {
+		return
+	}
+
+	svc := wec.App.WorkflowExecutionService()
+	step := c.Query("step")
+	var err error
+	if step == "" {
+		err = svc.Redrive(ctx, execution.ExecutionID)
+	} else {
+		err = svc.RerunFromStep(ctx, execution.ExecutionID, step)
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	execution, err = svc.GetExecution(ctx, execution.ExecutionID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	wec.App.GetAuditLogger().Audit(audit.WorkflowExecutionRerun, map[string]interface{}{"executionID": execution.ExecutionID, "step": step})
+	jsonAPIResponse(c, presenters.NewWorkflowExecutionResource(execution, wec.App.GetLogger()), "workflowExecution")
+}
+
+// findWorkflowJob returns the workflow job of the request, or writes the error response.
+func (wec *WorkflowExecutionsController) findWorkflowJob(c *gin.Context) (job.Job, bool) {
+	jb := job.Job{}
+	if err := jb.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return jb, false
+	}
+
+	jb, err := wec.App.JobORM().FindJob(c.Request.Context(), jb.ID)
+	if err != nil {
+		if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return jb, false
+	}
+	if jb.WorkflowSpec == nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("job %d is not a workflow job", jb.ID))
+		return jb, false
+	}
+
+	return jb, true
+}
+
+// findExecution returns the execution of the request, or writes the error response.
+func (wec *WorkflowExecutionsController) findExecution(c *gin.Context) (store.WorkflowExecution, bool) {
+	jb, ok := wec.findWorkflowJob(c)
+	if !ok {
+		return store.WorkflowExecution{}, false
+	}
+
+	execution, err := wec.App.WorkflowExecutionService().GetExecution(c.Request.Context(), c.Param("executionID"))
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return execution, false
+	}
+	if err != nil || execution.WorkflowID != jb.WorkflowSpec.WorkflowID {
+		jsonAPIError(c, http.StatusNotFound, errors.New("workflow execution not found"))
+		return execution, false
+	}
+
+	return execution, true
+}
+
+// parseTimeParam parses the optional RFC3339 query param of the request.
+func parseTimeParam(c *gin.Context, param string) (*time.Time, error) {
+	value := c.Query(param)
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s param", param)
+	}
+	return &t, nil
+}
@@ -0,0 +1,168 @@
+package web_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestWorkflowExecutionsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app, client, jobID := setupWorkflowExecutionsControllerTest(t)
+	mustAddWorkflowExecution(t, app, "execution-completed", store.StatusCompleted)
+	mustAddWorkflowExecution(t, app, "execution-errored", store.StatusErrored)
+
+	t.Run("lists all executions", func(t *testing.T) {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/executions", jobID))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var executions []presenters.WorkflowExecutionResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &executions))
+		assert.Len(t, executions, 2)
+	})
+
+	t.Run("filters by status", func(t *testing.T) {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/executions?status=%s,%s", jobID, store.StatusErrored, store.StatusTimeout))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var executions []presenters.WorkflowExecutionResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &executions))
+		require.Len(t, executions, 1)
+		assert.Equal(t, "execution-errored", executions[0].ID)
+		assert.Equal(t, store.StatusErrored, executions[0].Status)
+	})
+
+	t.Run("rejects an unknown status", func(t *testing.T) {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/executions?status=errored,bogus", jobID))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+		assert.Contains(t, string(cltest.ParseResponseBody(t, resp)), `invalid status \"bogus\"`)
+	})
+
+	t.Run("rejects an invalid time range", func(t *testing.T) {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/executions?from=yesterday", jobID))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("job not found", func(t *testing.T) {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/executions", jobID+1000))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+}
+
+func TestWorkflowExecutionsController_Show(t *testing.T) {
+	t.Parallel()
+
+	app, client, jobID := setupWorkflowExecutionsControllerTest(t)
+	mustAddWorkflowExecution(t, app, "execution-completed", store.StatusCompleted)
+
+	t.Run("shows an execution", func(t *testing.T) {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/executions/execution-completed", jobID))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var execution presenters.WorkflowExecutionResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &execution))
+		assert.Equal(t, "execution-completed", execution.ID)
+		assert.Equal(t, store.StatusCompleted, execution.Status)
+		require.Len(t, execution.Steps, 1)
+		assert.Equal(t, "trigger", execution.Steps[0].Ref)
+	})
+
+	t.Run("execution not found", func(t *testing.T) {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%d/executions/unknown", jobID))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+}
+
+func TestWorkflowExecutionsController_DeadLetters(t *testing.T) {
+	t.Parallel()
+
+	app, client, _ := setupWorkflowExecutionsControllerTest(t)
+	mustAddWorkflowExecution(t, app, "execution-completed", store.StatusCompleted)
+	mustAddWorkflowExecution(t, app, "execution-errored", store.StatusErrored)
+	mustAddWorkflowExecution(t, app, "execution-timeout", store.StatusTimeout)
+
+	t.Run("lists the errored and timed out executions with their steps", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/workflow_executions/dead_letters")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var executions []presenters.WorkflowExecutionResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &executions))
+		require.Len(t, executions, 2)
+		for _, execution := range executions {
+			assert.Contains(t, []string{store.StatusErrored, store.StatusTimeout}, execution.Status)
+			require.Len(t, execution.Steps, 1)
+			assert.Equal(t, "trigger", execution.Steps[0].Ref)
+		}
+	})
+
+	t.Run("paginates", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/workflow_executions/dead_letters?size=1")
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var links jsonapi.Links
+		var executions []presenters.WorkflowExecutionResource
+		require.NoError(t, web.ParsePaginatedResponse(cltest.ParseResponseBody(t, resp), &executions, &links))
+		assert.Len(t, executions, 1)
+		assert.NotEmpty(t, links["next"].Href)
+	})
+}
+
+const testWorkflowID = "15c631d295ef5e32deb99a10ee6804bc4af1385568f9b3363f6552ac6dbb2cef"
+
+func setupWorkflowExecutionsControllerTest(t *testing.T) (*cltest.TestApplication, cltest.HTTPClientCleaner, int32) {
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	jb := job.Job{
+		Type:          job.Workflow,
+		SchemaVersion: 1,
+		ExternalJobID: uuid.New(),
+		Name:          null.StringFrom("workflow"),
+		WorkflowSpec: &job.WorkflowSpec{
+			WorkflowID:    testWorkflowID,
+			Workflow:      "anything",
+			WorkflowOwner: "00000000000000000000000000000000000000aa",
+			WorkflowName:  "workflow",
+		},
+	}
+	require.NoError(t, app.JobORM().CreateJob(ctx, &jb))
+
+	return app, app.NewHTTPClient(nil), jb.ID
+}
+
+func mustAddWorkflowExecution(t *testing.T, app *cltest.TestApplication, executionID string, status string) {
+	execution := store.WorkflowExecution{
+		ExecutionID: executionID,
+		WorkflowID:  testWorkflowID,
+		Status:      status,
+		Steps: map[string]*store.WorkflowExecutionStep{
+			"trigger": {ExecutionID: executionID, Ref: "trigger", Status: status},
+		},
+	}
+	require.NoError(t, store.NewDBStore(app.GetDB(), clockwork.NewRealClock()).Add(testutils.Context(t), &execution))
+}